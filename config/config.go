@@ -2,8 +2,8 @@ package config
 
 import (
 	"bytes"
+	"fmt"
 	"io"
-	"log"
 	"strings"
 )
 
@@ -44,11 +44,11 @@ func ParseConfig(contents []byte, mapper func(field, value string, config interf
 		}
 
 		// fill field and value strings
-		if p := strings.Index(l, "="); p >= 0 { // comment
-			field = strings.TrimSpace(l[:p-1])
+		if p := strings.Index(l, "="); p > 0 {
+			field = strings.TrimSpace(l[:p])
 			value = strings.TrimSpace(l[p+1:])
 		} else {
-			log.Fatalf("No '=' on line %d of config file", lineNum)
+			return fmt.Errorf("no 'Key = value' on line %d of config file: %q", lineNum, l)
 		}
 
 		err = mapper(field, value, config)
@@ -63,3 +63,36 @@ func ParseConfig(contents []byte, mapper func(field, value string, config interf
 	}
 	return nil
 }
+
+// Entry is one  Key = value  line of a config file
+type Entry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ReadEntries returns the entries of a config file in file order,
+// comments and blank lines dropped
+func ReadEntries(contents []byte) ([]Entry, error) {
+	var entries []Entry
+	err := ParseConfig(contents, func(field, value string, config interface{}) error {
+		entries = append(entries, Entry{field, value})
+		return nil
+	}, nil)
+	return entries, err
+}
+
+// SetEntry gives key the value, the way a later line in the file would,
+// by replacing the entries for key (ignoring case) or appending one
+func SetEntry(entries []Entry, key, value string) []Entry {
+	found := false
+	for i, e := range entries {
+		if strings.EqualFold(e.Key, key) {
+			entries[i].Value = value
+			found = true
+		}
+	}
+	if !found {
+		entries = append(entries, Entry{key, value})
+	}
+	return entries
+}
@@ -1,3 +1,2003 @@
 x 
 f(xs) 
 -1.000000 -0.000000 
+-0.999000 -0.001883 
+-0.998000 -0.003762 
+-0.997000 -0.005638 
+-0.996000 -0.007509 
+-0.995000 -0.009376 
+-0.994000 -0.011239 
+-0.993000 -0.013098 
+-0.992000 -0.014953 
+-0.991000 -0.016803 
+-0.990000 -0.018649 
+-0.989000 -0.020490 
+-0.988000 -0.022327 
+-0.987000 -0.024159 
+-0.986000 -0.025986 
+-0.985000 -0.027809 
+-0.984000 -0.029627 
+-0.983000 -0.031440 
+-0.982000 -0.033247 
+-0.981000 -0.035050 
+-0.980000 -0.036848 
+-0.979000 -0.038640 
+-0.978000 -0.040428 
+-0.977000 -0.042210 
+-0.976000 -0.043986 
+-0.975000 -0.045757 
+-0.974000 -0.047523 
+-0.973000 -0.049282 
+-0.972000 -0.051037 
+-0.971000 -0.052785 
+-0.970000 -0.054528 
+-0.969000 -0.056265 
+-0.968000 -0.057996 
+-0.967000 -0.059721 
+-0.966000 -0.061440 
+-0.965000 -0.063152 
+-0.964000 -0.064859 
+-0.963000 -0.066560 
+-0.962000 -0.068254 
+-0.961000 -0.069941 
+-0.960000 -0.071623 
+-0.959000 -0.073298 
+-0.958000 -0.074966 
+-0.957000 -0.076628 
+-0.956000 -0.078283 
+-0.955000 -0.079931 
+-0.954000 -0.081573 
+-0.953000 -0.083207 
+-0.952000 -0.084835 
+-0.951000 -0.086456 
+-0.950000 -0.088070 
+-0.949000 -0.089677 
+-0.948000 -0.091276 
+-0.947000 -0.092869 
+-0.946000 -0.094454 
+-0.945000 -0.096032 
+-0.944000 -0.097603 
+-0.943000 -0.099166 
+-0.942000 -0.100722 
+-0.941000 -0.102270 
+-0.940000 -0.103811 
+-0.939000 -0.105344 
+-0.938000 -0.106870 
+-0.937000 -0.108388 
+-0.936000 -0.109898 
+-0.935000 -0.111400 
+-0.934000 -0.112894 
+-0.933000 -0.114381 
+-0.932000 -0.115859 
+-0.931000 -0.117330 
+-0.930000 -0.118792 
+-0.929000 -0.120247 
+-0.928000 -0.121693 
+-0.927000 -0.123131 
+-0.926000 -0.124561 
+-0.925000 -0.125982 
+-0.924000 -0.127396 
+-0.923000 -0.128800 
+-0.922000 -0.130197 
+-0.921000 -0.131585 
+-0.920000 -0.132964 
+-0.919000 -0.134335 
+-0.918000 -0.135697 
+-0.917000 -0.137051 
+-0.916000 -0.138396 
+-0.915000 -0.139732 
+-0.914000 -0.141059 
+-0.913000 -0.142378 
+-0.912000 -0.143688 
+-0.911000 -0.144989 
+-0.910000 -0.146281 
+-0.909000 -0.147564 
+-0.908000 -0.148838 
+-0.907000 -0.150103 
+-0.906000 -0.151359 
+-0.905000 -0.152606 
+-0.904000 -0.153843 
+-0.903000 -0.155072 
+-0.902000 -0.156291 
+-0.901000 -0.157501 
+-0.900000 -0.158702 
+-0.899000 -0.159893 
+-0.898000 -0.161076 
+-0.897000 -0.162248 
+-0.896000 -0.163412 
+-0.895000 -0.164566 
+-0.894000 -0.165710 
+-0.893000 -0.166845 
+-0.892000 -0.167970 
+-0.891000 -0.169086 
+-0.890000 -0.170192 
+-0.889000 -0.171289 
+-0.888000 -0.172376 
+-0.887000 -0.173453 
+-0.886000 -0.174520 
+-0.885000 -0.175578 
+-0.884000 -0.176626 
+-0.883000 -0.177665 
+-0.882000 -0.178693 
+-0.881000 -0.179712 
+-0.880000 -0.180720 
+-0.879000 -0.181719 
+-0.878000 -0.182708 
+-0.877000 -0.183687 
+-0.876000 -0.184656 
+-0.875000 -0.185616 
+-0.874000 -0.186565 
+-0.873000 -0.187504 
+-0.872000 -0.188433 
+-0.871000 -0.189352 
+-0.870000 -0.190261 
+-0.869000 -0.191160 
+-0.868000 -0.192048 
+-0.867000 -0.192927 
+-0.866000 -0.193796 
+-0.865000 -0.194654 
+-0.864000 -0.195502 
+-0.863000 -0.196340 
+-0.862000 -0.197168 
+-0.861000 -0.197985 
+-0.860000 -0.198792 
+-0.859000 -0.199589 
+-0.858000 -0.200376 
+-0.857000 -0.201153 
+-0.856000 -0.201919 
+-0.855000 -0.202675 
+-0.854000 -0.203420 
+-0.853000 -0.204156 
+-0.852000 -0.204881 
+-0.851000 -0.205595 
+-0.850000 -0.206299 
+-0.849000 -0.206993 
+-0.848000 -0.207677 
+-0.847000 -0.208350 
+-0.846000 -0.209013 
+-0.845000 -0.209665 
+-0.844000 -0.210307 
+-0.843000 -0.210938 
+-0.842000 -0.211560 
+-0.841000 -0.212170 
+-0.840000 -0.212771 
+-0.839000 -0.213361 
+-0.838000 -0.213940 
+-0.837000 -0.214509 
+-0.836000 -0.215068 
+-0.835000 -0.215616 
+-0.834000 -0.216154 
+-0.833000 -0.216681 
+-0.832000 -0.217198 
+-0.831000 -0.217704 
+-0.830000 -0.218200 
+-0.829000 -0.218686 
+-0.828000 -0.219161 
+-0.827000 -0.219626 
+-0.826000 -0.220080 
+-0.825000 -0.220524 
+-0.824000 -0.220958 
+-0.823000 -0.221381 
+-0.822000 -0.221793 
+-0.821000 -0.222196 
+-0.820000 -0.222587 
+-0.819000 -0.222969 
+-0.818000 -0.223340 
+-0.817000 -0.223701 
+-0.816000 -0.224051 
+-0.815000 -0.224391 
+-0.814000 -0.224721 
+-0.813000 -0.225040 
+-0.812000 -0.225349 
+-0.811000 -0.225647 
+-0.810000 -0.225936 
+-0.809000 -0.226214 
+-0.808000 -0.226481 
+-0.807000 -0.226739 
+-0.806000 -0.226986 
+-0.805000 -0.227223 
+-0.804000 -0.227449 
+-0.803000 -0.227666 
+-0.802000 -0.227872 
+-0.801000 -0.228068 
+-0.800000 -0.228254 
+-0.799000 -0.228429 
+-0.798000 -0.228595 
+-0.797000 -0.228750 
+-0.796000 -0.228895 
+-0.795000 -0.229030 
+-0.794000 -0.229155 
+-0.793000 -0.229270 
+-0.792000 -0.229375 
+-0.791000 -0.229469 
+-0.790000 -0.229554 
+-0.789000 -0.229629 
+-0.788000 -0.229694 
+-0.787000 -0.229749 
+-0.786000 -0.229793 
+-0.785000 -0.229828 
+-0.784000 -0.229853 
+-0.783000 -0.229869 
+-0.782000 -0.229874 
+-0.781000 -0.229870 
+-0.780000 -0.229855 
+-0.779000 -0.229831 
+-0.778000 -0.229797 
+-0.777000 -0.229754 
+-0.776000 -0.229700 
+-0.775000 -0.229638 
+-0.774000 -0.229565 
+-0.773000 -0.229483 
+-0.772000 -0.229391 
+-0.771000 -0.229289 
+-0.770000 -0.229178 
+-0.769000 -0.229058 
+-0.768000 -0.228928 
+-0.767000 -0.228789 
+-0.766000 -0.228640 
+-0.765000 -0.228481 
+-0.764000 -0.228314 
+-0.763000 -0.228137 
+-0.762000 -0.227951 
+-0.761000 -0.227755 
+-0.760000 -0.227550 
+-0.759000 -0.227336 
+-0.758000 -0.227113 
+-0.757000 -0.226880 
+-0.756000 -0.226639 
+-0.755000 -0.226388 
+-0.754000 -0.226129 
+-0.753000 -0.225860 
+-0.752000 -0.225582 
+-0.751000 -0.225296 
+-0.750000 -0.225000 
+-0.749000 -0.224696 
+-0.748000 -0.224382 
+-0.747000 -0.224060 
+-0.746000 -0.223729 
+-0.745000 -0.223390 
+-0.744000 -0.223041 
+-0.743000 -0.222684 
+-0.742000 -0.222319 
+-0.741000 -0.221945 
+-0.740000 -0.221562 
+-0.739000 -0.221171 
+-0.738000 -0.220771 
+-0.737000 -0.220363 
+-0.736000 -0.219946 
+-0.735000 -0.219521 
+-0.734000 -0.219088 
+-0.733000 -0.218647 
+-0.732000 -0.218197 
+-0.731000 -0.217739 
+-0.730000 -0.217273 
+-0.729000 -0.216799 
+-0.728000 -0.216317 
+-0.727000 -0.215827 
+-0.726000 -0.215328 
+-0.725000 -0.214822 
+-0.724000 -0.214308 
+-0.723000 -0.213786 
+-0.722000 -0.213257 
+-0.721000 -0.212719 
+-0.720000 -0.212174 
+-0.719000 -0.211621 
+-0.718000 -0.211061 
+-0.717000 -0.210493 
+-0.716000 -0.209917 
+-0.715000 -0.209334 
+-0.714000 -0.208744 
+-0.713000 -0.208146 
+-0.712000 -0.207541 
+-0.711000 -0.206928 
+-0.710000 -0.206308 
+-0.709000 -0.205681 
+-0.708000 -0.205047 
+-0.707000 -0.204406 
+-0.706000 -0.203757 
+-0.705000 -0.203102 
+-0.704000 -0.202440 
+-0.703000 -0.201771 
+-0.702000 -0.201094 
+-0.701000 -0.200412 
+-0.700000 -0.199722 
+-0.699000 -0.199025 
+-0.698000 -0.198322 
+-0.697000 -0.197613 
+-0.696000 -0.196896 
+-0.695000 -0.196174 
+-0.694000 -0.195444 
+-0.693000 -0.194709 
+-0.692000 -0.193967 
+-0.691000 -0.193218 
+-0.690000 -0.192464 
+-0.689000 -0.191703 
+-0.688000 -0.190936 
+-0.687000 -0.190163 
+-0.686000 -0.189384 
+-0.685000 -0.188599 
+-0.684000 -0.187807 
+-0.683000 -0.187011 
+-0.682000 -0.186208 
+-0.681000 -0.185399 
+-0.680000 -0.184585 
+-0.679000 -0.183765 
+-0.678000 -0.182939 
+-0.677000 -0.182108 
+-0.676000 -0.181271 
+-0.675000 -0.180429 
+-0.674000 -0.179581 
+-0.673000 -0.178728 
+-0.672000 -0.177870 
+-0.671000 -0.177006 
+-0.670000 -0.176138 
+-0.669000 -0.175264 
+-0.668000 -0.174385 
+-0.667000 -0.173501 
+-0.666000 -0.172612 
+-0.665000 -0.171718 
+-0.664000 -0.170819 
+-0.663000 -0.169916 
+-0.662000 -0.169008 
+-0.661000 -0.168095 
+-0.660000 -0.167177 
+-0.659000 -0.166255 
+-0.658000 -0.165328 
+-0.657000 -0.164397 
+-0.656000 -0.163461 
+-0.655000 -0.162521 
+-0.654000 -0.161577 
+-0.653000 -0.160629 
+-0.652000 -0.159676 
+-0.651000 -0.158719 
+-0.650000 -0.157758 
+-0.649000 -0.156793 
+-0.648000 -0.155825 
+-0.647000 -0.154852 
+-0.646000 -0.153875 
+-0.645000 -0.152895 
+-0.644000 -0.151911 
+-0.643000 -0.150923 
+-0.642000 -0.149932 
+-0.641000 -0.148937 
+-0.640000 -0.147939 
+-0.639000 -0.146937 
+-0.638000 -0.145931 
+-0.637000 -0.144923 
+-0.636000 -0.143911 
+-0.635000 -0.142896 
+-0.634000 -0.141878 
+-0.633000 -0.140857 
+-0.632000 -0.139832 
+-0.631000 -0.138805 
+-0.630000 -0.137775 
+-0.629000 -0.136742 
+-0.628000 -0.135706 
+-0.627000 -0.134668 
+-0.626000 -0.133626 
+-0.625000 -0.132583 
+-0.624000 -0.131536 
+-0.623000 -0.130487 
+-0.622000 -0.129436 
+-0.621000 -0.128382 
+-0.620000 -0.127326 
+-0.619000 -0.126267 
+-0.618000 -0.125207 
+-0.617000 -0.124144 
+-0.616000 -0.123079 
+-0.615000 -0.122012 
+-0.614000 -0.120943 
+-0.613000 -0.119872 
+-0.612000 -0.118799 
+-0.611000 -0.117725 
+-0.610000 -0.116649 
+-0.609000 -0.115571 
+-0.608000 -0.114491 
+-0.607000 -0.113410 
+-0.606000 -0.112327 
+-0.605000 -0.111243 
+-0.604000 -0.110157 
+-0.603000 -0.109070 
+-0.602000 -0.107982 
+-0.601000 -0.106892 
+-0.600000 -0.105801 
+-0.599000 -0.104709 
+-0.598000 -0.103617 
+-0.597000 -0.102523 
+-0.596000 -0.101428 
+-0.595000 -0.100332 
+-0.594000 -0.099235 
+-0.593000 -0.098138 
+-0.592000 -0.097040 
+-0.591000 -0.095941 
+-0.590000 -0.094841 
+-0.589000 -0.093741 
+-0.588000 -0.092641 
+-0.587000 -0.091540 
+-0.586000 -0.090438 
+-0.585000 -0.089337 
+-0.584000 -0.088235 
+-0.583000 -0.087133 
+-0.582000 -0.086030 
+-0.581000 -0.084928 
+-0.580000 -0.083825 
+-0.579000 -0.082723 
+-0.578000 -0.081620 
+-0.577000 -0.080518 
+-0.576000 -0.079415 
+-0.575000 -0.078313 
+-0.574000 -0.077212 
+-0.573000 -0.076110 
+-0.572000 -0.075009 
+-0.571000 -0.073908 
+-0.570000 -0.072808 
+-0.569000 -0.071709 
+-0.568000 -0.070610 
+-0.567000 -0.069511 
+-0.566000 -0.068414 
+-0.565000 -0.067317 
+-0.564000 -0.066220 
+-0.563000 -0.065125 
+-0.562000 -0.064031 
+-0.561000 -0.062937 
+-0.560000 -0.061845 
+-0.559000 -0.060754 
+-0.558000 -0.059663 
+-0.557000 -0.058574 
+-0.556000 -0.057486 
+-0.555000 -0.056400 
+-0.554000 -0.055315 
+-0.553000 -0.054231 
+-0.552000 -0.053148 
+-0.551000 -0.052067 
+-0.550000 -0.050988 
+-0.549000 -0.049910 
+-0.548000 -0.048834 
+-0.547000 -0.047759 
+-0.546000 -0.046686 
+-0.545000 -0.045615 
+-0.544000 -0.044546 
+-0.543000 -0.043478 
+-0.542000 -0.042413 
+-0.541000 -0.041349 
+-0.540000 -0.040288 
+-0.539000 -0.039228 
+-0.538000 -0.038171 
+-0.537000 -0.037116 
+-0.536000 -0.036063 
+-0.535000 -0.035012 
+-0.534000 -0.033964 
+-0.533000 -0.032917 
+-0.532000 -0.031874 
+-0.531000 -0.030832 
+-0.530000 -0.029794 
+-0.529000 -0.028757 
+-0.528000 -0.027724 
+-0.527000 -0.026693 
+-0.526000 -0.025664 
+-0.525000 -0.024638 
+-0.524000 -0.023615 
+-0.523000 -0.022595 
+-0.522000 -0.021578 
+-0.521000 -0.020564 
+-0.520000 -0.019552 
+-0.519000 -0.018543 
+-0.518000 -0.017538 
+-0.517000 -0.016535 
+-0.516000 -0.015536 
+-0.515000 -0.014540 
+-0.514000 -0.013547 
+-0.513000 -0.012557 
+-0.512000 -0.011570 
+-0.511000 -0.010587 
+-0.510000 -0.009607 
+-0.509000 -0.008630 
+-0.508000 -0.007657 
+-0.507000 -0.006688 
+-0.506000 -0.005721 
+-0.505000 -0.004759 
+-0.504000 -0.003800 
+-0.503000 -0.002844 
+-0.502000 -0.001892 
+-0.501000 -0.000944 
+-0.500000 0.000000 
+-0.499000 0.000941 
+-0.498000 0.001877 
+-0.497000 0.002810 
+-0.496000 0.003739 
+-0.495000 0.004664 
+-0.494000 0.005586 
+-0.493000 0.006503 
+-0.492000 0.007416 
+-0.491000 0.008325 
+-0.490000 0.009230 
+-0.489000 0.010131 
+-0.488000 0.011028 
+-0.487000 0.011920 
+-0.486000 0.012809 
+-0.485000 0.013693 
+-0.484000 0.014573 
+-0.483000 0.015448 
+-0.482000 0.016319 
+-0.481000 0.017186 
+-0.480000 0.018048 
+-0.479000 0.018906 
+-0.478000 0.019759 
+-0.477000 0.020608 
+-0.476000 0.021452 
+-0.475000 0.022292 
+-0.474000 0.023127 
+-0.473000 0.023957 
+-0.472000 0.024783 
+-0.471000 0.025604 
+-0.470000 0.026421 
+-0.469000 0.027232 
+-0.468000 0.028039 
+-0.467000 0.028841 
+-0.466000 0.029639 
+-0.465000 0.030431 
+-0.464000 0.031218 
+-0.463000 0.032001 
+-0.462000 0.032779 
+-0.461000 0.033551 
+-0.460000 0.034319 
+-0.459000 0.035082 
+-0.458000 0.035840 
+-0.457000 0.036592 
+-0.456000 0.037340 
+-0.455000 0.038082 
+-0.454000 0.038820 
+-0.453000 0.039552 
+-0.452000 0.040279 
+-0.451000 0.041001 
+-0.450000 0.041717 
+-0.449000 0.042429 
+-0.448000 0.043135 
+-0.447000 0.043836 
+-0.446000 0.044531 
+-0.445000 0.045222 
+-0.444000 0.045906 
+-0.443000 0.046586 
+-0.442000 0.047260 
+-0.441000 0.047929 
+-0.440000 0.048592 
+-0.439000 0.049250 
+-0.438000 0.049903 
+-0.437000 0.050550 
+-0.436000 0.051192 
+-0.435000 0.051828 
+-0.434000 0.052458 
+-0.433000 0.053084 
+-0.432000 0.053703 
+-0.431000 0.054317 
+-0.430000 0.054926 
+-0.429000 0.055528 
+-0.428000 0.056126 
+-0.427000 0.056717 
+-0.426000 0.057303 
+-0.425000 0.057884 
+-0.424000 0.058459 
+-0.423000 0.059028 
+-0.422000 0.059591 
+-0.421000 0.060149 
+-0.420000 0.060701 
+-0.419000 0.061247 
+-0.418000 0.061788 
+-0.417000 0.062323 
+-0.416000 0.062852 
+-0.415000 0.063376 
+-0.414000 0.063893 
+-0.413000 0.064405 
+-0.412000 0.064912 
+-0.411000 0.065412 
+-0.410000 0.065907 
+-0.409000 0.066396 
+-0.408000 0.066879 
+-0.407000 0.067356 
+-0.406000 0.067827 
+-0.405000 0.068293 
+-0.404000 0.068753 
+-0.403000 0.069207 
+-0.402000 0.069655 
+-0.401000 0.070098 
+-0.400000 0.070534 
+-0.399000 0.070965 
+-0.398000 0.071390 
+-0.397000 0.071809 
+-0.396000 0.072222 
+-0.395000 0.072629 
+-0.394000 0.073031 
+-0.393000 0.073427 
+-0.392000 0.073817 
+-0.391000 0.074200 
+-0.390000 0.074579 
+-0.389000 0.074951 
+-0.388000 0.075317 
+-0.387000 0.075678 
+-0.386000 0.076033 
+-0.385000 0.076382 
+-0.384000 0.076725 
+-0.383000 0.077062 
+-0.382000 0.077393 
+-0.381000 0.077719 
+-0.380000 0.078038 
+-0.379000 0.078352 
+-0.378000 0.078660 
+-0.377000 0.078963 
+-0.376000 0.079259 
+-0.375000 0.079550 
+-0.374000 0.079834 
+-0.373000 0.080113 
+-0.372000 0.080386 
+-0.371000 0.080654 
+-0.370000 0.080916 
+-0.369000 0.081171 
+-0.368000 0.081421 
+-0.367000 0.081666 
+-0.366000 0.081904 
+-0.365000 0.082137 
+-0.364000 0.082364 
+-0.363000 0.082586 
+-0.362000 0.082801 
+-0.361000 0.083011 
+-0.360000 0.083215 
+-0.359000 0.083414 
+-0.358000 0.083607 
+-0.357000 0.083794 
+-0.356000 0.083976 
+-0.355000 0.084152 
+-0.354000 0.084322 
+-0.353000 0.084486 
+-0.352000 0.084645 
+-0.351000 0.084799 
+-0.350000 0.084947 
+-0.349000 0.085089 
+-0.348000 0.085226 
+-0.347000 0.085357 
+-0.346000 0.085483 
+-0.345000 0.085603 
+-0.344000 0.085717 
+-0.343000 0.085827 
+-0.342000 0.085930 
+-0.341000 0.086029 
+-0.340000 0.086121 
+-0.339000 0.086209 
+-0.338000 0.086291 
+-0.337000 0.086367 
+-0.336000 0.086439 
+-0.335000 0.086505 
+-0.334000 0.086565 
+-0.333000 0.086620 
+-0.332000 0.086670 
+-0.331000 0.086715 
+-0.330000 0.086754 
+-0.329000 0.086789 
+-0.328000 0.086817 
+-0.327000 0.086841 
+-0.326000 0.086860 
+-0.325000 0.086873 
+-0.324000 0.086881 
+-0.323000 0.086885 
+-0.322000 0.086883 
+-0.321000 0.086876 
+-0.320000 0.086863 
+-0.319000 0.086846 
+-0.318000 0.086824 
+-0.317000 0.086797 
+-0.316000 0.086765 
+-0.315000 0.086728 
+-0.314000 0.086686 
+-0.313000 0.086639 
+-0.312000 0.086587 
+-0.311000 0.086531 
+-0.310000 0.086469 
+-0.309000 0.086403 
+-0.308000 0.086332 
+-0.307000 0.086256 
+-0.306000 0.086176 
+-0.305000 0.086091 
+-0.304000 0.086001 
+-0.303000 0.085906 
+-0.302000 0.085807 
+-0.301000 0.085703 
+-0.300000 0.085595 
+-0.299000 0.085482 
+-0.298000 0.085365 
+-0.297000 0.085243 
+-0.296000 0.085117 
+-0.295000 0.084986 
+-0.294000 0.084851 
+-0.293000 0.084711 
+-0.292000 0.084567 
+-0.291000 0.084419 
+-0.290000 0.084267 
+-0.289000 0.084110 
+-0.288000 0.083949 
+-0.287000 0.083784 
+-0.286000 0.083614 
+-0.285000 0.083441 
+-0.284000 0.083263 
+-0.283000 0.083082 
+-0.282000 0.082896 
+-0.281000 0.082706 
+-0.280000 0.082512 
+-0.279000 0.082314 
+-0.278000 0.082113 
+-0.277000 0.081907 
+-0.276000 0.081698 
+-0.275000 0.081484 
+-0.274000 0.081267 
+-0.273000 0.081046 
+-0.272000 0.080822 
+-0.271000 0.080593 
+-0.270000 0.080361 
+-0.269000 0.080126 
+-0.268000 0.079886 
+-0.267000 0.079643 
+-0.266000 0.079397 
+-0.265000 0.079147 
+-0.264000 0.078894 
+-0.263000 0.078637 
+-0.262000 0.078377 
+-0.261000 0.078113 
+-0.260000 0.077846 
+-0.259000 0.077576 
+-0.258000 0.077302 
+-0.257000 0.077025 
+-0.256000 0.076745 
+-0.255000 0.076462 
+-0.254000 0.076176 
+-0.253000 0.075887 
+-0.252000 0.075594 
+-0.251000 0.075299 
+-0.250000 0.075000 
+-0.249000 0.074699 
+-0.248000 0.074394 
+-0.247000 0.074087 
+-0.246000 0.073777 
+-0.245000 0.073464 
+-0.244000 0.073148 
+-0.243000 0.072830 
+-0.242000 0.072508 
+-0.241000 0.072184 
+-0.240000 0.071858 
+-0.239000 0.071529 
+-0.238000 0.071197 
+-0.237000 0.070863 
+-0.236000 0.070526 
+-0.235000 0.070187 
+-0.234000 0.069846 
+-0.233000 0.069502 
+-0.232000 0.069155 
+-0.231000 0.068807 
+-0.230000 0.068456 
+-0.229000 0.068103 
+-0.228000 0.067748 
+-0.227000 0.067390 
+-0.226000 0.067031 
+-0.225000 0.066669 
+-0.224000 0.066305 
+-0.223000 0.065940 
+-0.222000 0.065572 
+-0.221000 0.065202 
+-0.220000 0.064831 
+-0.219000 0.064458 
+-0.218000 0.064083 
+-0.217000 0.063706 
+-0.216000 0.063327 
+-0.215000 0.062947 
+-0.214000 0.062565 
+-0.213000 0.062181 
+-0.212000 0.061796 
+-0.211000 0.061409 
+-0.210000 0.061021 
+-0.209000 0.060631 
+-0.208000 0.060240 
+-0.207000 0.059847 
+-0.206000 0.059453 
+-0.205000 0.059058 
+-0.204000 0.058662 
+-0.203000 0.058264 
+-0.202000 0.057865 
+-0.201000 0.057465 
+-0.200000 0.057063 
+-0.199000 0.056661 
+-0.198000 0.056258 
+-0.197000 0.055853 
+-0.196000 0.055448 
+-0.195000 0.055042 
+-0.194000 0.054634 
+-0.193000 0.054226 
+-0.192000 0.053817 
+-0.191000 0.053408 
+-0.190000 0.052997 
+-0.189000 0.052586 
+-0.188000 0.052174 
+-0.187000 0.051762 
+-0.186000 0.051349 
+-0.185000 0.050935 
+-0.184000 0.050521 
+-0.183000 0.050107 
+-0.182000 0.049692 
+-0.181000 0.049276 
+-0.180000 0.048861 
+-0.179000 0.048445 
+-0.178000 0.048028 
+-0.177000 0.047612 
+-0.176000 0.047195 
+-0.175000 0.046778 
+-0.174000 0.046361 
+-0.173000 0.045944 
+-0.172000 0.045526 
+-0.171000 0.045109 
+-0.170000 0.044692 
+-0.169000 0.044274 
+-0.168000 0.043857 
+-0.167000 0.043440 
+-0.166000 0.043023 
+-0.165000 0.042607 
+-0.164000 0.042190 
+-0.163000 0.041774 
+-0.162000 0.041358 
+-0.161000 0.040943 
+-0.160000 0.040528 
+-0.159000 0.040113 
+-0.158000 0.039699 
+-0.157000 0.039285 
+-0.156000 0.038872 
+-0.155000 0.038459 
+-0.154000 0.038047 
+-0.153000 0.037636 
+-0.152000 0.037225 
+-0.151000 0.036815 
+-0.150000 0.036406 
+-0.149000 0.035997 
+-0.148000 0.035590 
+-0.147000 0.035183 
+-0.146000 0.034777 
+-0.145000 0.034372 
+-0.144000 0.033968 
+-0.143000 0.033565 
+-0.142000 0.033162 
+-0.141000 0.032761 
+-0.140000 0.032362 
+-0.139000 0.031963 
+-0.138000 0.031565 
+-0.137000 0.031169 
+-0.136000 0.030773 
+-0.135000 0.030379 
+-0.134000 0.029987 
+-0.133000 0.029595 
+-0.132000 0.029206 
+-0.131000 0.028817 
+-0.130000 0.028430 
+-0.129000 0.028044 
+-0.128000 0.027660 
+-0.127000 0.027277 
+-0.126000 0.026896 
+-0.125000 0.026517 
+-0.124000 0.026139 
+-0.123000 0.025762 
+-0.122000 0.025388 
+-0.121000 0.025015 
+-0.120000 0.024644 
+-0.119000 0.024274 
+-0.118000 0.023907 
+-0.117000 0.023541 
+-0.116000 0.023177 
+-0.115000 0.022815 
+-0.114000 0.022455 
+-0.113000 0.022097 
+-0.112000 0.021741 
+-0.111000 0.021387 
+-0.110000 0.021035 
+-0.109000 0.020685 
+-0.108000 0.020337 
+-0.107000 0.019991 
+-0.106000 0.019648 
+-0.105000 0.019307 
+-0.104000 0.018967 
+-0.103000 0.018631 
+-0.102000 0.018296 
+-0.101000 0.017964 
+-0.100000 0.017634 
+-0.099000 0.017306 
+-0.098000 0.016981 
+-0.097000 0.016658 
+-0.096000 0.016337 
+-0.095000 0.016019 
+-0.094000 0.015704 
+-0.093000 0.015391 
+-0.092000 0.015080 
+-0.091000 0.014773 
+-0.090000 0.014467 
+-0.089000 0.014165 
+-0.088000 0.013865 
+-0.087000 0.013567 
+-0.086000 0.013273 
+-0.085000 0.012981 
+-0.084000 0.012691 
+-0.083000 0.012405 
+-0.082000 0.012121 
+-0.081000 0.011840 
+-0.080000 0.011562 
+-0.079000 0.011287 
+-0.078000 0.011014 
+-0.077000 0.010745 
+-0.076000 0.010478 
+-0.075000 0.010215 
+-0.074000 0.009954 
+-0.073000 0.009696 
+-0.072000 0.009442 
+-0.071000 0.009190 
+-0.070000 0.008941 
+-0.069000 0.008696 
+-0.068000 0.008453 
+-0.067000 0.008214 
+-0.066000 0.007978 
+-0.065000 0.007744 
+-0.064000 0.007514 
+-0.063000 0.007288 
+-0.062000 0.007064 
+-0.061000 0.006843 
+-0.060000 0.006626 
+-0.059000 0.006412 
+-0.058000 0.006202 
+-0.057000 0.005994 
+-0.056000 0.005790 
+-0.055000 0.005589 
+-0.054000 0.005392 
+-0.053000 0.005198 
+-0.052000 0.005007 
+-0.051000 0.004819 
+-0.050000 0.004635 
+-0.049000 0.004455 
+-0.048000 0.004277 
+-0.047000 0.004104 
+-0.046000 0.003933 
+-0.045000 0.003766 
+-0.044000 0.003603 
+-0.043000 0.003443 
+-0.042000 0.003287 
+-0.041000 0.003134 
+-0.040000 0.002984 
+-0.039000 0.002838 
+-0.038000 0.002696 
+-0.037000 0.002557 
+-0.036000 0.002422 
+-0.035000 0.002291 
+-0.034000 0.002162 
+-0.033000 0.002038 
+-0.032000 0.001917 
+-0.031000 0.001800 
+-0.030000 0.001686 
+-0.029000 0.001576 
+-0.028000 0.001470 
+-0.027000 0.001368 
+-0.026000 0.001269 
+-0.025000 0.001173 
+-0.024000 0.001082 
+-0.023000 0.000994 
+-0.022000 0.000909 
+-0.021000 0.000829 
+-0.020000 0.000752 
+-0.019000 0.000679 
+-0.018000 0.000609 
+-0.017000 0.000544 
+-0.016000 0.000482 
+-0.015000 0.000423 
+-0.014000 0.000369 
+-0.013000 0.000318 
+-0.012000 0.000271 
+-0.011000 0.000228 
+-0.010000 0.000188 
+-0.009000 0.000153 
+-0.008000 0.000121 
+-0.007000 0.000092 
+-0.006000 0.000068 
+-0.005000 0.000047 
+-0.004000 0.000030 
+-0.003000 0.000017 
+-0.002000 0.000008 
+-0.001000 0.000002 
+0.000000 0.000000 
+0.001000 0.000002 
+0.002000 0.000008 
+0.003000 0.000017 
+0.004000 0.000030 
+0.005000 0.000047 
+0.006000 0.000068 
+0.007000 0.000092 
+0.008000 0.000121 
+0.009000 0.000153 
+0.010000 0.000188 
+0.011000 0.000228 
+0.012000 0.000271 
+0.013000 0.000318 
+0.014000 0.000369 
+0.015000 0.000423 
+0.016000 0.000482 
+0.017000 0.000544 
+0.018000 0.000609 
+0.019000 0.000679 
+0.020000 0.000752 
+0.021000 0.000829 
+0.022000 0.000909 
+0.023000 0.000994 
+0.024000 0.001082 
+0.025000 0.001173 
+0.026000 0.001269 
+0.027000 0.001368 
+0.028000 0.001470 
+0.029000 0.001576 
+0.030000 0.001686 
+0.031000 0.001800 
+0.032000 0.001917 
+0.033000 0.002038 
+0.034000 0.002162 
+0.035000 0.002291 
+0.036000 0.002422 
+0.037000 0.002557 
+0.038000 0.002696 
+0.039000 0.002838 
+0.040000 0.002984 
+0.041000 0.003134 
+0.042000 0.003287 
+0.043000 0.003443 
+0.044000 0.003603 
+0.045000 0.003766 
+0.046000 0.003933 
+0.047000 0.004104 
+0.048000 0.004277 
+0.049000 0.004455 
+0.050000 0.004635 
+0.051000 0.004819 
+0.052000 0.005007 
+0.053000 0.005198 
+0.054000 0.005392 
+0.055000 0.005589 
+0.056000 0.005790 
+0.057000 0.005994 
+0.058000 0.006202 
+0.059000 0.006412 
+0.060000 0.006626 
+0.061000 0.006843 
+0.062000 0.007064 
+0.063000 0.007288 
+0.064000 0.007514 
+0.065000 0.007744 
+0.066000 0.007978 
+0.067000 0.008214 
+0.068000 0.008453 
+0.069000 0.008696 
+0.070000 0.008941 
+0.071000 0.009190 
+0.072000 0.009442 
+0.073000 0.009696 
+0.074000 0.009954 
+0.075000 0.010215 
+0.076000 0.010478 
+0.077000 0.010745 
+0.078000 0.011014 
+0.079000 0.011287 
+0.080000 0.011562 
+0.081000 0.011840 
+0.082000 0.012121 
+0.083000 0.012405 
+0.084000 0.012691 
+0.085000 0.012981 
+0.086000 0.013273 
+0.087000 0.013567 
+0.088000 0.013865 
+0.089000 0.014165 
+0.090000 0.014467 
+0.091000 0.014773 
+0.092000 0.015080 
+0.093000 0.015391 
+0.094000 0.015704 
+0.095000 0.016019 
+0.096000 0.016337 
+0.097000 0.016658 
+0.098000 0.016981 
+0.099000 0.017306 
+0.100000 0.017634 
+0.101000 0.017964 
+0.102000 0.018296 
+0.103000 0.018631 
+0.104000 0.018967 
+0.105000 0.019307 
+0.106000 0.019648 
+0.107000 0.019991 
+0.108000 0.020337 
+0.109000 0.020685 
+0.110000 0.021035 
+0.111000 0.021387 
+0.112000 0.021741 
+0.113000 0.022097 
+0.114000 0.022455 
+0.115000 0.022815 
+0.116000 0.023177 
+0.117000 0.023541 
+0.118000 0.023907 
+0.119000 0.024274 
+0.120000 0.024644 
+0.121000 0.025015 
+0.122000 0.025388 
+0.123000 0.025762 
+0.124000 0.026139 
+0.125000 0.026517 
+0.126000 0.026896 
+0.127000 0.027277 
+0.128000 0.027660 
+0.129000 0.028044 
+0.130000 0.028430 
+0.131000 0.028817 
+0.132000 0.029206 
+0.133000 0.029595 
+0.134000 0.029987 
+0.135000 0.030379 
+0.136000 0.030773 
+0.137000 0.031169 
+0.138000 0.031565 
+0.139000 0.031963 
+0.140000 0.032362 
+0.141000 0.032761 
+0.142000 0.033162 
+0.143000 0.033565 
+0.144000 0.033968 
+0.145000 0.034372 
+0.146000 0.034777 
+0.147000 0.035183 
+0.148000 0.035590 
+0.149000 0.035997 
+0.150000 0.036406 
+0.151000 0.036815 
+0.152000 0.037225 
+0.153000 0.037636 
+0.154000 0.038047 
+0.155000 0.038459 
+0.156000 0.038872 
+0.157000 0.039285 
+0.158000 0.039699 
+0.159000 0.040113 
+0.160000 0.040528 
+0.161000 0.040943 
+0.162000 0.041358 
+0.163000 0.041774 
+0.164000 0.042190 
+0.165000 0.042607 
+0.166000 0.043023 
+0.167000 0.043440 
+0.168000 0.043857 
+0.169000 0.044274 
+0.170000 0.044692 
+0.171000 0.045109 
+0.172000 0.045526 
+0.173000 0.045944 
+0.174000 0.046361 
+0.175000 0.046778 
+0.176000 0.047195 
+0.177000 0.047612 
+0.178000 0.048028 
+0.179000 0.048445 
+0.180000 0.048861 
+0.181000 0.049276 
+0.182000 0.049692 
+0.183000 0.050107 
+0.184000 0.050521 
+0.185000 0.050935 
+0.186000 0.051349 
+0.187000 0.051762 
+0.188000 0.052174 
+0.189000 0.052586 
+0.190000 0.052997 
+0.191000 0.053408 
+0.192000 0.053817 
+0.193000 0.054226 
+0.194000 0.054634 
+0.195000 0.055042 
+0.196000 0.055448 
+0.197000 0.055853 
+0.198000 0.056258 
+0.199000 0.056661 
+0.200000 0.057063 
+0.201000 0.057465 
+0.202000 0.057865 
+0.203000 0.058264 
+0.204000 0.058662 
+0.205000 0.059058 
+0.206000 0.059453 
+0.207000 0.059847 
+0.208000 0.060240 
+0.209000 0.060631 
+0.210000 0.061021 
+0.211000 0.061409 
+0.212000 0.061796 
+0.213000 0.062181 
+0.214000 0.062565 
+0.215000 0.062947 
+0.216000 0.063327 
+0.217000 0.063706 
+0.218000 0.064083 
+0.219000 0.064458 
+0.220000 0.064831 
+0.221000 0.065202 
+0.222000 0.065572 
+0.223000 0.065940 
+0.224000 0.066305 
+0.225000 0.066669 
+0.226000 0.067031 
+0.227000 0.067390 
+0.228000 0.067748 
+0.229000 0.068103 
+0.230000 0.068456 
+0.231000 0.068807 
+0.232000 0.069155 
+0.233000 0.069502 
+0.234000 0.069846 
+0.235000 0.070187 
+0.236000 0.070526 
+0.237000 0.070863 
+0.238000 0.071197 
+0.239000 0.071529 
+0.240000 0.071858 
+0.241000 0.072184 
+0.242000 0.072508 
+0.243000 0.072830 
+0.244000 0.073148 
+0.245000 0.073464 
+0.246000 0.073777 
+0.247000 0.074087 
+0.248000 0.074394 
+0.249000 0.074699 
+0.250000 0.075000 
+0.251000 0.075299 
+0.252000 0.075594 
+0.253000 0.075887 
+0.254000 0.076176 
+0.255000 0.076462 
+0.256000 0.076745 
+0.257000 0.077025 
+0.258000 0.077302 
+0.259000 0.077576 
+0.260000 0.077846 
+0.261000 0.078113 
+0.262000 0.078377 
+0.263000 0.078637 
+0.264000 0.078894 
+0.265000 0.079147 
+0.266000 0.079397 
+0.267000 0.079643 
+0.268000 0.079886 
+0.269000 0.080126 
+0.270000 0.080361 
+0.271000 0.080593 
+0.272000 0.080822 
+0.273000 0.081046 
+0.274000 0.081267 
+0.275000 0.081484 
+0.276000 0.081698 
+0.277000 0.081907 
+0.278000 0.082113 
+0.279000 0.082314 
+0.280000 0.082512 
+0.281000 0.082706 
+0.282000 0.082896 
+0.283000 0.083082 
+0.284000 0.083263 
+0.285000 0.083441 
+0.286000 0.083614 
+0.287000 0.083784 
+0.288000 0.083949 
+0.289000 0.084110 
+0.290000 0.084267 
+0.291000 0.084419 
+0.292000 0.084567 
+0.293000 0.084711 
+0.294000 0.084851 
+0.295000 0.084986 
+0.296000 0.085117 
+0.297000 0.085243 
+0.298000 0.085365 
+0.299000 0.085482 
+0.300000 0.085595 
+0.301000 0.085703 
+0.302000 0.085807 
+0.303000 0.085906 
+0.304000 0.086001 
+0.305000 0.086091 
+0.306000 0.086176 
+0.307000 0.086256 
+0.308000 0.086332 
+0.309000 0.086403 
+0.310000 0.086469 
+0.311000 0.086531 
+0.312000 0.086587 
+0.313000 0.086639 
+0.314000 0.086686 
+0.315000 0.086728 
+0.316000 0.086765 
+0.317000 0.086797 
+0.318000 0.086824 
+0.319000 0.086846 
+0.320000 0.086863 
+0.321000 0.086876 
+0.322000 0.086883 
+0.323000 0.086885 
+0.324000 0.086881 
+0.325000 0.086873 
+0.326000 0.086860 
+0.327000 0.086841 
+0.328000 0.086817 
+0.329000 0.086789 
+0.330000 0.086754 
+0.331000 0.086715 
+0.332000 0.086670 
+0.333000 0.086620 
+0.334000 0.086565 
+0.335000 0.086505 
+0.336000 0.086439 
+0.337000 0.086367 
+0.338000 0.086291 
+0.339000 0.086209 
+0.340000 0.086121 
+0.341000 0.086029 
+0.342000 0.085930 
+0.343000 0.085827 
+0.344000 0.085717 
+0.345000 0.085603 
+0.346000 0.085483 
+0.347000 0.085357 
+0.348000 0.085226 
+0.349000 0.085089 
+0.350000 0.084947 
+0.351000 0.084799 
+0.352000 0.084645 
+0.353000 0.084486 
+0.354000 0.084322 
+0.355000 0.084152 
+0.356000 0.083976 
+0.357000 0.083794 
+0.358000 0.083607 
+0.359000 0.083414 
+0.360000 0.083215 
+0.361000 0.083011 
+0.362000 0.082801 
+0.363000 0.082586 
+0.364000 0.082364 
+0.365000 0.082137 
+0.366000 0.081904 
+0.367000 0.081666 
+0.368000 0.081421 
+0.369000 0.081171 
+0.370000 0.080916 
+0.371000 0.080654 
+0.372000 0.080386 
+0.373000 0.080113 
+0.374000 0.079834 
+0.375000 0.079550 
+0.376000 0.079259 
+0.377000 0.078963 
+0.378000 0.078660 
+0.379000 0.078352 
+0.380000 0.078038 
+0.381000 0.077719 
+0.382000 0.077393 
+0.383000 0.077062 
+0.384000 0.076725 
+0.385000 0.076382 
+0.386000 0.076033 
+0.387000 0.075678 
+0.388000 0.075317 
+0.389000 0.074951 
+0.390000 0.074579 
+0.391000 0.074200 
+0.392000 0.073817 
+0.393000 0.073427 
+0.394000 0.073031 
+0.395000 0.072629 
+0.396000 0.072222 
+0.397000 0.071809 
+0.398000 0.071390 
+0.399000 0.070965 
+0.400000 0.070534 
+0.401000 0.070098 
+0.402000 0.069655 
+0.403000 0.069207 
+0.404000 0.068753 
+0.405000 0.068293 
+0.406000 0.067827 
+0.407000 0.067356 
+0.408000 0.066879 
+0.409000 0.066396 
+0.410000 0.065907 
+0.411000 0.065412 
+0.412000 0.064912 
+0.413000 0.064405 
+0.414000 0.063893 
+0.415000 0.063376 
+0.416000 0.062852 
+0.417000 0.062323 
+0.418000 0.061788 
+0.419000 0.061247 
+0.420000 0.060701 
+0.421000 0.060149 
+0.422000 0.059591 
+0.423000 0.059028 
+0.424000 0.058459 
+0.425000 0.057884 
+0.426000 0.057303 
+0.427000 0.056717 
+0.428000 0.056126 
+0.429000 0.055528 
+0.430000 0.054926 
+0.431000 0.054317 
+0.432000 0.053703 
+0.433000 0.053084 
+0.434000 0.052458 
+0.435000 0.051828 
+0.436000 0.051192 
+0.437000 0.050550 
+0.438000 0.049903 
+0.439000 0.049250 
+0.440000 0.048592 
+0.441000 0.047929 
+0.442000 0.047260 
+0.443000 0.046586 
+0.444000 0.045906 
+0.445000 0.045222 
+0.446000 0.044531 
+0.447000 0.043836 
+0.448000 0.043135 
+0.449000 0.042429 
+0.450000 0.041717 
+0.451000 0.041001 
+0.452000 0.040279 
+0.453000 0.039552 
+0.454000 0.038820 
+0.455000 0.038082 
+0.456000 0.037340 
+0.457000 0.036592 
+0.458000 0.035840 
+0.459000 0.035082 
+0.460000 0.034319 
+0.461000 0.033551 
+0.462000 0.032779 
+0.463000 0.032001 
+0.464000 0.031218 
+0.465000 0.030431 
+0.466000 0.029639 
+0.467000 0.028841 
+0.468000 0.028039 
+0.469000 0.027232 
+0.470000 0.026421 
+0.471000 0.025604 
+0.472000 0.024783 
+0.473000 0.023957 
+0.474000 0.023127 
+0.475000 0.022292 
+0.476000 0.021452 
+0.477000 0.020608 
+0.478000 0.019759 
+0.479000 0.018906 
+0.480000 0.018048 
+0.481000 0.017186 
+0.482000 0.016319 
+0.483000 0.015448 
+0.484000 0.014573 
+0.485000 0.013693 
+0.486000 0.012809 
+0.487000 0.011920 
+0.488000 0.011028 
+0.489000 0.010131 
+0.490000 0.009230 
+0.491000 0.008325 
+0.492000 0.007416 
+0.493000 0.006503 
+0.494000 0.005586 
+0.495000 0.004664 
+0.496000 0.003739 
+0.497000 0.002810 
+0.498000 0.001877 
+0.499000 0.000941 
+0.500000 0.000000 
+0.501000 -0.000944 
+0.502000 -0.001892 
+0.503000 -0.002844 
+0.504000 -0.003800 
+0.505000 -0.004759 
+0.506000 -0.005721 
+0.507000 -0.006688 
+0.508000 -0.007657 
+0.509000 -0.008630 
+0.510000 -0.009607 
+0.511000 -0.010587 
+0.512000 -0.011570 
+0.513000 -0.012557 
+0.514000 -0.013547 
+0.515000 -0.014540 
+0.516000 -0.015536 
+0.517000 -0.016535 
+0.518000 -0.017538 
+0.519000 -0.018543 
+0.520000 -0.019552 
+0.521000 -0.020564 
+0.522000 -0.021578 
+0.523000 -0.022595 
+0.524000 -0.023615 
+0.525000 -0.024638 
+0.526000 -0.025664 
+0.527000 -0.026693 
+0.528000 -0.027724 
+0.529000 -0.028757 
+0.530000 -0.029794 
+0.531000 -0.030832 
+0.532000 -0.031874 
+0.533000 -0.032917 
+0.534000 -0.033964 
+0.535000 -0.035012 
+0.536000 -0.036063 
+0.537000 -0.037116 
+0.538000 -0.038171 
+0.539000 -0.039228 
+0.540000 -0.040288 
+0.541000 -0.041349 
+0.542000 -0.042413 
+0.543000 -0.043478 
+0.544000 -0.044546 
+0.545000 -0.045615 
+0.546000 -0.046686 
+0.547000 -0.047759 
+0.548000 -0.048834 
+0.549000 -0.049910 
+0.550000 -0.050988 
+0.551000 -0.052067 
+0.552000 -0.053148 
+0.553000 -0.054231 
+0.554000 -0.055315 
+0.555000 -0.056400 
+0.556000 -0.057486 
+0.557000 -0.058574 
+0.558000 -0.059663 
+0.559000 -0.060754 
+0.560000 -0.061845 
+0.561000 -0.062937 
+0.562000 -0.064031 
+0.563000 -0.065125 
+0.564000 -0.066220 
+0.565000 -0.067317 
+0.566000 -0.068414 
+0.567000 -0.069511 
+0.568000 -0.070610 
+0.569000 -0.071709 
+0.570000 -0.072808 
+0.571000 -0.073908 
+0.572000 -0.075009 
+0.573000 -0.076110 
+0.574000 -0.077212 
+0.575000 -0.078313 
+0.576000 -0.079415 
+0.577000 -0.080518 
+0.578000 -0.081620 
+0.579000 -0.082723 
+0.580000 -0.083825 
+0.581000 -0.084928 
+0.582000 -0.086030 
+0.583000 -0.087133 
+0.584000 -0.088235 
+0.585000 -0.089337 
+0.586000 -0.090438 
+0.587000 -0.091540 
+0.588000 -0.092641 
+0.589000 -0.093741 
+0.590000 -0.094841 
+0.591000 -0.095941 
+0.592000 -0.097040 
+0.593000 -0.098138 
+0.594000 -0.099235 
+0.595000 -0.100332 
+0.596000 -0.101428 
+0.597000 -0.102523 
+0.598000 -0.103617 
+0.599000 -0.104709 
+0.600000 -0.105801 
+0.601000 -0.106892 
+0.602000 -0.107982 
+0.603000 -0.109070 
+0.604000 -0.110157 
+0.605000 -0.111243 
+0.606000 -0.112327 
+0.607000 -0.113410 
+0.608000 -0.114491 
+0.609000 -0.115571 
+0.610000 -0.116649 
+0.611000 -0.117725 
+0.612000 -0.118799 
+0.613000 -0.119872 
+0.614000 -0.120943 
+0.615000 -0.122012 
+0.616000 -0.123079 
+0.617000 -0.124144 
+0.618000 -0.125207 
+0.619000 -0.126267 
+0.620000 -0.127326 
+0.621000 -0.128382 
+0.622000 -0.129436 
+0.623000 -0.130487 
+0.624000 -0.131536 
+0.625000 -0.132583 
+0.626000 -0.133626 
+0.627000 -0.134668 
+0.628000 -0.135706 
+0.629000 -0.136742 
+0.630000 -0.137775 
+0.631000 -0.138805 
+0.632000 -0.139832 
+0.633000 -0.140857 
+0.634000 -0.141878 
+0.635000 -0.142896 
+0.636000 -0.143911 
+0.637000 -0.144923 
+0.638000 -0.145931 
+0.639000 -0.146937 
+0.640000 -0.147939 
+0.641000 -0.148937 
+0.642000 -0.149932 
+0.643000 -0.150923 
+0.644000 -0.151911 
+0.645000 -0.152895 
+0.646000 -0.153875 
+0.647000 -0.154852 
+0.648000 -0.155825 
+0.649000 -0.156793 
+0.650000 -0.157758 
+0.651000 -0.158719 
+0.652000 -0.159676 
+0.653000 -0.160629 
+0.654000 -0.161577 
+0.655000 -0.162521 
+0.656000 -0.163461 
+0.657000 -0.164397 
+0.658000 -0.165328 
+0.659000 -0.166255 
+0.660000 -0.167177 
+0.661000 -0.168095 
+0.662000 -0.169008 
+0.663000 -0.169916 
+0.664000 -0.170819 
+0.665000 -0.171718 
+0.666000 -0.172612 
+0.667000 -0.173501 
+0.668000 -0.174385 
+0.669000 -0.175264 
+0.670000 -0.176138 
+0.671000 -0.177006 
+0.672000 -0.177870 
+0.673000 -0.178728 
+0.674000 -0.179581 
+0.675000 -0.180429 
+0.676000 -0.181271 
+0.677000 -0.182108 
+0.678000 -0.182939 
+0.679000 -0.183765 
+0.680000 -0.184585 
+0.681000 -0.185399 
+0.682000 -0.186208 
+0.683000 -0.187011 
+0.684000 -0.187807 
+0.685000 -0.188599 
+0.686000 -0.189384 
+0.687000 -0.190163 
+0.688000 -0.190936 
+0.689000 -0.191703 
+0.690000 -0.192464 
+0.691000 -0.193218 
+0.692000 -0.193967 
+0.693000 -0.194709 
+0.694000 -0.195444 
+0.695000 -0.196174 
+0.696000 -0.196896 
+0.697000 -0.197613 
+0.698000 -0.198322 
+0.699000 -0.199025 
+0.700000 -0.199722 
+0.701000 -0.200412 
+0.702000 -0.201094 
+0.703000 -0.201771 
+0.704000 -0.202440 
+0.705000 -0.203102 
+0.706000 -0.203757 
+0.707000 -0.204406 
+0.708000 -0.205047 
+0.709000 -0.205681 
+0.710000 -0.206308 
+0.711000 -0.206928 
+0.712000 -0.207541 
+0.713000 -0.208146 
+0.714000 -0.208744 
+0.715000 -0.209334 
+0.716000 -0.209917 
+0.717000 -0.210493 
+0.718000 -0.211061 
+0.719000 -0.211621 
+0.720000 -0.212174 
+0.721000 -0.212719 
+0.722000 -0.213257 
+0.723000 -0.213786 
+0.724000 -0.214308 
+0.725000 -0.214822 
+0.726000 -0.215328 
+0.727000 -0.215827 
+0.728000 -0.216317 
+0.729000 -0.216799 
+0.730000 -0.217273 
+0.731000 -0.217739 
+0.732000 -0.218197 
+0.733000 -0.218647 
+0.734000 -0.219088 
+0.735000 -0.219521 
+0.736000 -0.219946 
+0.737000 -0.220363 
+0.738000 -0.220771 
+0.739000 -0.221171 
+0.740000 -0.221562 
+0.741000 -0.221945 
+0.742000 -0.222319 
+0.743000 -0.222684 
+0.744000 -0.223041 
+0.745000 -0.223390 
+0.746000 -0.223729 
+0.747000 -0.224060 
+0.748000 -0.224382 
+0.749000 -0.224696 
+0.750000 -0.225000 
+0.751000 -0.225296 
+0.752000 -0.225582 
+0.753000 -0.225860 
+0.754000 -0.226129 
+0.755000 -0.226388 
+0.756000 -0.226639 
+0.757000 -0.226880 
+0.758000 -0.227113 
+0.759000 -0.227336 
+0.760000 -0.227550 
+0.761000 -0.227755 
+0.762000 -0.227951 
+0.763000 -0.228137 
+0.764000 -0.228314 
+0.765000 -0.228481 
+0.766000 -0.228640 
+0.767000 -0.228789 
+0.768000 -0.228928 
+0.769000 -0.229058 
+0.770000 -0.229178 
+0.771000 -0.229289 
+0.772000 -0.229391 
+0.773000 -0.229483 
+0.774000 -0.229565 
+0.775000 -0.229638 
+0.776000 -0.229700 
+0.777000 -0.229754 
+0.778000 -0.229797 
+0.779000 -0.229831 
+0.780000 -0.229855 
+0.781000 -0.229870 
+0.782000 -0.229874 
+0.783000 -0.229869 
+0.784000 -0.229853 
+0.785000 -0.229828 
+0.786000 -0.229793 
+0.787000 -0.229749 
+0.788000 -0.229694 
+0.789000 -0.229629 
+0.790000 -0.229554 
+0.791000 -0.229469 
+0.792000 -0.229375 
+0.793000 -0.229270 
+0.794000 -0.229155 
+0.795000 -0.229030 
+0.796000 -0.228895 
+0.797000 -0.228750 
+0.798000 -0.228595 
+0.799000 -0.228429 
+0.800000 -0.228254 
+0.801000 -0.228068 
+0.802000 -0.227872 
+0.803000 -0.227666 
+0.804000 -0.227449 
+0.805000 -0.227223 
+0.806000 -0.226986 
+0.807000 -0.226739 
+0.808000 -0.226481 
+0.809000 -0.226214 
+0.810000 -0.225936 
+0.811000 -0.225647 
+0.812000 -0.225349 
+0.813000 -0.225040 
+0.814000 -0.224721 
+0.815000 -0.224391 
+0.816000 -0.224051 
+0.817000 -0.223701 
+0.818000 -0.223340 
+0.819000 -0.222969 
+0.820000 -0.222587 
+0.821000 -0.222196 
+0.822000 -0.221793 
+0.823000 -0.221381 
+0.824000 -0.220958 
+0.825000 -0.220524 
+0.826000 -0.220080 
+0.827000 -0.219626 
+0.828000 -0.219161 
+0.829000 -0.218686 
+0.830000 -0.218200 
+0.831000 -0.217704 
+0.832000 -0.217198 
+0.833000 -0.216681 
+0.834000 -0.216154 
+0.835000 -0.215616 
+0.836000 -0.215068 
+0.837000 -0.214509 
+0.838000 -0.213940 
+0.839000 -0.213361 
+0.840000 -0.212771 
+0.841000 -0.212170 
+0.842000 -0.211560 
+0.843000 -0.210938 
+0.844000 -0.210307 
+0.845000 -0.209665 
+0.846000 -0.209013 
+0.847000 -0.208350 
+0.848000 -0.207677 
+0.849000 -0.206993 
+0.850000 -0.206299 
+0.851000 -0.205595 
+0.852000 -0.204881 
+0.853000 -0.204156 
+0.854000 -0.203420 
+0.855000 -0.202675 
+0.856000 -0.201919 
+0.857000 -0.201153 
+0.858000 -0.200376 
+0.859000 -0.199589 
+0.860000 -0.198792 
+0.861000 -0.197985 
+0.862000 -0.197168 
+0.863000 -0.196340 
+0.864000 -0.195502 
+0.865000 -0.194654 
+0.866000 -0.193796 
+0.867000 -0.192927 
+0.868000 -0.192048 
+0.869000 -0.191160 
+0.870000 -0.190261 
+0.871000 -0.189352 
+0.872000 -0.188433 
+0.873000 -0.187504 
+0.874000 -0.186565 
+0.875000 -0.185616 
+0.876000 -0.184656 
+0.877000 -0.183687 
+0.878000 -0.182708 
+0.879000 -0.181719 
+0.880000 -0.180720 
+0.881000 -0.179712 
+0.882000 -0.178693 
+0.883000 -0.177665 
+0.884000 -0.176626 
+0.885000 -0.175578 
+0.886000 -0.174520 
+0.887000 -0.173453 
+0.888000 -0.172376 
+0.889000 -0.171289 
+0.890000 -0.170192 
+0.891000 -0.169086 
+0.892000 -0.167970 
+0.893000 -0.166845 
+0.894000 -0.165710 
+0.895000 -0.164566 
+0.896000 -0.163412 
+0.897000 -0.162248 
+0.898000 -0.161076 
+0.899000 -0.159893 
+0.900000 -0.158702 
+0.901000 -0.157501 
+0.902000 -0.156291 
+0.903000 -0.155072 
+0.904000 -0.153843 
+0.905000 -0.152606 
+0.906000 -0.151359 
+0.907000 -0.150103 
+0.908000 -0.148838 
+0.909000 -0.147564 
+0.910000 -0.146281 
+0.911000 -0.144989 
+0.912000 -0.143688 
+0.913000 -0.142378 
+0.914000 -0.141059 
+0.915000 -0.139732 
+0.916000 -0.138396 
+0.917000 -0.137051 
+0.918000 -0.135697 
+0.919000 -0.134335 
+0.920000 -0.132964 
+0.921000 -0.131585 
+0.922000 -0.130197 
+0.923000 -0.128800 
+0.924000 -0.127396 
+0.925000 -0.125982 
+0.926000 -0.124561 
+0.927000 -0.123131 
+0.928000 -0.121693 
+0.929000 -0.120247 
+0.930000 -0.118792 
+0.931000 -0.117330 
+0.932000 -0.115859 
+0.933000 -0.114381 
+0.934000 -0.112894 
+0.935000 -0.111400 
+0.936000 -0.109898 
+0.937000 -0.108388 
+0.938000 -0.106870 
+0.939000 -0.105344 
+0.940000 -0.103811 
+0.941000 -0.102270 
+0.942000 -0.100722 
+0.943000 -0.099166 
+0.944000 -0.097603 
+0.945000 -0.096032 
+0.946000 -0.094454 
+0.947000 -0.092869 
+0.948000 -0.091276 
+0.949000 -0.089677 
+0.950000 -0.088070 
+0.951000 -0.086456 
+0.952000 -0.084835 
+0.953000 -0.083207 
+0.954000 -0.081573 
+0.955000 -0.079931 
+0.956000 -0.078283 
+0.957000 -0.076628 
+0.958000 -0.074966 
+0.959000 -0.073298 
+0.960000 -0.071623 
+0.961000 -0.069941 
+0.962000 -0.068254 
+0.963000 -0.066560 
+0.964000 -0.064859 
+0.965000 -0.063152 
+0.966000 -0.061440 
+0.967000 -0.059721 
+0.968000 -0.057996 
+0.969000 -0.056265 
+0.970000 -0.054528 
+0.971000 -0.052785 
+0.972000 -0.051037 
+0.973000 -0.049282 
+0.974000 -0.047523 
+0.975000 -0.045757 
+0.976000 -0.043986 
+0.977000 -0.042210 
+0.978000 -0.040428 
+0.979000 -0.038640 
+0.980000 -0.036848 
+0.981000 -0.035050 
+0.982000 -0.033247 
+0.983000 -0.031440 
+0.984000 -0.029627 
+0.985000 -0.027809 
+0.986000 -0.025986 
+0.987000 -0.024159 
+0.988000 -0.022327 
+0.989000 -0.020490 
+0.990000 -0.018649 
+0.991000 -0.016803 
+0.992000 -0.014953 
+0.993000 -0.013098 
+0.994000 -0.011239 
+0.995000 -0.009376 
+0.996000 -0.007509 
+0.997000 -0.005638 
+0.998000 -0.003762 
+0.999000 -0.001883 
+1.000000 -0.000000 
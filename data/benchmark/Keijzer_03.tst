@@ -1,3 +1,6003 @@
 x 
 f(xs) 
 -3.000000 -0.000000 
+-2.999000 -0.005653 
+-2.998000 -0.011302 
+-2.997000 -0.016947 
+-2.996000 -0.022587 
+-2.995000 -0.028223 
+-2.994000 -0.033853 
+-2.993000 -0.039479 
+-2.992000 -0.045099 
+-2.991000 -0.050714 
+-2.990000 -0.056323 
+-2.989000 -0.061926 
+-2.988000 -0.067523 
+-2.987000 -0.073113 
+-2.986000 -0.078697 
+-2.985000 -0.084274 
+-2.984000 -0.089844 
+-2.983000 -0.095406 
+-2.982000 -0.100961 
+-2.981000 -0.106509 
+-2.980000 -0.112048 
+-2.979000 -0.117579 
+-2.978000 -0.123102 
+-2.977000 -0.128616 
+-2.976000 -0.134121 
+-2.975000 -0.139618 
+-2.974000 -0.145105 
+-2.973000 -0.150583 
+-2.972000 -0.156051 
+-2.971000 -0.161509 
+-2.970000 -0.166957 
+-2.969000 -0.172395 
+-2.968000 -0.177822 
+-2.967000 -0.183238 
+-2.966000 -0.188644 
+-2.965000 -0.194038 
+-2.964000 -0.199422 
+-2.963000 -0.204793 
+-2.962000 -0.210153 
+-2.961000 -0.215501 
+-2.960000 -0.220837 
+-2.959000 -0.226160 
+-2.958000 -0.231471 
+-2.957000 -0.236769 
+-2.956000 -0.242054 
+-2.955000 -0.247326 
+-2.954000 -0.252584 
+-2.953000 -0.257829 
+-2.952000 -0.263060 
+-2.951000 -0.268277 
+-2.950000 -0.273480 
+-2.949000 -0.278669 
+-2.948000 -0.283843 
+-2.947000 -0.289002 
+-2.946000 -0.294146 
+-2.945000 -0.299275 
+-2.944000 -0.304389 
+-2.943000 -0.309487 
+-2.942000 -0.314569 
+-2.941000 -0.319636 
+-2.940000 -0.324686 
+-2.939000 -0.329720 
+-2.938000 -0.334737 
+-2.937000 -0.339738 
+-2.936000 -0.344722 
+-2.935000 -0.349689 
+-2.934000 -0.354638 
+-2.933000 -0.359570 
+-2.932000 -0.364485 
+-2.931000 -0.369381 
+-2.930000 -0.374260 
+-2.929000 -0.379120 
+-2.928000 -0.383962 
+-2.927000 -0.388786 
+-2.926000 -0.393591 
+-2.925000 -0.398377 
+-2.924000 -0.403143 
+-2.923000 -0.407891 
+-2.922000 -0.412619 
+-2.921000 -0.417328 
+-2.920000 -0.422016 
+-2.919000 -0.426685 
+-2.918000 -0.431334 
+-2.917000 -0.435962 
+-2.916000 -0.440570 
+-2.915000 -0.445157 
+-2.914000 -0.449723 
+-2.913000 -0.454268 
+-2.912000 -0.458793 
+-2.911000 -0.463295 
+-2.910000 -0.467777 
+-2.909000 -0.472237 
+-2.908000 -0.476674 
+-2.907000 -0.481090 
+-2.906000 -0.485484 
+-2.905000 -0.489856 
+-2.904000 -0.494205 
+-2.903000 -0.498531 
+-2.902000 -0.502835 
+-2.901000 -0.507116 
+-2.900000 -0.511373 
+-2.899000 -0.515608 
+-2.898000 -0.519819 
+-2.897000 -0.524006 
+-2.896000 -0.528170 
+-2.895000 -0.532310 
+-2.894000 -0.536426 
+-2.893000 -0.540518 
+-2.892000 -0.544585 
+-2.891000 -0.548628 
+-2.890000 -0.552647 
+-2.889000 -0.556640 
+-2.888000 -0.560609 
+-2.887000 -0.564553 
+-2.886000 -0.568472 
+-2.885000 -0.572365 
+-2.884000 -0.576233 
+-2.883000 -0.580076 
+-2.882000 -0.583893 
+-2.881000 -0.587684 
+-2.880000 -0.591449 
+-2.879000 -0.595188 
+-2.878000 -0.598900 
+-2.877000 -0.602587 
+-2.876000 -0.606246 
+-2.875000 -0.609880 
+-2.874000 -0.613486 
+-2.873000 -0.617066 
+-2.872000 -0.620618 
+-2.871000 -0.624144 
+-2.870000 -0.627642 
+-2.869000 -0.631113 
+-2.868000 -0.634556 
+-2.867000 -0.637972 
+-2.866000 -0.641360 
+-2.865000 -0.644720 
+-2.864000 -0.648053 
+-2.863000 -0.651357 
+-2.862000 -0.654633 
+-2.861000 -0.657881 
+-2.860000 -0.661100 
+-2.859000 -0.664291 
+-2.858000 -0.667454 
+-2.857000 -0.670587 
+-2.856000 -0.673692 
+-2.855000 -0.676768 
+-2.854000 -0.679815 
+-2.853000 -0.682832 
+-2.852000 -0.685821 
+-2.851000 -0.688780 
+-2.850000 -0.691710 
+-2.849000 -0.694610 
+-2.848000 -0.697480 
+-2.847000 -0.700321 
+-2.846000 -0.703132 
+-2.845000 -0.705913 
+-2.844000 -0.708664 
+-2.843000 -0.711385 
+-2.842000 -0.714076 
+-2.841000 -0.716737 
+-2.840000 -0.719367 
+-2.839000 -0.721967 
+-2.838000 -0.724537 
+-2.837000 -0.727076 
+-2.836000 -0.729584 
+-2.835000 -0.732061 
+-2.834000 -0.734508 
+-2.833000 -0.736923 
+-2.832000 -0.739308 
+-2.831000 -0.741662 
+-2.830000 -0.743984 
+-2.829000 -0.746276 
+-2.828000 -0.748536 
+-2.827000 -0.750765 
+-2.826000 -0.752962 
+-2.825000 -0.755128 
+-2.824000 -0.757262 
+-2.823000 -0.759365 
+-2.822000 -0.761436 
+-2.821000 -0.763476 
+-2.820000 -0.765484 
+-2.819000 -0.767460 
+-2.818000 -0.769404 
+-2.817000 -0.771316 
+-2.816000 -0.773196 
+-2.815000 -0.775044 
+-2.814000 -0.776860 
+-2.813000 -0.778644 
+-2.812000 -0.780395 
+-2.811000 -0.782114 
+-2.810000 -0.783802 
+-2.809000 -0.785456 
+-2.808000 -0.787079 
+-2.807000 -0.788669 
+-2.806000 -0.790226 
+-2.805000 -0.791751 
+-2.804000 -0.793244 
+-2.803000 -0.794704 
+-2.802000 -0.796131 
+-2.801000 -0.797525 
+-2.800000 -0.798887 
+-2.799000 -0.800217 
+-2.798000 -0.801513 
+-2.797000 -0.802777 
+-2.796000 -0.804008 
+-2.795000 -0.805206 
+-2.794000 -0.806372 
+-2.793000 -0.807504 
+-2.792000 -0.808604 
+-2.791000 -0.809670 
+-2.790000 -0.810704 
+-2.789000 -0.811705 
+-2.788000 -0.812673 
+-2.787000 -0.813608 
+-2.786000 -0.814510 
+-2.785000 -0.815378 
+-2.784000 -0.816214 
+-2.783000 -0.817017 
+-2.782000 -0.817787 
+-2.781000 -0.818524 
+-2.780000 -0.819228 
+-2.779000 -0.819898 
+-2.778000 -0.820536 
+-2.777000 -0.821141 
+-2.776000 -0.821712 
+-2.775000 -0.822251 
+-2.774000 -0.822756 
+-2.773000 -0.823228 
+-2.772000 -0.823668 
+-2.771000 -0.824074 
+-2.770000 -0.824447 
+-2.769000 -0.824788 
+-2.768000 -0.825095 
+-2.767000 -0.825369 
+-2.766000 -0.825610 
+-2.765000 -0.825819 
+-2.764000 -0.825994 
+-2.763000 -0.826136 
+-2.762000 -0.826246 
+-2.761000 -0.826322 
+-2.760000 -0.826366 
+-2.759000 -0.826377 
+-2.758000 -0.826355 
+-2.757000 -0.826300 
+-2.756000 -0.826213 
+-2.755000 -0.826092 
+-2.754000 -0.825939 
+-2.753000 -0.825753 
+-2.752000 -0.825535 
+-2.751000 -0.825284 
+-2.750000 -0.825000 
+-2.749000 -0.824684 
+-2.748000 -0.824335 
+-2.747000 -0.823954 
+-2.746000 -0.823540 
+-2.745000 -0.823094 
+-2.744000 -0.822615 
+-2.743000 -0.822104 
+-2.742000 -0.821561 
+-2.741000 -0.820986 
+-2.740000 -0.820378 
+-2.739000 -0.819738 
+-2.738000 -0.819066 
+-2.737000 -0.818362 
+-2.736000 -0.817626 
+-2.735000 -0.816859 
+-2.734000 -0.816059 
+-2.733000 -0.815227 
+-2.732000 -0.814364 
+-2.731000 -0.813469 
+-2.730000 -0.812542 
+-2.729000 -0.811584 
+-2.728000 -0.810594 
+-2.727000 -0.809572 
+-2.726000 -0.808519 
+-2.725000 -0.807435 
+-2.724000 -0.806320 
+-2.723000 -0.805173 
+-2.722000 -0.803995 
+-2.721000 -0.802786 
+-2.720000 -0.801546 
+-2.719000 -0.800276 
+-2.718000 -0.798974 
+-2.717000 -0.797641 
+-2.716000 -0.796278 
+-2.715000 -0.794884 
+-2.714000 -0.793460 
+-2.713000 -0.792005 
+-2.712000 -0.790520 
+-2.711000 -0.789004 
+-2.710000 -0.787458 
+-2.709000 -0.785882 
+-2.708000 -0.784276 
+-2.707000 -0.782640 
+-2.706000 -0.780974 
+-2.705000 -0.779278 
+-2.704000 -0.777553 
+-2.703000 -0.775798 
+-2.702000 -0.774013 
+-2.701000 -0.772199 
+-2.700000 -0.770356 
+-2.699000 -0.768483 
+-2.698000 -0.766581 
+-2.697000 -0.764651 
+-2.696000 -0.762691 
+-2.695000 -0.760702 
+-2.694000 -0.758685 
+-2.693000 -0.756639 
+-2.692000 -0.754564 
+-2.691000 -0.752461 
+-2.690000 -0.750330 
+-2.689000 -0.748170 
+-2.688000 -0.745982 
+-2.687000 -0.743766 
+-2.686000 -0.741523 
+-2.685000 -0.739251 
+-2.684000 -0.736952 
+-2.683000 -0.734625 
+-2.682000 -0.732271 
+-2.681000 -0.729890 
+-2.680000 -0.727481 
+-2.679000 -0.725045 
+-2.678000 -0.722582 
+-2.677000 -0.720092 
+-2.676000 -0.717576 
+-2.675000 -0.715033 
+-2.674000 -0.712463 
+-2.673000 -0.709867 
+-2.672000 -0.707245 
+-2.671000 -0.704596 
+-2.670000 -0.701922 
+-2.669000 -0.699221 
+-2.668000 -0.696495 
+-2.667000 -0.693743 
+-2.666000 -0.690966 
+-2.665000 -0.688163 
+-2.664000 -0.685335 
+-2.663000 -0.682482 
+-2.662000 -0.679604 
+-2.661000 -0.676701 
+-2.660000 -0.673774 
+-2.659000 -0.670821 
+-2.658000 -0.667845 
+-2.657000 -0.664844 
+-2.656000 -0.661819 
+-2.655000 -0.658770 
+-2.654000 -0.655697 
+-2.653000 -0.652600 
+-2.652000 -0.649480 
+-2.651000 -0.646336 
+-2.650000 -0.643169 
+-2.649000 -0.639978 
+-2.648000 -0.636765 
+-2.647000 -0.633529 
+-2.646000 -0.630270 
+-2.645000 -0.626988 
+-2.644000 -0.623684 
+-2.643000 -0.620358 
+-2.642000 -0.617009 
+-2.641000 -0.613639 
+-2.640000 -0.610246 
+-2.639000 -0.606833 
+-2.638000 -0.603397 
+-2.637000 -0.599940 
+-2.636000 -0.596462 
+-2.635000 -0.592963 
+-2.634000 -0.589443 
+-2.633000 -0.585902 
+-2.632000 -0.582340 
+-2.631000 -0.578758 
+-2.630000 -0.575156 
+-2.629000 -0.571534 
+-2.628000 -0.567892 
+-2.627000 -0.564230 
+-2.626000 -0.560548 
+-2.625000 -0.556847 
+-2.624000 -0.553126 
+-2.623000 -0.549386 
+-2.622000 -0.545628 
+-2.621000 -0.541850 
+-2.620000 -0.538054 
+-2.619000 -0.534239 
+-2.618000 -0.530406 
+-2.617000 -0.526555 
+-2.616000 -0.522686 
+-2.615000 -0.518799 
+-2.614000 -0.514895 
+-2.613000 -0.510972 
+-2.612000 -0.507033 
+-2.611000 -0.503077 
+-2.610000 -0.499103 
+-2.609000 -0.495113 
+-2.608000 -0.491106 
+-2.607000 -0.487082 
+-2.606000 -0.483043 
+-2.605000 -0.478987 
+-2.604000 -0.474915 
+-2.603000 -0.470828 
+-2.602000 -0.466725 
+-2.601000 -0.462606 
+-2.600000 -0.458472 
+-2.599000 -0.454324 
+-2.598000 -0.450160 
+-2.597000 -0.445982 
+-2.596000 -0.441789 
+-2.595000 -0.437582 
+-2.594000 -0.433361 
+-2.593000 -0.429125 
+-2.592000 -0.424876 
+-2.591000 -0.420614 
+-2.590000 -0.416337 
+-2.589000 -0.412048 
+-2.588000 -0.407746 
+-2.587000 -0.403430 
+-2.586000 -0.399102 
+-2.585000 -0.394762 
+-2.584000 -0.390409 
+-2.583000 -0.386044 
+-2.582000 -0.381667 
+-2.581000 -0.377278 
+-2.580000 -0.372877 
+-2.579000 -0.368465 
+-2.578000 -0.364042 
+-2.577000 -0.359608 
+-2.576000 -0.355163 
+-2.575000 -0.350708 
+-2.574000 -0.346242 
+-2.573000 -0.341765 
+-2.572000 -0.337279 
+-2.571000 -0.332782 
+-2.570000 -0.328276 
+-2.569000 -0.323760 
+-2.568000 -0.319235 
+-2.567000 -0.314701 
+-2.566000 -0.310157 
+-2.565000 -0.305605 
+-2.564000 -0.301045 
+-2.563000 -0.296476 
+-2.562000 -0.291898 
+-2.561000 -0.287313 
+-2.560000 -0.282720 
+-2.559000 -0.278119 
+-2.558000 -0.273510 
+-2.557000 -0.268895 
+-2.556000 -0.264272 
+-2.555000 -0.259643 
+-2.554000 -0.255006 
+-2.553000 -0.250364 
+-2.552000 -0.245714 
+-2.551000 -0.241059 
+-2.550000 -0.236398 
+-2.549000 -0.231731 
+-2.548000 -0.227059 
+-2.547000 -0.222381 
+-2.546000 -0.217698 
+-2.545000 -0.213010 
+-2.544000 -0.208317 
+-2.543000 -0.203620 
+-2.542000 -0.198918 
+-2.541000 -0.194212 
+-2.540000 -0.189502 
+-2.539000 -0.184788 
+-2.538000 -0.180070 
+-2.537000 -0.175349 
+-2.536000 -0.170625 
+-2.535000 -0.165898 
+-2.534000 -0.161168 
+-2.533000 -0.156435 
+-2.532000 -0.151700 
+-2.531000 -0.146962 
+-2.530000 -0.142222 
+-2.529000 -0.137481 
+-2.528000 -0.132737 
+-2.527000 -0.127993 
+-2.526000 -0.123246 
+-2.525000 -0.118499 
+-2.524000 -0.113751 
+-2.523000 -0.109002 
+-2.522000 -0.104252 
+-2.521000 -0.099502 
+-2.520000 -0.094752 
+-2.519000 -0.090002 
+-2.518000 -0.085252 
+-2.517000 -0.080502 
+-2.516000 -0.075753 
+-2.515000 -0.071005 
+-2.514000 -0.066257 
+-2.513000 -0.061511 
+-2.512000 -0.056766 
+-2.511000 -0.052023 
+-2.510000 -0.047281 
+-2.509000 -0.042542 
+-2.508000 -0.037804 
+-2.507000 -0.033068 
+-2.506000 -0.028335 
+-2.505000 -0.023605 
+-2.504000 -0.018878 
+-2.503000 -0.014153 
+-2.502000 -0.009432 
+-2.501000 -0.004714 
+-2.500000 0.000000 
+-2.499000 0.004710 
+-2.498000 0.009417 
+-2.497000 0.014119 
+-2.496000 0.018817 
+-2.495000 0.023511 
+-2.494000 0.028200 
+-2.493000 0.032884 
+-2.492000 0.037563 
+-2.491000 0.042236 
+-2.490000 0.046905 
+-2.489000 0.051567 
+-2.488000 0.056224 
+-2.487000 0.060875 
+-2.486000 0.065519 
+-2.485000 0.070158 
+-2.484000 0.074790 
+-2.483000 0.079415 
+-2.482000 0.084033 
+-2.481000 0.088644 
+-2.480000 0.093248 
+-2.479000 0.097844 
+-2.478000 0.102433 
+-2.477000 0.107014 
+-2.476000 0.111588 
+-2.475000 0.116153 
+-2.474000 0.120709 
+-2.473000 0.125258 
+-2.472000 0.129797 
+-2.471000 0.134328 
+-2.470000 0.138850 
+-2.469000 0.143362 
+-2.468000 0.147865 
+-2.467000 0.152359 
+-2.466000 0.156843 
+-2.465000 0.161317 
+-2.464000 0.165781 
+-2.463000 0.170235 
+-2.462000 0.174678 
+-2.461000 0.179111 
+-2.460000 0.183533 
+-2.459000 0.187944 
+-2.458000 0.192345 
+-2.457000 0.196733 
+-2.456000 0.201111 
+-2.455000 0.205477 
+-2.454000 0.209831 
+-2.453000 0.214174 
+-2.452000 0.218504 
+-2.451000 0.222822 
+-2.450000 0.227127 
+-2.449000 0.231421 
+-2.448000 0.235701 
+-2.447000 0.239969 
+-2.446000 0.244223 
+-2.445000 0.248464 
+-2.444000 0.252692 
+-2.443000 0.256907 
+-2.442000 0.261107 
+-2.441000 0.265294 
+-2.440000 0.269467 
+-2.439000 0.273626 
+-2.438000 0.277770 
+-2.437000 0.281900 
+-2.436000 0.286016 
+-2.435000 0.290117 
+-2.434000 0.294202 
+-2.433000 0.298273 
+-2.432000 0.302328 
+-2.431000 0.306369 
+-2.430000 0.310393 
+-2.429000 0.314402 
+-2.428000 0.318395 
+-2.427000 0.322372 
+-2.426000 0.326333 
+-2.425000 0.330278 
+-2.424000 0.334206 
+-2.423000 0.338118 
+-2.422000 0.342013 
+-2.421000 0.345892 
+-2.420000 0.349753 
+-2.419000 0.353597 
+-2.418000 0.357424 
+-2.417000 0.361234 
+-2.416000 0.365026 
+-2.415000 0.368801 
+-2.414000 0.372557 
+-2.413000 0.376296 
+-2.412000 0.380016 
+-2.411000 0.383719 
+-2.410000 0.387403 
+-2.409000 0.391068 
+-2.408000 0.394715 
+-2.407000 0.398343 
+-2.406000 0.401953 
+-2.405000 0.405543 
+-2.404000 0.409114 
+-2.403000 0.412666 
+-2.402000 0.416199 
+-2.401000 0.419712 
+-2.400000 0.423205 
+-2.399000 0.426679 
+-2.398000 0.430133 
+-2.397000 0.433567 
+-2.396000 0.436980 
+-2.395000 0.440374 
+-2.394000 0.443747 
+-2.393000 0.447099 
+-2.392000 0.450431 
+-2.391000 0.453743 
+-2.390000 0.457033 
+-2.389000 0.460302 
+-2.388000 0.463551 
+-2.387000 0.466778 
+-2.386000 0.469984 
+-2.385000 0.473169 
+-2.384000 0.476332 
+-2.383000 0.479473 
+-2.382000 0.482593 
+-2.381000 0.485691 
+-2.380000 0.488767 
+-2.379000 0.491821 
+-2.378000 0.494852 
+-2.377000 0.497862 
+-2.376000 0.500849 
+-2.375000 0.503814 
+-2.374000 0.506756 
+-2.373000 0.509675 
+-2.372000 0.512572 
+-2.371000 0.515446 
+-2.370000 0.518297 
+-2.369000 0.521125 
+-2.368000 0.523929 
+-2.367000 0.526711 
+-2.366000 0.529469 
+-2.365000 0.532204 
+-2.364000 0.534915 
+-2.363000 0.537603 
+-2.362000 0.540267 
+-2.361000 0.542907 
+-2.360000 0.545523 
+-2.359000 0.548116 
+-2.358000 0.550684 
+-2.357000 0.553229 
+-2.356000 0.555749 
+-2.355000 0.558245 
+-2.354000 0.560716 
+-2.353000 0.563163 
+-2.352000 0.565586 
+-2.351000 0.567984 
+-2.350000 0.570357 
+-2.349000 0.572706 
+-2.348000 0.575029 
+-2.347000 0.577328 
+-2.346000 0.579602 
+-2.345000 0.581851 
+-2.344000 0.584075 
+-2.343000 0.586274 
+-2.342000 0.588447 
+-2.341000 0.590595 
+-2.340000 0.592718 
+-2.339000 0.594816 
+-2.338000 0.596888 
+-2.337000 0.598934 
+-2.336000 0.600955 
+-2.335000 0.602950 
+-2.334000 0.604919 
+-2.333000 0.606863 
+-2.332000 0.608781 
+-2.331000 0.610672 
+-2.330000 0.612538 
+-2.329000 0.614378 
+-2.328000 0.616192 
+-2.327000 0.617980 
+-2.326000 0.619742 
+-2.325000 0.621477 
+-2.324000 0.623186 
+-2.323000 0.624869 
+-2.322000 0.626526 
+-2.321000 0.628156 
+-2.320000 0.629760 
+-2.319000 0.631337 
+-2.318000 0.632888 
+-2.317000 0.634412 
+-2.316000 0.635910 
+-2.315000 0.637381 
+-2.314000 0.638825 
+-2.313000 0.640243 
+-2.312000 0.641634 
+-2.311000 0.642998 
+-2.310000 0.644335 
+-2.309000 0.645646 
+-2.308000 0.646929 
+-2.307000 0.648186 
+-2.306000 0.649416 
+-2.305000 0.650619 
+-2.304000 0.651795 
+-2.303000 0.652944 
+-2.302000 0.654066 
+-2.301000 0.655161 
+-2.300000 0.656229 
+-2.299000 0.657270 
+-2.298000 0.658284 
+-2.297000 0.659270 
+-2.296000 0.660230 
+-2.295000 0.661162 
+-2.294000 0.662067 
+-2.293000 0.662946 
+-2.292000 0.663796 
+-2.291000 0.664620 
+-2.290000 0.665417 
+-2.289000 0.666186 
+-2.288000 0.666928 
+-2.287000 0.667643 
+-2.286000 0.668331 
+-2.285000 0.668991 
+-2.284000 0.669624 
+-2.283000 0.670230 
+-2.282000 0.670809 
+-2.281000 0.671360 
+-2.280000 0.671884 
+-2.279000 0.672381 
+-2.278000 0.672851 
+-2.277000 0.673294 
+-2.276000 0.673709 
+-2.275000 0.674097 
+-2.274000 0.674458 
+-2.273000 0.674792 
+-2.272000 0.675099 
+-2.271000 0.675378 
+-2.270000 0.675630 
+-2.269000 0.675855 
+-2.268000 0.676053 
+-2.267000 0.676224 
+-2.266000 0.676368 
+-2.265000 0.676484 
+-2.264000 0.676574 
+-2.263000 0.676636 
+-2.262000 0.676672 
+-2.261000 0.676681 
+-2.260000 0.676662 
+-2.259000 0.676617 
+-2.258000 0.676544 
+-2.257000 0.676445 
+-2.256000 0.676319 
+-2.255000 0.676166 
+-2.254000 0.675986 
+-2.253000 0.675780 
+-2.252000 0.675547 
+-2.251000 0.675287 
+-2.250000 0.675000 
+-2.249000 0.674687 
+-2.248000 0.674347 
+-2.247000 0.673980 
+-2.246000 0.673587 
+-2.245000 0.673168 
+-2.244000 0.672722 
+-2.243000 0.672249 
+-2.242000 0.671750 
+-2.241000 0.671225 
+-2.240000 0.670674 
+-2.239000 0.670096 
+-2.238000 0.669492 
+-2.237000 0.668863 
+-2.236000 0.668206 
+-2.235000 0.667524 
+-2.234000 0.666816 
+-2.233000 0.666082 
+-2.232000 0.665322 
+-2.231000 0.664536 
+-2.230000 0.663725 
+-2.229000 0.662887 
+-2.228000 0.662024 
+-2.227000 0.661136 
+-2.226000 0.660222 
+-2.225000 0.659282 
+-2.224000 0.658317 
+-2.223000 0.657326 
+-2.222000 0.656311 
+-2.221000 0.655270 
+-2.220000 0.654203 
+-2.219000 0.653112 
+-2.218000 0.651996 
+-2.217000 0.650854 
+-2.216000 0.649688 
+-2.215000 0.648497 
+-2.214000 0.647281 
+-2.213000 0.646040 
+-2.212000 0.644775 
+-2.211000 0.643485 
+-2.210000 0.642171 
+-2.209000 0.640832 
+-2.208000 0.639469 
+-2.207000 0.638081 
+-2.206000 0.636670 
+-2.205000 0.635234 
+-2.204000 0.633775 
+-2.203000 0.632291 
+-2.202000 0.630784 
+-2.201000 0.629252 
+-2.200000 0.627697 
+-2.199000 0.626119 
+-2.198000 0.624517 
+-2.197000 0.622891 
+-2.196000 0.621242 
+-2.195000 0.619570 
+-2.194000 0.617875 
+-2.193000 0.616156 
+-2.192000 0.614415 
+-2.191000 0.612650 
+-2.190000 0.610863 
+-2.189000 0.609053 
+-2.188000 0.607221 
+-2.187000 0.605366 
+-2.186000 0.603488 
+-2.185000 0.601588 
+-2.184000 0.599666 
+-2.183000 0.597722 
+-2.182000 0.595755 
+-2.181000 0.593767 
+-2.180000 0.591757 
+-2.179000 0.589725 
+-2.178000 0.587671 
+-2.177000 0.585596 
+-2.176000 0.583500 
+-2.175000 0.581382 
+-2.174000 0.579243 
+-2.173000 0.577082 
+-2.172000 0.574901 
+-2.171000 0.572699 
+-2.170000 0.570476 
+-2.169000 0.568232 
+-2.168000 0.565968 
+-2.167000 0.563683 
+-2.166000 0.561377 
+-2.165000 0.559052 
+-2.164000 0.556706 
+-2.163000 0.554341 
+-2.162000 0.551955 
+-2.161000 0.549550 
+-2.160000 0.547124 
+-2.159000 0.544680 
+-2.158000 0.542216 
+-2.157000 0.539732 
+-2.156000 0.537229 
+-2.155000 0.534708 
+-2.154000 0.532167 
+-2.153000 0.529607 
+-2.152000 0.527029 
+-2.151000 0.524432 
+-2.150000 0.521816 
+-2.149000 0.519182 
+-2.148000 0.516530 
+-2.147000 0.513859 
+-2.146000 0.511171 
+-2.145000 0.508465 
+-2.144000 0.505741 
+-2.143000 0.502999 
+-2.142000 0.500240 
+-2.141000 0.497463 
+-2.140000 0.494670 
+-2.139000 0.491859 
+-2.138000 0.489031 
+-2.137000 0.486186 
+-2.136000 0.483324 
+-2.135000 0.480446 
+-2.134000 0.477552 
+-2.133000 0.474641 
+-2.132000 0.471713 
+-2.131000 0.468770 
+-2.130000 0.465811 
+-2.129000 0.462836 
+-2.128000 0.459845 
+-2.127000 0.456839 
+-2.126000 0.453817 
+-2.125000 0.450781 
+-2.124000 0.447729 
+-2.123000 0.444662 
+-2.122000 0.441580 
+-2.121000 0.438483 
+-2.120000 0.435372 
+-2.119000 0.432246 
+-2.118000 0.429106 
+-2.117000 0.425952 
+-2.116000 0.422784 
+-2.115000 0.419602 
+-2.114000 0.416407 
+-2.113000 0.413197 
+-2.112000 0.409975 
+-2.111000 0.406739 
+-2.110000 0.403489 
+-2.109000 0.400227 
+-2.108000 0.396952 
+-2.107000 0.393664 
+-2.106000 0.390364 
+-2.105000 0.387051 
+-2.104000 0.383726 
+-2.103000 0.380388 
+-2.102000 0.377039 
+-2.101000 0.373678 
+-2.100000 0.370305 
+-2.099000 0.366920 
+-2.098000 0.363524 
+-2.097000 0.360117 
+-2.096000 0.356699 
+-2.095000 0.353269 
+-2.094000 0.349829 
+-2.093000 0.346378 
+-2.092000 0.342917 
+-2.091000 0.339445 
+-2.090000 0.335963 
+-2.089000 0.332471 
+-2.088000 0.328969 
+-2.087000 0.325458 
+-2.086000 0.321936 
+-2.085000 0.318405 
+-2.084000 0.314865 
+-2.083000 0.311316 
+-2.082000 0.307757 
+-2.081000 0.304190 
+-2.080000 0.300614 
+-2.079000 0.297030 
+-2.078000 0.293437 
+-2.077000 0.289836 
+-2.076000 0.286226 
+-2.075000 0.282609 
+-2.074000 0.278984 
+-2.073000 0.275351 
+-2.072000 0.271711 
+-2.071000 0.268064 
+-2.070000 0.264409 
+-2.069000 0.260747 
+-2.068000 0.257079 
+-2.067000 0.253403 
+-2.066000 0.249721 
+-2.065000 0.246033 
+-2.064000 0.242339 
+-2.063000 0.238638 
+-2.062000 0.234931 
+-2.061000 0.231219 
+-2.060000 0.227501 
+-2.059000 0.223777 
+-2.058000 0.220049 
+-2.057000 0.216315 
+-2.056000 0.212576 
+-2.055000 0.208832 
+-2.054000 0.205083 
+-2.053000 0.201330 
+-2.052000 0.197573 
+-2.051000 0.193811 
+-2.050000 0.190045 
+-2.049000 0.186276 
+-2.048000 0.182502 
+-2.047000 0.178725 
+-2.046000 0.174945 
+-2.045000 0.171161 
+-2.044000 0.167374 
+-2.043000 0.163584 
+-2.042000 0.159792 
+-2.041000 0.155996 
+-2.040000 0.152198 
+-2.039000 0.148398 
+-2.038000 0.144595 
+-2.037000 0.140791 
+-2.036000 0.136985 
+-2.035000 0.133176 
+-2.034000 0.129367 
+-2.033000 0.125556 
+-2.032000 0.121743 
+-2.031000 0.117930 
+-2.030000 0.114115 
+-2.029000 0.110300 
+-2.028000 0.106484 
+-2.027000 0.102668 
+-2.026000 0.098851 
+-2.025000 0.095034 
+-2.024000 0.091217 
+-2.023000 0.087400 
+-2.022000 0.083584 
+-2.021000 0.079767 
+-2.020000 0.075952 
+-2.019000 0.072137 
+-2.018000 0.068323 
+-2.017000 0.064510 
+-2.016000 0.060699 
+-2.015000 0.056888 
+-2.014000 0.053080 
+-2.013000 0.049273 
+-2.012000 0.045467 
+-2.011000 0.041664 
+-2.010000 0.037863 
+-2.009000 0.034064 
+-2.008000 0.030267 
+-2.007000 0.026473 
+-2.006000 0.022682 
+-2.005000 0.018894 
+-2.004000 0.015108 
+-2.003000 0.011326 
+-2.002000 0.007547 
+-2.001000 0.003772 
+-2.000000 -0.000000 
+-1.999000 -0.003768 
+-1.998000 -0.007532 
+-1.997000 -0.011292 
+-1.996000 -0.015048 
+-1.995000 -0.018799 
+-1.994000 -0.022546 
+-1.993000 -0.026289 
+-1.992000 -0.030026 
+-1.991000 -0.033759 
+-1.990000 -0.037486 
+-1.989000 -0.041208 
+-1.988000 -0.044925 
+-1.987000 -0.048636 
+-1.986000 -0.052342 
+-1.985000 -0.056042 
+-1.984000 -0.059735 
+-1.983000 -0.063423 
+-1.982000 -0.067104 
+-1.981000 -0.070779 
+-1.980000 -0.074448 
+-1.979000 -0.078110 
+-1.978000 -0.081765 
+-1.977000 -0.085413 
+-1.976000 -0.089054 
+-1.975000 -0.092687 
+-1.974000 -0.096314 
+-1.973000 -0.099933 
+-1.972000 -0.103544 
+-1.971000 -0.107147 
+-1.970000 -0.110742 
+-1.969000 -0.114330 
+-1.968000 -0.117909 
+-1.967000 -0.121480 
+-1.966000 -0.125042 
+-1.965000 -0.128595 
+-1.964000 -0.132140 
+-1.963000 -0.135676 
+-1.962000 -0.139203 
+-1.961000 -0.142721 
+-1.960000 -0.146230 
+-1.959000 -0.149729 
+-1.958000 -0.153218 
+-1.957000 -0.156698 
+-1.956000 -0.160168 
+-1.955000 -0.163628 
+-1.954000 -0.167078 
+-1.953000 -0.170518 
+-1.952000 -0.173948 
+-1.951000 -0.177367 
+-1.950000 -0.180775 
+-1.949000 -0.184173 
+-1.948000 -0.187559 
+-1.947000 -0.190935 
+-1.946000 -0.194300 
+-1.945000 -0.197654 
+-1.944000 -0.200996 
+-1.943000 -0.204326 
+-1.942000 -0.207646 
+-1.941000 -0.210953 
+-1.940000 -0.214248 
+-1.939000 -0.217532 
+-1.938000 -0.220804 
+-1.937000 -0.224063 
+-1.936000 -0.227310 
+-1.935000 -0.230544 
+-1.934000 -0.233766 
+-1.933000 -0.236976 
+-1.932000 -0.240172 
+-1.931000 -0.243356 
+-1.930000 -0.246526 
+-1.929000 -0.249684 
+-1.928000 -0.252828 
+-1.927000 -0.255959 
+-1.926000 -0.259076 
+-1.925000 -0.262180 
+-1.924000 -0.265269 
+-1.923000 -0.268346 
+-1.922000 -0.271408 
+-1.921000 -0.274456 
+-1.920000 -0.277490 
+-1.919000 -0.280510 
+-1.918000 -0.283515 
+-1.917000 -0.286506 
+-1.916000 -0.289483 
+-1.915000 -0.292444 
+-1.914000 -0.295391 
+-1.913000 -0.298323 
+-1.912000 -0.301240 
+-1.911000 -0.304142 
+-1.910000 -0.307029 
+-1.909000 -0.309900 
+-1.908000 -0.312756 
+-1.907000 -0.315597 
+-1.906000 -0.318421 
+-1.905000 -0.321231 
+-1.904000 -0.324024 
+-1.903000 -0.326802 
+-1.902000 -0.329563 
+-1.901000 -0.332308 
+-1.900000 -0.335038 
+-1.899000 -0.337751 
+-1.898000 -0.340447 
+-1.897000 -0.343127 
+-1.896000 -0.345791 
+-1.895000 -0.348438 
+-1.894000 -0.351068 
+-1.893000 -0.353681 
+-1.892000 -0.356278 
+-1.891000 -0.358857 
+-1.890000 -0.361419 
+-1.889000 -0.363965 
+-1.888000 -0.366492 
+-1.887000 -0.369003 
+-1.886000 -0.371496 
+-1.885000 -0.373972 
+-1.884000 -0.376430 
+-1.883000 -0.378870 
+-1.882000 -0.381293 
+-1.881000 -0.383698 
+-1.880000 -0.386085 
+-1.879000 -0.388453 
+-1.878000 -0.390804 
+-1.877000 -0.393137 
+-1.876000 -0.395451 
+-1.875000 -0.397748 
+-1.874000 -0.400025 
+-1.873000 -0.402285 
+-1.872000 -0.404526 
+-1.871000 -0.406748 
+-1.870000 -0.408951 
+-1.869000 -0.411136 
+-1.868000 -0.413302 
+-1.867000 -0.415450 
+-1.866000 -0.417578 
+-1.865000 -0.419687 
+-1.864000 -0.421777 
+-1.863000 -0.423848 
+-1.862000 -0.425900 
+-1.861000 -0.427933 
+-1.860000 -0.429946 
+-1.859000 -0.431940 
+-1.858000 -0.433915 
+-1.857000 -0.435870 
+-1.856000 -0.437805 
+-1.855000 -0.439721 
+-1.854000 -0.441617 
+-1.853000 -0.443494 
+-1.852000 -0.445351 
+-1.851000 -0.447187 
+-1.850000 -0.449004 
+-1.849000 -0.450801 
+-1.848000 -0.452578 
+-1.847000 -0.454335 
+-1.846000 -0.456072 
+-1.845000 -0.457789 
+-1.844000 -0.459486 
+-1.843000 -0.461162 
+-1.842000 -0.462818 
+-1.841000 -0.464454 
+-1.840000 -0.466069 
+-1.839000 -0.467664 
+-1.838000 -0.469238 
+-1.837000 -0.470792 
+-1.836000 -0.472326 
+-1.835000 -0.473838 
+-1.834000 -0.475331 
+-1.833000 -0.476802 
+-1.832000 -0.478253 
+-1.831000 -0.479683 
+-1.830000 -0.481092 
+-1.829000 -0.482481 
+-1.828000 -0.483849 
+-1.827000 -0.485195 
+-1.826000 -0.486521 
+-1.825000 -0.487826 
+-1.824000 -0.489110 
+-1.823000 -0.490373 
+-1.822000 -0.491615 
+-1.821000 -0.492836 
+-1.820000 -0.494036 
+-1.819000 -0.495214 
+-1.818000 -0.496372 
+-1.817000 -0.497508 
+-1.816000 -0.498623 
+-1.815000 -0.499717 
+-1.814000 -0.500790 
+-1.813000 -0.501842 
+-1.812000 -0.502872 
+-1.811000 -0.503881 
+-1.810000 -0.504869 
+-1.809000 -0.505835 
+-1.808000 -0.506780 
+-1.807000 -0.507704 
+-1.806000 -0.508606 
+-1.805000 -0.509487 
+-1.804000 -0.510346 
+-1.803000 -0.511185 
+-1.802000 -0.512001 
+-1.801000 -0.512797 
+-1.800000 -0.513571 
+-1.799000 -0.514323 
+-1.798000 -0.515054 
+-1.797000 -0.515763 
+-1.796000 -0.516452 
+-1.795000 -0.517118 
+-1.794000 -0.517763 
+-1.793000 -0.518387 
+-1.792000 -0.518989 
+-1.791000 -0.519570 
+-1.790000 -0.520129 
+-1.789000 -0.520667 
+-1.788000 -0.521183 
+-1.787000 -0.521678 
+-1.786000 -0.522151 
+-1.785000 -0.522603 
+-1.784000 -0.523034 
+-1.783000 -0.523443 
+-1.782000 -0.523831 
+-1.781000 -0.524197 
+-1.780000 -0.524541 
+-1.779000 -0.524865 
+-1.778000 -0.525167 
+-1.777000 -0.525447 
+-1.776000 -0.525706 
+-1.775000 -0.525944 
+-1.774000 -0.526160 
+-1.773000 -0.526356 
+-1.772000 -0.526529 
+-1.771000 -0.526682 
+-1.770000 -0.526813 
+-1.769000 -0.526923 
+-1.768000 -0.527011 
+-1.767000 -0.527079 
+-1.766000 -0.527125 
+-1.765000 -0.527150 
+-1.764000 -0.527154 
+-1.763000 -0.527137 
+-1.762000 -0.527098 
+-1.761000 -0.527039 
+-1.760000 -0.526958 
+-1.759000 -0.526856 
+-1.758000 -0.526734 
+-1.757000 -0.526590 
+-1.756000 -0.526426 
+-1.755000 -0.526240 
+-1.754000 -0.526034 
+-1.753000 -0.525807 
+-1.752000 -0.525559 
+-1.751000 -0.525290 
+-1.750000 -0.525000 
+-1.749000 -0.524690 
+-1.748000 -0.524359 
+-1.747000 -0.524007 
+-1.746000 -0.523635 
+-1.745000 -0.523242 
+-1.744000 -0.522828 
+-1.743000 -0.522394 
+-1.742000 -0.521940 
+-1.741000 -0.521465 
+-1.740000 -0.520970 
+-1.739000 -0.520454 
+-1.738000 -0.519919 
+-1.737000 -0.519363 
+-1.736000 -0.518786 
+-1.735000 -0.518190 
+-1.734000 -0.517574 
+-1.733000 -0.516937 
+-1.732000 -0.516280 
+-1.731000 -0.515604 
+-1.730000 -0.514908 
+-1.729000 -0.514191 
+-1.728000 -0.513455 
+-1.727000 -0.512699 
+-1.726000 -0.511924 
+-1.725000 -0.511129 
+-1.724000 -0.510314 
+-1.723000 -0.509480 
+-1.722000 -0.508626 
+-1.721000 -0.507753 
+-1.720000 -0.506860 
+-1.719000 -0.505948 
+-1.718000 -0.505017 
+-1.717000 -0.504067 
+-1.716000 -0.503098 
+-1.715000 -0.502109 
+-1.714000 -0.501102 
+-1.713000 -0.500075 
+-1.712000 -0.499030 
+-1.711000 -0.497966 
+-1.710000 -0.496883 
+-1.709000 -0.495782 
+-1.708000 -0.494662 
+-1.707000 -0.493523 
+-1.706000 -0.492366 
+-1.705000 -0.491190 
+-1.704000 -0.489996 
+-1.703000 -0.488784 
+-1.702000 -0.487554 
+-1.701000 -0.486305 
+-1.700000 -0.485039 
+-1.699000 -0.483754 
+-1.698000 -0.482452 
+-1.697000 -0.481132 
+-1.696000 -0.479794 
+-1.695000 -0.478438 
+-1.694000 -0.477065 
+-1.693000 -0.475674 
+-1.692000 -0.474265 
+-1.691000 -0.472840 
+-1.690000 -0.471397 
+-1.689000 -0.469936 
+-1.688000 -0.468459 
+-1.687000 -0.466965 
+-1.686000 -0.465453 
+-1.685000 -0.463925 
+-1.684000 -0.462380 
+-1.683000 -0.460818 
+-1.682000 -0.459239 
+-1.681000 -0.457644 
+-1.680000 -0.456033 
+-1.679000 -0.454405 
+-1.678000 -0.452761 
+-1.677000 -0.451100 
+-1.676000 -0.449423 
+-1.675000 -0.447731 
+-1.674000 -0.446022 
+-1.673000 -0.444298 
+-1.672000 -0.442557 
+-1.671000 -0.440801 
+-1.670000 -0.439030 
+-1.669000 -0.437243 
+-1.668000 -0.435440 
+-1.667000 -0.433622 
+-1.666000 -0.431789 
+-1.665000 -0.429941 
+-1.664000 -0.428077 
+-1.663000 -0.426199 
+-1.662000 -0.424306 
+-1.661000 -0.422398 
+-1.660000 -0.420475 
+-1.659000 -0.418538 
+-1.658000 -0.416586 
+-1.657000 -0.414620 
+-1.656000 -0.412640 
+-1.655000 -0.410646 
+-1.654000 -0.408637 
+-1.653000 -0.406614 
+-1.652000 -0.404578 
+-1.651000 -0.402527 
+-1.650000 -0.400463 
+-1.649000 -0.398386 
+-1.648000 -0.396295 
+-1.647000 -0.394190 
+-1.646000 -0.392072 
+-1.645000 -0.389941 
+-1.644000 -0.387797 
+-1.643000 -0.385640 
+-1.642000 -0.383471 
+-1.641000 -0.381288 
+-1.640000 -0.379093 
+-1.639000 -0.376885 
+-1.638000 -0.374664 
+-1.637000 -0.372432 
+-1.636000 -0.370187 
+-1.635000 -0.367929 
+-1.634000 -0.365660 
+-1.633000 -0.363379 
+-1.632000 -0.361086 
+-1.631000 -0.358782 
+-1.630000 -0.356466 
+-1.629000 -0.354138 
+-1.628000 -0.351799 
+-1.627000 -0.349449 
+-1.626000 -0.347087 
+-1.625000 -0.344715 
+-1.624000 -0.342331 
+-1.623000 -0.339937 
+-1.622000 -0.337532 
+-1.621000 -0.335116 
+-1.620000 -0.332690 
+-1.619000 -0.330253 
+-1.618000 -0.327807 
+-1.617000 -0.325350 
+-1.616000 -0.322883 
+-1.615000 -0.320406 
+-1.614000 -0.317919 
+-1.613000 -0.315422 
+-1.612000 -0.312916 
+-1.611000 -0.310401 
+-1.610000 -0.307876 
+-1.609000 -0.305342 
+-1.608000 -0.302798 
+-1.607000 -0.300246 
+-1.606000 -0.297685 
+-1.605000 -0.295115 
+-1.604000 -0.292536 
+-1.603000 -0.289949 
+-1.602000 -0.287353 
+-1.601000 -0.284749 
+-1.600000 -0.282137 
+-1.599000 -0.279517 
+-1.598000 -0.276888 
+-1.597000 -0.274252 
+-1.596000 -0.271608 
+-1.595000 -0.268957 
+-1.594000 -0.266298 
+-1.593000 -0.263632 
+-1.592000 -0.260958 
+-1.591000 -0.258277 
+-1.590000 -0.255589 
+-1.589000 -0.252895 
+-1.588000 -0.250193 
+-1.587000 -0.247485 
+-1.586000 -0.244770 
+-1.585000 -0.242049 
+-1.584000 -0.239322 
+-1.583000 -0.236588 
+-1.582000 -0.233848 
+-1.581000 -0.231103 
+-1.580000 -0.228351 
+-1.579000 -0.225594 
+-1.578000 -0.222831 
+-1.577000 -0.220063 
+-1.576000 -0.217289 
+-1.575000 -0.214511 
+-1.574000 -0.211727 
+-1.573000 -0.208938 
+-1.572000 -0.206144 
+-1.571000 -0.203345 
+-1.570000 -0.200542 
+-1.569000 -0.197734 
+-1.568000 -0.194922 
+-1.567000 -0.192106 
+-1.566000 -0.189285 
+-1.565000 -0.186461 
+-1.564000 -0.183633 
+-1.563000 -0.180800 
+-1.562000 -0.177964 
+-1.561000 -0.175125 
+-1.560000 -0.172282 
+-1.559000 -0.169436 
+-1.558000 -0.166587 
+-1.557000 -0.163735 
+-1.556000 -0.160879 
+-1.555000 -0.158021 
+-1.554000 -0.155160 
+-1.553000 -0.152297 
+-1.552000 -0.149431 
+-1.551000 -0.146563 
+-1.550000 -0.143693 
+-1.549000 -0.140820 
+-1.548000 -0.137946 
+-1.547000 -0.135070 
+-1.546000 -0.132192 
+-1.545000 -0.129312 
+-1.544000 -0.126431 
+-1.543000 -0.123549 
+-1.542000 -0.120665 
+-1.541000 -0.117781 
+-1.540000 -0.114895 
+-1.539000 -0.112008 
+-1.538000 -0.109121 
+-1.537000 -0.106233 
+-1.536000 -0.103344 
+-1.535000 -0.100455 
+-1.534000 -0.097566 
+-1.533000 -0.094676 
+-1.532000 -0.091787 
+-1.531000 -0.088897 
+-1.530000 -0.086008 
+-1.529000 -0.083119 
+-1.528000 -0.080231 
+-1.527000 -0.077343 
+-1.526000 -0.074455 
+-1.525000 -0.071569 
+-1.524000 -0.068683 
+-1.523000 -0.065799 
+-1.522000 -0.062915 
+-1.521000 -0.060033 
+-1.520000 -0.057152 
+-1.519000 -0.054273 
+-1.518000 -0.051395 
+-1.517000 -0.048519 
+-1.516000 -0.045645 
+-1.515000 -0.042772 
+-1.514000 -0.039902 
+-1.513000 -0.037034 
+-1.512000 -0.034168 
+-1.511000 -0.031305 
+-1.510000 -0.028444 
+-1.509000 -0.025586 
+-1.508000 -0.022731 
+-1.507000 -0.019878 
+-1.506000 -0.017028 
+-1.505000 -0.014182 
+-1.504000 -0.011339 
+-1.503000 -0.008499 
+-1.502000 -0.005662 
+-1.501000 -0.002829 
+-1.500000 0.000000 
+-1.499000 0.002826 
+-1.498000 0.005647 
+-1.497000 0.008465 
+-1.496000 0.011278 
+-1.495000 0.014088 
+-1.494000 0.016893 
+-1.493000 0.019693 
+-1.492000 0.022489 
+-1.491000 0.025281 
+-1.490000 0.028067 
+-1.489000 0.030849 
+-1.488000 0.033626 
+-1.487000 0.036398 
+-1.486000 0.039164 
+-1.485000 0.041925 
+-1.484000 0.044681 
+-1.483000 0.047431 
+-1.482000 0.050176 
+-1.481000 0.052915 
+-1.480000 0.055648 
+-1.479000 0.058375 
+-1.478000 0.061096 
+-1.477000 0.063811 
+-1.476000 0.066520 
+-1.475000 0.069222 
+-1.474000 0.071918 
+-1.473000 0.074607 
+-1.472000 0.077290 
+-1.471000 0.079966 
+-1.470000 0.082635 
+-1.469000 0.085297 
+-1.468000 0.087952 
+-1.467000 0.090600 
+-1.466000 0.093241 
+-1.465000 0.095874 
+-1.464000 0.098500 
+-1.463000 0.101118 
+-1.462000 0.103728 
+-1.461000 0.106331 
+-1.460000 0.108926 
+-1.459000 0.111513 
+-1.458000 0.114092 
+-1.457000 0.116663 
+-1.456000 0.119225 
+-1.455000 0.121780 
+-1.454000 0.124325 
+-1.453000 0.126863 
+-1.452000 0.129391 
+-1.451000 0.131911 
+-1.450000 0.134422 
+-1.449000 0.136925 
+-1.448000 0.139418 
+-1.447000 0.141902 
+-1.446000 0.144377 
+-1.445000 0.146843 
+-1.444000 0.149299 
+-1.443000 0.151746 
+-1.442000 0.154184 
+-1.441000 0.156612 
+-1.440000 0.159030 
+-1.439000 0.161438 
+-1.438000 0.163837 
+-1.437000 0.166225 
+-1.436000 0.168604 
+-1.435000 0.170972 
+-1.434000 0.173330 
+-1.433000 0.175678 
+-1.432000 0.178016 
+-1.431000 0.180343 
+-1.430000 0.182659 
+-1.429000 0.184965 
+-1.428000 0.187260 
+-1.427000 0.189545 
+-1.426000 0.191818 
+-1.425000 0.194081 
+-1.424000 0.196333 
+-1.423000 0.198573 
+-1.422000 0.200802 
+-1.421000 0.203020 
+-1.420000 0.205227 
+-1.419000 0.207422 
+-1.418000 0.209606 
+-1.417000 0.211778 
+-1.416000 0.213939 
+-1.415000 0.216088 
+-1.414000 0.218225 
+-1.413000 0.220351 
+-1.412000 0.222464 
+-1.411000 0.224565 
+-1.410000 0.226655 
+-1.409000 0.228732 
+-1.408000 0.230797 
+-1.407000 0.232850 
+-1.406000 0.234890 
+-1.405000 0.236918 
+-1.404000 0.238934 
+-1.403000 0.240937 
+-1.402000 0.242927 
+-1.401000 0.244905 
+-1.400000 0.246870 
+-1.399000 0.248822 
+-1.398000 0.250761 
+-1.397000 0.252688 
+-1.396000 0.254601 
+-1.395000 0.256502 
+-1.394000 0.258389 
+-1.393000 0.260263 
+-1.392000 0.262124 
+-1.391000 0.263972 
+-1.390000 0.265806 
+-1.389000 0.267627 
+-1.388000 0.269434 
+-1.387000 0.271228 
+-1.386000 0.273008 
+-1.385000 0.274775 
+-1.384000 0.276528 
+-1.383000 0.278267 
+-1.382000 0.279993 
+-1.381000 0.281705 
+-1.380000 0.283403 
+-1.379000 0.285086 
+-1.378000 0.286756 
+-1.377000 0.288412 
+-1.376000 0.290054 
+-1.375000 0.291682 
+-1.374000 0.293295 
+-1.373000 0.294894 
+-1.372000 0.296479 
+-1.371000 0.298050 
+-1.370000 0.299606 
+-1.369000 0.301148 
+-1.368000 0.302675 
+-1.367000 0.304188 
+-1.366000 0.305687 
+-1.365000 0.307170 
+-1.364000 0.308640 
+-1.363000 0.310094 
+-1.362000 0.311534 
+-1.361000 0.312959 
+-1.360000 0.314369 
+-1.359000 0.315765 
+-1.358000 0.317146 
+-1.357000 0.318511 
+-1.356000 0.319862 
+-1.355000 0.321198 
+-1.354000 0.322519 
+-1.353000 0.323825 
+-1.352000 0.325116 
+-1.351000 0.326391 
+-1.350000 0.327652 
+-1.349000 0.328897 
+-1.348000 0.330128 
+-1.347000 0.331343 
+-1.346000 0.332542 
+-1.345000 0.333727 
+-1.344000 0.334896 
+-1.343000 0.336050 
+-1.342000 0.337189 
+-1.341000 0.338312 
+-1.340000 0.339420 
+-1.339000 0.340512 
+-1.338000 0.341589 
+-1.337000 0.342651 
+-1.336000 0.343697 
+-1.335000 0.344727 
+-1.334000 0.345742 
+-1.333000 0.346742 
+-1.332000 0.347725 
+-1.331000 0.348694 
+-1.330000 0.349646 
+-1.329000 0.350583 
+-1.328000 0.351505 
+-1.327000 0.352411 
+-1.326000 0.353301 
+-1.325000 0.354175 
+-1.324000 0.355034 
+-1.323000 0.355877 
+-1.322000 0.356704 
+-1.321000 0.357516 
+-1.320000 0.358312 
+-1.319000 0.359092 
+-1.318000 0.359856 
+-1.317000 0.360604 
+-1.316000 0.361337 
+-1.315000 0.362054 
+-1.314000 0.362755 
+-1.313000 0.363441 
+-1.312000 0.364110 
+-1.311000 0.364764 
+-1.310000 0.365402 
+-1.309000 0.366024 
+-1.308000 0.366631 
+-1.307000 0.367221 
+-1.306000 0.367796 
+-1.305000 0.368355 
+-1.304000 0.368898 
+-1.303000 0.369425 
+-1.302000 0.369937 
+-1.301000 0.370432 
+-1.300000 0.370912 
+-1.299000 0.371376 
+-1.298000 0.371824 
+-1.297000 0.372257 
+-1.296000 0.372673 
+-1.295000 0.373074 
+-1.294000 0.373459 
+-1.293000 0.373828 
+-1.292000 0.374182 
+-1.291000 0.374520 
+-1.290000 0.374842 
+-1.289000 0.375148 
+-1.288000 0.375439 
+-1.287000 0.375713 
+-1.286000 0.375972 
+-1.285000 0.376216 
+-1.284000 0.376444 
+-1.283000 0.376656 
+-1.282000 0.376852 
+-1.281000 0.377033 
+-1.280000 0.377198 
+-1.279000 0.377348 
+-1.278000 0.377482 
+-1.277000 0.377600 
+-1.276000 0.377703 
+-1.275000 0.377791 
+-1.274000 0.377863 
+-1.273000 0.377919 
+-1.272000 0.377960 
+-1.271000 0.377986 
+-1.270000 0.377996 
+-1.269000 0.377990 
+-1.268000 0.377970 
+-1.267000 0.377934 
+-1.266000 0.377882 
+-1.265000 0.377816 
+-1.264000 0.377734 
+-1.263000 0.377637 
+-1.262000 0.377524 
+-1.261000 0.377397 
+-1.260000 0.377254 
+-1.259000 0.377096 
+-1.258000 0.376923 
+-1.257000 0.376735 
+-1.256000 0.376532 
+-1.255000 0.376314 
+-1.254000 0.376081 
+-1.253000 0.375833 
+-1.252000 0.375570 
+-1.251000 0.375293 
+-1.250000 0.375000 
+-1.249000 0.374693 
+-1.248000 0.374370 
+-1.247000 0.374034 
+-1.246000 0.373682 
+-1.245000 0.373316 
+-1.244000 0.372935 
+-1.243000 0.372539 
+-1.242000 0.372129 
+-1.241000 0.371705 
+-1.240000 0.371266 
+-1.239000 0.370813 
+-1.238000 0.370345 
+-1.237000 0.369863 
+-1.236000 0.369366 
+-1.235000 0.368856 
+-1.234000 0.368331 
+-1.233000 0.367792 
+-1.232000 0.367239 
+-1.231000 0.366672 
+-1.230000 0.366090 
+-1.229000 0.365495 
+-1.228000 0.364886 
+-1.227000 0.364263 
+-1.226000 0.363626 
+-1.225000 0.362975 
+-1.224000 0.362311 
+-1.223000 0.361633 
+-1.222000 0.360941 
+-1.221000 0.360236 
+-1.220000 0.359517 
+-1.219000 0.358785 
+-1.218000 0.358039 
+-1.217000 0.357280 
+-1.216000 0.356507 
+-1.215000 0.355722 
+-1.214000 0.354923 
+-1.213000 0.354111 
+-1.212000 0.353285 
+-1.211000 0.352447 
+-1.210000 0.351596 
+-1.209000 0.350731 
+-1.208000 0.349854 
+-1.207000 0.348964 
+-1.206000 0.348062 
+-1.205000 0.347146 
+-1.204000 0.346218 
+-1.203000 0.345277 
+-1.202000 0.344324 
+-1.201000 0.343358 
+-1.200000 0.342380 
+-1.199000 0.341390 
+-1.198000 0.340387 
+-1.197000 0.339372 
+-1.196000 0.338345 
+-1.195000 0.337306 
+-1.194000 0.336254 
+-1.193000 0.335191 
+-1.192000 0.334116 
+-1.191000 0.333029 
+-1.190000 0.331930 
+-1.189000 0.330820 
+-1.188000 0.329698 
+-1.187000 0.328564 
+-1.186000 0.327418 
+-1.185000 0.326262 
+-1.184000 0.325094 
+-1.183000 0.323914 
+-1.182000 0.322724 
+-1.181000 0.321522 
+-1.180000 0.320309 
+-1.179000 0.319085 
+-1.178000 0.317850 
+-1.177000 0.316604 
+-1.176000 0.315347 
+-1.175000 0.314080 
+-1.174000 0.312802 
+-1.173000 0.311513 
+-1.172000 0.310214 
+-1.171000 0.308904 
+-1.170000 0.307584 
+-1.169000 0.306253 
+-1.168000 0.304912 
+-1.167000 0.303561 
+-1.166000 0.302200 
+-1.165000 0.300829 
+-1.164000 0.299448 
+-1.163000 0.298057 
+-1.162000 0.296657 
+-1.161000 0.295246 
+-1.160000 0.293826 
+-1.159000 0.292396 
+-1.158000 0.290957 
+-1.157000 0.289509 
+-1.156000 0.288051 
+-1.155000 0.286583 
+-1.154000 0.285107 
+-1.153000 0.283621 
+-1.152000 0.282127 
+-1.151000 0.280623 
+-1.150000 0.279111 
+-1.149000 0.277590 
+-1.148000 0.276060 
+-1.147000 0.274521 
+-1.146000 0.272974 
+-1.145000 0.271418 
+-1.144000 0.269854 
+-1.143000 0.268282 
+-1.142000 0.266701 
+-1.141000 0.265112 
+-1.140000 0.263516 
+-1.139000 0.261911 
+-1.138000 0.260298 
+-1.137000 0.258677 
+-1.136000 0.257049 
+-1.135000 0.255413 
+-1.134000 0.253769 
+-1.133000 0.252118 
+-1.132000 0.250459 
+-1.131000 0.248794 
+-1.130000 0.247120 
+-1.129000 0.245440 
+-1.128000 0.243753 
+-1.127000 0.242058 
+-1.126000 0.240357 
+-1.125000 0.238649 
+-1.124000 0.236934 
+-1.123000 0.235212 
+-1.122000 0.233484 
+-1.121000 0.231749 
+-1.120000 0.230008 
+-1.119000 0.228260 
+-1.118000 0.226507 
+-1.117000 0.224747 
+-1.116000 0.222981 
+-1.115000 0.221209 
+-1.114000 0.219431 
+-1.113000 0.217647 
+-1.112000 0.215858 
+-1.111000 0.214063 
+-1.110000 0.212262 
+-1.109000 0.210456 
+-1.108000 0.208645 
+-1.107000 0.206828 
+-1.106000 0.205006 
+-1.105000 0.203179 
+-1.104000 0.201347 
+-1.103000 0.199509 
+-1.102000 0.197667 
+-1.101000 0.195821 
+-1.100000 0.193969 
+-1.099000 0.192113 
+-1.098000 0.190252 
+-1.097000 0.188387 
+-1.096000 0.186518 
+-1.095000 0.184644 
+-1.094000 0.182767 
+-1.093000 0.180885 
+-1.092000 0.178999 
+-1.091000 0.177109 
+-1.090000 0.175215 
+-1.089000 0.173318 
+-1.088000 0.171417 
+-1.087000 0.169512 
+-1.086000 0.167604 
+-1.085000 0.165693 
+-1.084000 0.163778 
+-1.083000 0.161860 
+-1.082000 0.159939 
+-1.081000 0.158015 
+-1.080000 0.156088 
+-1.079000 0.154158 
+-1.078000 0.152226 
+-1.077000 0.150290 
+-1.076000 0.148352 
+-1.075000 0.146412 
+-1.074000 0.144469 
+-1.073000 0.142524 
+-1.072000 0.140576 
+-1.071000 0.138627 
+-1.070000 0.136675 
+-1.069000 0.134721 
+-1.068000 0.132766 
+-1.067000 0.130809 
+-1.066000 0.128849 
+-1.065000 0.126889 
+-1.064000 0.124926 
+-1.063000 0.122963 
+-1.062000 0.120998 
+-1.061000 0.119031 
+-1.060000 0.117064 
+-1.059000 0.115095 
+-1.058000 0.113125 
+-1.057000 0.111154 
+-1.056000 0.109183 
+-1.055000 0.107211 
+-1.054000 0.105238 
+-1.053000 0.103264 
+-1.052000 0.101290 
+-1.051000 0.099315 
+-1.050000 0.097340 
+-1.049000 0.095365 
+-1.048000 0.093390 
+-1.047000 0.091414 
+-1.046000 0.089439 
+-1.045000 0.087464 
+-1.044000 0.085489 
+-1.043000 0.083514 
+-1.042000 0.081539 
+-1.041000 0.079565 
+-1.040000 0.077591 
+-1.039000 0.075618 
+-1.038000 0.073646 
+-1.037000 0.071674 
+-1.036000 0.069703 
+-1.035000 0.067733 
+-1.034000 0.065765 
+-1.033000 0.063797 
+-1.032000 0.061830 
+-1.031000 0.059865 
+-1.030000 0.057901 
+-1.029000 0.055938 
+-1.028000 0.053977 
+-1.027000 0.052018 
+-1.026000 0.050060 
+-1.025000 0.048104 
+-1.024000 0.046149 
+-1.023000 0.044197 
+-1.022000 0.042247 
+-1.021000 0.040298 
+-1.020000 0.038352 
+-1.019000 0.036408 
+-1.018000 0.034466 
+-1.017000 0.032527 
+-1.016000 0.030590 
+-1.015000 0.028656 
+-1.014000 0.026724 
+-1.013000 0.024795 
+-1.012000 0.022869 
+-1.011000 0.020946 
+-1.010000 0.019026 
+-1.009000 0.017108 
+-1.008000 0.015194 
+-1.007000 0.013283 
+-1.006000 0.011375 
+-1.005000 0.009470 
+-1.004000 0.007569 
+-1.003000 0.005671 
+-1.002000 0.003777 
+-1.001000 0.001887 
+-1.000000 -0.000000 
+-0.999000 -0.001883 
+-0.998000 -0.003762 
+-0.997000 -0.005638 
+-0.996000 -0.007509 
+-0.995000 -0.009376 
+-0.994000 -0.011239 
+-0.993000 -0.013098 
+-0.992000 -0.014953 
+-0.991000 -0.016803 
+-0.990000 -0.018649 
+-0.989000 -0.020490 
+-0.988000 -0.022327 
+-0.987000 -0.024159 
+-0.986000 -0.025986 
+-0.985000 -0.027809 
+-0.984000 -0.029627 
+-0.983000 -0.031440 
+-0.982000 -0.033247 
+-0.981000 -0.035050 
+-0.980000 -0.036848 
+-0.979000 -0.038640 
+-0.978000 -0.040428 
+-0.977000 -0.042210 
+-0.976000 -0.043986 
+-0.975000 -0.045757 
+-0.974000 -0.047523 
+-0.973000 -0.049282 
+-0.972000 -0.051037 
+-0.971000 -0.052785 
+-0.970000 -0.054528 
+-0.969000 -0.056265 
+-0.968000 -0.057996 
+-0.967000 -0.059721 
+-0.966000 -0.061440 
+-0.965000 -0.063152 
+-0.964000 -0.064859 
+-0.963000 -0.066560 
+-0.962000 -0.068254 
+-0.961000 -0.069941 
+-0.960000 -0.071623 
+-0.959000 -0.073298 
+-0.958000 -0.074966 
+-0.957000 -0.076628 
+-0.956000 -0.078283 
+-0.955000 -0.079931 
+-0.954000 -0.081573 
+-0.953000 -0.083207 
+-0.952000 -0.084835 
+-0.951000 -0.086456 
+-0.950000 -0.088070 
+-0.949000 -0.089677 
+-0.948000 -0.091276 
+-0.947000 -0.092869 
+-0.946000 -0.094454 
+-0.945000 -0.096032 
+-0.944000 -0.097603 
+-0.943000 -0.099166 
+-0.942000 -0.100722 
+-0.941000 -0.102270 
+-0.940000 -0.103811 
+-0.939000 -0.105344 
+-0.938000 -0.106870 
+-0.937000 -0.108388 
+-0.936000 -0.109898 
+-0.935000 -0.111400 
+-0.934000 -0.112894 
+-0.933000 -0.114381 
+-0.932000 -0.115859 
+-0.931000 -0.117330 
+-0.930000 -0.118792 
+-0.929000 -0.120247 
+-0.928000 -0.121693 
+-0.927000 -0.123131 
+-0.926000 -0.124561 
+-0.925000 -0.125982 
+-0.924000 -0.127396 
+-0.923000 -0.128800 
+-0.922000 -0.130197 
+-0.921000 -0.131585 
+-0.920000 -0.132964 
+-0.919000 -0.134335 
+-0.918000 -0.135697 
+-0.917000 -0.137051 
+-0.916000 -0.138396 
+-0.915000 -0.139732 
+-0.914000 -0.141059 
+-0.913000 -0.142378 
+-0.912000 -0.143688 
+-0.911000 -0.144989 
+-0.910000 -0.146281 
+-0.909000 -0.147564 
+-0.908000 -0.148838 
+-0.907000 -0.150103 
+-0.906000 -0.151359 
+-0.905000 -0.152606 
+-0.904000 -0.153843 
+-0.903000 -0.155072 
+-0.902000 -0.156291 
+-0.901000 -0.157501 
+-0.900000 -0.158702 
+-0.899000 -0.159893 
+-0.898000 -0.161076 
+-0.897000 -0.162248 
+-0.896000 -0.163412 
+-0.895000 -0.164566 
+-0.894000 -0.165710 
+-0.893000 -0.166845 
+-0.892000 -0.167970 
+-0.891000 -0.169086 
+-0.890000 -0.170192 
+-0.889000 -0.171289 
+-0.888000 -0.172376 
+-0.887000 -0.173453 
+-0.886000 -0.174520 
+-0.885000 -0.175578 
+-0.884000 -0.176626 
+-0.883000 -0.177665 
+-0.882000 -0.178693 
+-0.881000 -0.179712 
+-0.880000 -0.180720 
+-0.879000 -0.181719 
+-0.878000 -0.182708 
+-0.877000 -0.183687 
+-0.876000 -0.184656 
+-0.875000 -0.185616 
+-0.874000 -0.186565 
+-0.873000 -0.187504 
+-0.872000 -0.188433 
+-0.871000 -0.189352 
+-0.870000 -0.190261 
+-0.869000 -0.191160 
+-0.868000 -0.192048 
+-0.867000 -0.192927 
+-0.866000 -0.193796 
+-0.865000 -0.194654 
+-0.864000 -0.195502 
+-0.863000 -0.196340 
+-0.862000 -0.197168 
+-0.861000 -0.197985 
+-0.860000 -0.198792 
+-0.859000 -0.199589 
+-0.858000 -0.200376 
+-0.857000 -0.201153 
+-0.856000 -0.201919 
+-0.855000 -0.202675 
+-0.854000 -0.203420 
+-0.853000 -0.204156 
+-0.852000 -0.204881 
+-0.851000 -0.205595 
+-0.850000 -0.206299 
+-0.849000 -0.206993 
+-0.848000 -0.207677 
+-0.847000 -0.208350 
+-0.846000 -0.209013 
+-0.845000 -0.209665 
+-0.844000 -0.210307 
+-0.843000 -0.210938 
+-0.842000 -0.211560 
+-0.841000 -0.212170 
+-0.840000 -0.212771 
+-0.839000 -0.213361 
+-0.838000 -0.213940 
+-0.837000 -0.214509 
+-0.836000 -0.215068 
+-0.835000 -0.215616 
+-0.834000 -0.216154 
+-0.833000 -0.216681 
+-0.832000 -0.217198 
+-0.831000 -0.217704 
+-0.830000 -0.218200 
+-0.829000 -0.218686 
+-0.828000 -0.219161 
+-0.827000 -0.219626 
+-0.826000 -0.220080 
+-0.825000 -0.220524 
+-0.824000 -0.220958 
+-0.823000 -0.221381 
+-0.822000 -0.221793 
+-0.821000 -0.222196 
+-0.820000 -0.222587 
+-0.819000 -0.222969 
+-0.818000 -0.223340 
+-0.817000 -0.223701 
+-0.816000 -0.224051 
+-0.815000 -0.224391 
+-0.814000 -0.224721 
+-0.813000 -0.225040 
+-0.812000 -0.225349 
+-0.811000 -0.225647 
+-0.810000 -0.225936 
+-0.809000 -0.226214 
+-0.808000 -0.226481 
+-0.807000 -0.226739 
+-0.806000 -0.226986 
+-0.805000 -0.227223 
+-0.804000 -0.227449 
+-0.803000 -0.227666 
+-0.802000 -0.227872 
+-0.801000 -0.228068 
+-0.800000 -0.228254 
+-0.799000 -0.228429 
+-0.798000 -0.228595 
+-0.797000 -0.228750 
+-0.796000 -0.228895 
+-0.795000 -0.229030 
+-0.794000 -0.229155 
+-0.793000 -0.229270 
+-0.792000 -0.229375 
+-0.791000 -0.229469 
+-0.790000 -0.229554 
+-0.789000 -0.229629 
+-0.788000 -0.229694 
+-0.787000 -0.229749 
+-0.786000 -0.229793 
+-0.785000 -0.229828 
+-0.784000 -0.229853 
+-0.783000 -0.229869 
+-0.782000 -0.229874 
+-0.781000 -0.229870 
+-0.780000 -0.229855 
+-0.779000 -0.229831 
+-0.778000 -0.229797 
+-0.777000 -0.229754 
+-0.776000 -0.229700 
+-0.775000 -0.229638 
+-0.774000 -0.229565 
+-0.773000 -0.229483 
+-0.772000 -0.229391 
+-0.771000 -0.229289 
+-0.770000 -0.229178 
+-0.769000 -0.229058 
+-0.768000 -0.228928 
+-0.767000 -0.228789 
+-0.766000 -0.228640 
+-0.765000 -0.228481 
+-0.764000 -0.228314 
+-0.763000 -0.228137 
+-0.762000 -0.227951 
+-0.761000 -0.227755 
+-0.760000 -0.227550 
+-0.759000 -0.227336 
+-0.758000 -0.227113 
+-0.757000 -0.226880 
+-0.756000 -0.226639 
+-0.755000 -0.226388 
+-0.754000 -0.226129 
+-0.753000 -0.225860 
+-0.752000 -0.225582 
+-0.751000 -0.225296 
+-0.750000 -0.225000 
+-0.749000 -0.224696 
+-0.748000 -0.224382 
+-0.747000 -0.224060 
+-0.746000 -0.223729 
+-0.745000 -0.223390 
+-0.744000 -0.223041 
+-0.743000 -0.222684 
+-0.742000 -0.222319 
+-0.741000 -0.221945 
+-0.740000 -0.221562 
+-0.739000 -0.221171 
+-0.738000 -0.220771 
+-0.737000 -0.220363 
+-0.736000 -0.219946 
+-0.735000 -0.219521 
+-0.734000 -0.219088 
+-0.733000 -0.218647 
+-0.732000 -0.218197 
+-0.731000 -0.217739 
+-0.730000 -0.217273 
+-0.729000 -0.216799 
+-0.728000 -0.216317 
+-0.727000 -0.215827 
+-0.726000 -0.215328 
+-0.725000 -0.214822 
+-0.724000 -0.214308 
+-0.723000 -0.213786 
+-0.722000 -0.213257 
+-0.721000 -0.212719 
+-0.720000 -0.212174 
+-0.719000 -0.211621 
+-0.718000 -0.211061 
+-0.717000 -0.210493 
+-0.716000 -0.209917 
+-0.715000 -0.209334 
+-0.714000 -0.208744 
+-0.713000 -0.208146 
+-0.712000 -0.207541 
+-0.711000 -0.206928 
+-0.710000 -0.206308 
+-0.709000 -0.205681 
+-0.708000 -0.205047 
+-0.707000 -0.204406 
+-0.706000 -0.203757 
+-0.705000 -0.203102 
+-0.704000 -0.202440 
+-0.703000 -0.201771 
+-0.702000 -0.201094 
+-0.701000 -0.200412 
+-0.700000 -0.199722 
+-0.699000 -0.199025 
+-0.698000 -0.198322 
+-0.697000 -0.197613 
+-0.696000 -0.196896 
+-0.695000 -0.196174 
+-0.694000 -0.195444 
+-0.693000 -0.194709 
+-0.692000 -0.193967 
+-0.691000 -0.193218 
+-0.690000 -0.192464 
+-0.689000 -0.191703 
+-0.688000 -0.190936 
+-0.687000 -0.190163 
+-0.686000 -0.189384 
+-0.685000 -0.188599 
+-0.684000 -0.187807 
+-0.683000 -0.187011 
+-0.682000 -0.186208 
+-0.681000 -0.185399 
+-0.680000 -0.184585 
+-0.679000 -0.183765 
+-0.678000 -0.182939 
+-0.677000 -0.182108 
+-0.676000 -0.181271 
+-0.675000 -0.180429 
+-0.674000 -0.179581 
+-0.673000 -0.178728 
+-0.672000 -0.177870 
+-0.671000 -0.177006 
+-0.670000 -0.176138 
+-0.669000 -0.175264 
+-0.668000 -0.174385 
+-0.667000 -0.173501 
+-0.666000 -0.172612 
+-0.665000 -0.171718 
+-0.664000 -0.170819 
+-0.663000 -0.169916 
+-0.662000 -0.169008 
+-0.661000 -0.168095 
+-0.660000 -0.167177 
+-0.659000 -0.166255 
+-0.658000 -0.165328 
+-0.657000 -0.164397 
+-0.656000 -0.163461 
+-0.655000 -0.162521 
+-0.654000 -0.161577 
+-0.653000 -0.160629 
+-0.652000 -0.159676 
+-0.651000 -0.158719 
+-0.650000 -0.157758 
+-0.649000 -0.156793 
+-0.648000 -0.155825 
+-0.647000 -0.154852 
+-0.646000 -0.153875 
+-0.645000 -0.152895 
+-0.644000 -0.151911 
+-0.643000 -0.150923 
+-0.642000 -0.149932 
+-0.641000 -0.148937 
+-0.640000 -0.147939 
+-0.639000 -0.146937 
+-0.638000 -0.145931 
+-0.637000 -0.144923 
+-0.636000 -0.143911 
+-0.635000 -0.142896 
+-0.634000 -0.141878 
+-0.633000 -0.140857 
+-0.632000 -0.139832 
+-0.631000 -0.138805 
+-0.630000 -0.137775 
+-0.629000 -0.136742 
+-0.628000 -0.135706 
+-0.627000 -0.134668 
+-0.626000 -0.133626 
+-0.625000 -0.132583 
+-0.624000 -0.131536 
+-0.623000 -0.130487 
+-0.622000 -0.129436 
+-0.621000 -0.128382 
+-0.620000 -0.127326 
+-0.619000 -0.126267 
+-0.618000 -0.125207 
+-0.617000 -0.124144 
+-0.616000 -0.123079 
+-0.615000 -0.122012 
+-0.614000 -0.120943 
+-0.613000 -0.119872 
+-0.612000 -0.118799 
+-0.611000 -0.117725 
+-0.610000 -0.116649 
+-0.609000 -0.115571 
+-0.608000 -0.114491 
+-0.607000 -0.113410 
+-0.606000 -0.112327 
+-0.605000 -0.111243 
+-0.604000 -0.110157 
+-0.603000 -0.109070 
+-0.602000 -0.107982 
+-0.601000 -0.106892 
+-0.600000 -0.105801 
+-0.599000 -0.104709 
+-0.598000 -0.103617 
+-0.597000 -0.102523 
+-0.596000 -0.101428 
+-0.595000 -0.100332 
+-0.594000 -0.099235 
+-0.593000 -0.098138 
+-0.592000 -0.097040 
+-0.591000 -0.095941 
+-0.590000 -0.094841 
+-0.589000 -0.093741 
+-0.588000 -0.092641 
+-0.587000 -0.091540 
+-0.586000 -0.090438 
+-0.585000 -0.089337 
+-0.584000 -0.088235 
+-0.583000 -0.087133 
+-0.582000 -0.086030 
+-0.581000 -0.084928 
+-0.580000 -0.083825 
+-0.579000 -0.082723 
+-0.578000 -0.081620 
+-0.577000 -0.080518 
+-0.576000 -0.079415 
+-0.575000 -0.078313 
+-0.574000 -0.077212 
+-0.573000 -0.076110 
+-0.572000 -0.075009 
+-0.571000 -0.073908 
+-0.570000 -0.072808 
+-0.569000 -0.071709 
+-0.568000 -0.070610 
+-0.567000 -0.069511 
+-0.566000 -0.068414 
+-0.565000 -0.067317 
+-0.564000 -0.066220 
+-0.563000 -0.065125 
+-0.562000 -0.064031 
+-0.561000 -0.062937 
+-0.560000 -0.061845 
+-0.559000 -0.060754 
+-0.558000 -0.059663 
+-0.557000 -0.058574 
+-0.556000 -0.057486 
+-0.555000 -0.056400 
+-0.554000 -0.055315 
+-0.553000 -0.054231 
+-0.552000 -0.053148 
+-0.551000 -0.052067 
+-0.550000 -0.050988 
+-0.549000 -0.049910 
+-0.548000 -0.048834 
+-0.547000 -0.047759 
+-0.546000 -0.046686 
+-0.545000 -0.045615 
+-0.544000 -0.044546 
+-0.543000 -0.043478 
+-0.542000 -0.042413 
+-0.541000 -0.041349 
+-0.540000 -0.040288 
+-0.539000 -0.039228 
+-0.538000 -0.038171 
+-0.537000 -0.037116 
+-0.536000 -0.036063 
+-0.535000 -0.035012 
+-0.534000 -0.033964 
+-0.533000 -0.032917 
+-0.532000 -0.031874 
+-0.531000 -0.030832 
+-0.530000 -0.029794 
+-0.529000 -0.028757 
+-0.528000 -0.027724 
+-0.527000 -0.026693 
+-0.526000 -0.025664 
+-0.525000 -0.024638 
+-0.524000 -0.023615 
+-0.523000 -0.022595 
+-0.522000 -0.021578 
+-0.521000 -0.020564 
+-0.520000 -0.019552 
+-0.519000 -0.018543 
+-0.518000 -0.017538 
+-0.517000 -0.016535 
+-0.516000 -0.015536 
+-0.515000 -0.014540 
+-0.514000 -0.013547 
+-0.513000 -0.012557 
+-0.512000 -0.011570 
+-0.511000 -0.010587 
+-0.510000 -0.009607 
+-0.509000 -0.008630 
+-0.508000 -0.007657 
+-0.507000 -0.006688 
+-0.506000 -0.005721 
+-0.505000 -0.004759 
+-0.504000 -0.003800 
+-0.503000 -0.002844 
+-0.502000 -0.001892 
+-0.501000 -0.000944 
+-0.500000 0.000000 
+-0.499000 0.000941 
+-0.498000 0.001877 
+-0.497000 0.002810 
+-0.496000 0.003739 
+-0.495000 0.004664 
+-0.494000 0.005586 
+-0.493000 0.006503 
+-0.492000 0.007416 
+-0.491000 0.008325 
+-0.490000 0.009230 
+-0.489000 0.010131 
+-0.488000 0.011028 
+-0.487000 0.011920 
+-0.486000 0.012809 
+-0.485000 0.013693 
+-0.484000 0.014573 
+-0.483000 0.015448 
+-0.482000 0.016319 
+-0.481000 0.017186 
+-0.480000 0.018048 
+-0.479000 0.018906 
+-0.478000 0.019759 
+-0.477000 0.020608 
+-0.476000 0.021452 
+-0.475000 0.022292 
+-0.474000 0.023127 
+-0.473000 0.023957 
+-0.472000 0.024783 
+-0.471000 0.025604 
+-0.470000 0.026421 
+-0.469000 0.027232 
+-0.468000 0.028039 
+-0.467000 0.028841 
+-0.466000 0.029639 
+-0.465000 0.030431 
+-0.464000 0.031218 
+-0.463000 0.032001 
+-0.462000 0.032779 
+-0.461000 0.033551 
+-0.460000 0.034319 
+-0.459000 0.035082 
+-0.458000 0.035840 
+-0.457000 0.036592 
+-0.456000 0.037340 
+-0.455000 0.038082 
+-0.454000 0.038820 
+-0.453000 0.039552 
+-0.452000 0.040279 
+-0.451000 0.041001 
+-0.450000 0.041717 
+-0.449000 0.042429 
+-0.448000 0.043135 
+-0.447000 0.043836 
+-0.446000 0.044531 
+-0.445000 0.045222 
+-0.444000 0.045906 
+-0.443000 0.046586 
+-0.442000 0.047260 
+-0.441000 0.047929 
+-0.440000 0.048592 
+-0.439000 0.049250 
+-0.438000 0.049903 
+-0.437000 0.050550 
+-0.436000 0.051192 
+-0.435000 0.051828 
+-0.434000 0.052458 
+-0.433000 0.053084 
+-0.432000 0.053703 
+-0.431000 0.054317 
+-0.430000 0.054926 
+-0.429000 0.055528 
+-0.428000 0.056126 
+-0.427000 0.056717 
+-0.426000 0.057303 
+-0.425000 0.057884 
+-0.424000 0.058459 
+-0.423000 0.059028 
+-0.422000 0.059591 
+-0.421000 0.060149 
+-0.420000 0.060701 
+-0.419000 0.061247 
+-0.418000 0.061788 
+-0.417000 0.062323 
+-0.416000 0.062852 
+-0.415000 0.063376 
+-0.414000 0.063893 
+-0.413000 0.064405 
+-0.412000 0.064912 
+-0.411000 0.065412 
+-0.410000 0.065907 
+-0.409000 0.066396 
+-0.408000 0.066879 
+-0.407000 0.067356 
+-0.406000 0.067827 
+-0.405000 0.068293 
+-0.404000 0.068753 
+-0.403000 0.069207 
+-0.402000 0.069655 
+-0.401000 0.070098 
+-0.400000 0.070534 
+-0.399000 0.070965 
+-0.398000 0.071390 
+-0.397000 0.071809 
+-0.396000 0.072222 
+-0.395000 0.072629 
+-0.394000 0.073031 
+-0.393000 0.073427 
+-0.392000 0.073817 
+-0.391000 0.074200 
+-0.390000 0.074579 
+-0.389000 0.074951 
+-0.388000 0.075317 
+-0.387000 0.075678 
+-0.386000 0.076033 
+-0.385000 0.076382 
+-0.384000 0.076725 
+-0.383000 0.077062 
+-0.382000 0.077393 
+-0.381000 0.077719 
+-0.380000 0.078038 
+-0.379000 0.078352 
+-0.378000 0.078660 
+-0.377000 0.078963 
+-0.376000 0.079259 
+-0.375000 0.079550 
+-0.374000 0.079834 
+-0.373000 0.080113 
+-0.372000 0.080386 
+-0.371000 0.080654 
+-0.370000 0.080916 
+-0.369000 0.081171 
+-0.368000 0.081421 
+-0.367000 0.081666 
+-0.366000 0.081904 
+-0.365000 0.082137 
+-0.364000 0.082364 
+-0.363000 0.082586 
+-0.362000 0.082801 
+-0.361000 0.083011 
+-0.360000 0.083215 
+-0.359000 0.083414 
+-0.358000 0.083607 
+-0.357000 0.083794 
+-0.356000 0.083976 
+-0.355000 0.084152 
+-0.354000 0.084322 
+-0.353000 0.084486 
+-0.352000 0.084645 
+-0.351000 0.084799 
+-0.350000 0.084947 
+-0.349000 0.085089 
+-0.348000 0.085226 
+-0.347000 0.085357 
+-0.346000 0.085483 
+-0.345000 0.085603 
+-0.344000 0.085717 
+-0.343000 0.085827 
+-0.342000 0.085930 
+-0.341000 0.086029 
+-0.340000 0.086121 
+-0.339000 0.086209 
+-0.338000 0.086291 
+-0.337000 0.086367 
+-0.336000 0.086439 
+-0.335000 0.086505 
+-0.334000 0.086565 
+-0.333000 0.086620 
+-0.332000 0.086670 
+-0.331000 0.086715 
+-0.330000 0.086754 
+-0.329000 0.086789 
+-0.328000 0.086817 
+-0.327000 0.086841 
+-0.326000 0.086860 
+-0.325000 0.086873 
+-0.324000 0.086881 
+-0.323000 0.086885 
+-0.322000 0.086883 
+-0.321000 0.086876 
+-0.320000 0.086863 
+-0.319000 0.086846 
+-0.318000 0.086824 
+-0.317000 0.086797 
+-0.316000 0.086765 
+-0.315000 0.086728 
+-0.314000 0.086686 
+-0.313000 0.086639 
+-0.312000 0.086587 
+-0.311000 0.086531 
+-0.310000 0.086469 
+-0.309000 0.086403 
+-0.308000 0.086332 
+-0.307000 0.086256 
+-0.306000 0.086176 
+-0.305000 0.086091 
+-0.304000 0.086001 
+-0.303000 0.085906 
+-0.302000 0.085807 
+-0.301000 0.085703 
+-0.300000 0.085595 
+-0.299000 0.085482 
+-0.298000 0.085365 
+-0.297000 0.085243 
+-0.296000 0.085117 
+-0.295000 0.084986 
+-0.294000 0.084851 
+-0.293000 0.084711 
+-0.292000 0.084567 
+-0.291000 0.084419 
+-0.290000 0.084267 
+-0.289000 0.084110 
+-0.288000 0.083949 
+-0.287000 0.083784 
+-0.286000 0.083614 
+-0.285000 0.083441 
+-0.284000 0.083263 
+-0.283000 0.083082 
+-0.282000 0.082896 
+-0.281000 0.082706 
+-0.280000 0.082512 
+-0.279000 0.082314 
+-0.278000 0.082113 
+-0.277000 0.081907 
+-0.276000 0.081698 
+-0.275000 0.081484 
+-0.274000 0.081267 
+-0.273000 0.081046 
+-0.272000 0.080822 
+-0.271000 0.080593 
+-0.270000 0.080361 
+-0.269000 0.080126 
+-0.268000 0.079886 
+-0.267000 0.079643 
+-0.266000 0.079397 
+-0.265000 0.079147 
+-0.264000 0.078894 
+-0.263000 0.078637 
+-0.262000 0.078377 
+-0.261000 0.078113 
+-0.260000 0.077846 
+-0.259000 0.077576 
+-0.258000 0.077302 
+-0.257000 0.077025 
+-0.256000 0.076745 
+-0.255000 0.076462 
+-0.254000 0.076176 
+-0.253000 0.075887 
+-0.252000 0.075594 
+-0.251000 0.075299 
+-0.250000 0.075000 
+-0.249000 0.074699 
+-0.248000 0.074394 
+-0.247000 0.074087 
+-0.246000 0.073777 
+-0.245000 0.073464 
+-0.244000 0.073148 
+-0.243000 0.072830 
+-0.242000 0.072508 
+-0.241000 0.072184 
+-0.240000 0.071858 
+-0.239000 0.071529 
+-0.238000 0.071197 
+-0.237000 0.070863 
+-0.236000 0.070526 
+-0.235000 0.070187 
+-0.234000 0.069846 
+-0.233000 0.069502 
+-0.232000 0.069155 
+-0.231000 0.068807 
+-0.230000 0.068456 
+-0.229000 0.068103 
+-0.228000 0.067748 
+-0.227000 0.067390 
+-0.226000 0.067031 
+-0.225000 0.066669 
+-0.224000 0.066305 
+-0.223000 0.065940 
+-0.222000 0.065572 
+-0.221000 0.065202 
+-0.220000 0.064831 
+-0.219000 0.064458 
+-0.218000 0.064083 
+-0.217000 0.063706 
+-0.216000 0.063327 
+-0.215000 0.062947 
+-0.214000 0.062565 
+-0.213000 0.062181 
+-0.212000 0.061796 
+-0.211000 0.061409 
+-0.210000 0.061021 
+-0.209000 0.060631 
+-0.208000 0.060240 
+-0.207000 0.059847 
+-0.206000 0.059453 
+-0.205000 0.059058 
+-0.204000 0.058662 
+-0.203000 0.058264 
+-0.202000 0.057865 
+-0.201000 0.057465 
+-0.200000 0.057063 
+-0.199000 0.056661 
+-0.198000 0.056258 
+-0.197000 0.055853 
+-0.196000 0.055448 
+-0.195000 0.055042 
+-0.194000 0.054634 
+-0.193000 0.054226 
+-0.192000 0.053817 
+-0.191000 0.053408 
+-0.190000 0.052997 
+-0.189000 0.052586 
+-0.188000 0.052174 
+-0.187000 0.051762 
+-0.186000 0.051349 
+-0.185000 0.050935 
+-0.184000 0.050521 
+-0.183000 0.050107 
+-0.182000 0.049692 
+-0.181000 0.049276 
+-0.180000 0.048861 
+-0.179000 0.048445 
+-0.178000 0.048028 
+-0.177000 0.047612 
+-0.176000 0.047195 
+-0.175000 0.046778 
+-0.174000 0.046361 
+-0.173000 0.045944 
+-0.172000 0.045526 
+-0.171000 0.045109 
+-0.170000 0.044692 
+-0.169000 0.044274 
+-0.168000 0.043857 
+-0.167000 0.043440 
+-0.166000 0.043023 
+-0.165000 0.042607 
+-0.164000 0.042190 
+-0.163000 0.041774 
+-0.162000 0.041358 
+-0.161000 0.040943 
+-0.160000 0.040528 
+-0.159000 0.040113 
+-0.158000 0.039699 
+-0.157000 0.039285 
+-0.156000 0.038872 
+-0.155000 0.038459 
+-0.154000 0.038047 
+-0.153000 0.037636 
+-0.152000 0.037225 
+-0.151000 0.036815 
+-0.150000 0.036406 
+-0.149000 0.035997 
+-0.148000 0.035590 
+-0.147000 0.035183 
+-0.146000 0.034777 
+-0.145000 0.034372 
+-0.144000 0.033968 
+-0.143000 0.033565 
+-0.142000 0.033162 
+-0.141000 0.032761 
+-0.140000 0.032362 
+-0.139000 0.031963 
+-0.138000 0.031565 
+-0.137000 0.031169 
+-0.136000 0.030773 
+-0.135000 0.030379 
+-0.134000 0.029987 
+-0.133000 0.029595 
+-0.132000 0.029206 
+-0.131000 0.028817 
+-0.130000 0.028430 
+-0.129000 0.028044 
+-0.128000 0.027660 
+-0.127000 0.027277 
+-0.126000 0.026896 
+-0.125000 0.026517 
+-0.124000 0.026139 
+-0.123000 0.025762 
+-0.122000 0.025388 
+-0.121000 0.025015 
+-0.120000 0.024644 
+-0.119000 0.024274 
+-0.118000 0.023907 
+-0.117000 0.023541 
+-0.116000 0.023177 
+-0.115000 0.022815 
+-0.114000 0.022455 
+-0.113000 0.022097 
+-0.112000 0.021741 
+-0.111000 0.021387 
+-0.110000 0.021035 
+-0.109000 0.020685 
+-0.108000 0.020337 
+-0.107000 0.019991 
+-0.106000 0.019648 
+-0.105000 0.019307 
+-0.104000 0.018967 
+-0.103000 0.018631 
+-0.102000 0.018296 
+-0.101000 0.017964 
+-0.100000 0.017634 
+-0.099000 0.017306 
+-0.098000 0.016981 
+-0.097000 0.016658 
+-0.096000 0.016337 
+-0.095000 0.016019 
+-0.094000 0.015704 
+-0.093000 0.015391 
+-0.092000 0.015080 
+-0.091000 0.014773 
+-0.090000 0.014467 
+-0.089000 0.014165 
+-0.088000 0.013865 
+-0.087000 0.013567 
+-0.086000 0.013273 
+-0.085000 0.012981 
+-0.084000 0.012691 
+-0.083000 0.012405 
+-0.082000 0.012121 
+-0.081000 0.011840 
+-0.080000 0.011562 
+-0.079000 0.011287 
+-0.078000 0.011014 
+-0.077000 0.010745 
+-0.076000 0.010478 
+-0.075000 0.010215 
+-0.074000 0.009954 
+-0.073000 0.009696 
+-0.072000 0.009442 
+-0.071000 0.009190 
+-0.070000 0.008941 
+-0.069000 0.008696 
+-0.068000 0.008453 
+-0.067000 0.008214 
+-0.066000 0.007978 
+-0.065000 0.007744 
+-0.064000 0.007514 
+-0.063000 0.007288 
+-0.062000 0.007064 
+-0.061000 0.006843 
+-0.060000 0.006626 
+-0.059000 0.006412 
+-0.058000 0.006202 
+-0.057000 0.005994 
+-0.056000 0.005790 
+-0.055000 0.005589 
+-0.054000 0.005392 
+-0.053000 0.005198 
+-0.052000 0.005007 
+-0.051000 0.004819 
+-0.050000 0.004635 
+-0.049000 0.004455 
+-0.048000 0.004277 
+-0.047000 0.004104 
+-0.046000 0.003933 
+-0.045000 0.003766 
+-0.044000 0.003603 
+-0.043000 0.003443 
+-0.042000 0.003287 
+-0.041000 0.003134 
+-0.040000 0.002984 
+-0.039000 0.002838 
+-0.038000 0.002696 
+-0.037000 0.002557 
+-0.036000 0.002422 
+-0.035000 0.002291 
+-0.034000 0.002162 
+-0.033000 0.002038 
+-0.032000 0.001917 
+-0.031000 0.001800 
+-0.030000 0.001686 
+-0.029000 0.001576 
+-0.028000 0.001470 
+-0.027000 0.001368 
+-0.026000 0.001269 
+-0.025000 0.001173 
+-0.024000 0.001082 
+-0.023000 0.000994 
+-0.022000 0.000909 
+-0.021000 0.000829 
+-0.020000 0.000752 
+-0.019000 0.000679 
+-0.018000 0.000609 
+-0.017000 0.000544 
+-0.016000 0.000482 
+-0.015000 0.000423 
+-0.014000 0.000369 
+-0.013000 0.000318 
+-0.012000 0.000271 
+-0.011000 0.000228 
+-0.010000 0.000188 
+-0.009000 0.000153 
+-0.008000 0.000121 
+-0.007000 0.000092 
+-0.006000 0.000068 
+-0.005000 0.000047 
+-0.004000 0.000030 
+-0.003000 0.000017 
+-0.002000 0.000008 
+-0.001000 0.000002 
+0.000000 0.000000 
+0.001000 0.000002 
+0.002000 0.000008 
+0.003000 0.000017 
+0.004000 0.000030 
+0.005000 0.000047 
+0.006000 0.000068 
+0.007000 0.000092 
+0.008000 0.000121 
+0.009000 0.000153 
+0.010000 0.000188 
+0.011000 0.000228 
+0.012000 0.000271 
+0.013000 0.000318 
+0.014000 0.000369 
+0.015000 0.000423 
+0.016000 0.000482 
+0.017000 0.000544 
+0.018000 0.000609 
+0.019000 0.000679 
+0.020000 0.000752 
+0.021000 0.000829 
+0.022000 0.000909 
+0.023000 0.000994 
+0.024000 0.001082 
+0.025000 0.001173 
+0.026000 0.001269 
+0.027000 0.001368 
+0.028000 0.001470 
+0.029000 0.001576 
+0.030000 0.001686 
+0.031000 0.001800 
+0.032000 0.001917 
+0.033000 0.002038 
+0.034000 0.002162 
+0.035000 0.002291 
+0.036000 0.002422 
+0.037000 0.002557 
+0.038000 0.002696 
+0.039000 0.002838 
+0.040000 0.002984 
+0.041000 0.003134 
+0.042000 0.003287 
+0.043000 0.003443 
+0.044000 0.003603 
+0.045000 0.003766 
+0.046000 0.003933 
+0.047000 0.004104 
+0.048000 0.004277 
+0.049000 0.004455 
+0.050000 0.004635 
+0.051000 0.004819 
+0.052000 0.005007 
+0.053000 0.005198 
+0.054000 0.005392 
+0.055000 0.005589 
+0.056000 0.005790 
+0.057000 0.005994 
+0.058000 0.006202 
+0.059000 0.006412 
+0.060000 0.006626 
+0.061000 0.006843 
+0.062000 0.007064 
+0.063000 0.007288 
+0.064000 0.007514 
+0.065000 0.007744 
+0.066000 0.007978 
+0.067000 0.008214 
+0.068000 0.008453 
+0.069000 0.008696 
+0.070000 0.008941 
+0.071000 0.009190 
+0.072000 0.009442 
+0.073000 0.009696 
+0.074000 0.009954 
+0.075000 0.010215 
+0.076000 0.010478 
+0.077000 0.010745 
+0.078000 0.011014 
+0.079000 0.011287 
+0.080000 0.011562 
+0.081000 0.011840 
+0.082000 0.012121 
+0.083000 0.012405 
+0.084000 0.012691 
+0.085000 0.012981 
+0.086000 0.013273 
+0.087000 0.013567 
+0.088000 0.013865 
+0.089000 0.014165 
+0.090000 0.014467 
+0.091000 0.014773 
+0.092000 0.015080 
+0.093000 0.015391 
+0.094000 0.015704 
+0.095000 0.016019 
+0.096000 0.016337 
+0.097000 0.016658 
+0.098000 0.016981 
+0.099000 0.017306 
+0.100000 0.017634 
+0.101000 0.017964 
+0.102000 0.018296 
+0.103000 0.018631 
+0.104000 0.018967 
+0.105000 0.019307 
+0.106000 0.019648 
+0.107000 0.019991 
+0.108000 0.020337 
+0.109000 0.020685 
+0.110000 0.021035 
+0.111000 0.021387 
+0.112000 0.021741 
+0.113000 0.022097 
+0.114000 0.022455 
+0.115000 0.022815 
+0.116000 0.023177 
+0.117000 0.023541 
+0.118000 0.023907 
+0.119000 0.024274 
+0.120000 0.024644 
+0.121000 0.025015 
+0.122000 0.025388 
+0.123000 0.025762 
+0.124000 0.026139 
+0.125000 0.026517 
+0.126000 0.026896 
+0.127000 0.027277 
+0.128000 0.027660 
+0.129000 0.028044 
+0.130000 0.028430 
+0.131000 0.028817 
+0.132000 0.029206 
+0.133000 0.029595 
+0.134000 0.029987 
+0.135000 0.030379 
+0.136000 0.030773 
+0.137000 0.031169 
+0.138000 0.031565 
+0.139000 0.031963 
+0.140000 0.032362 
+0.141000 0.032761 
+0.142000 0.033162 
+0.143000 0.033565 
+0.144000 0.033968 
+0.145000 0.034372 
+0.146000 0.034777 
+0.147000 0.035183 
+0.148000 0.035590 
+0.149000 0.035997 
+0.150000 0.036406 
+0.151000 0.036815 
+0.152000 0.037225 
+0.153000 0.037636 
+0.154000 0.038047 
+0.155000 0.038459 
+0.156000 0.038872 
+0.157000 0.039285 
+0.158000 0.039699 
+0.159000 0.040113 
+0.160000 0.040528 
+0.161000 0.040943 
+0.162000 0.041358 
+0.163000 0.041774 
+0.164000 0.042190 
+0.165000 0.042607 
+0.166000 0.043023 
+0.167000 0.043440 
+0.168000 0.043857 
+0.169000 0.044274 
+0.170000 0.044692 
+0.171000 0.045109 
+0.172000 0.045526 
+0.173000 0.045944 
+0.174000 0.046361 
+0.175000 0.046778 
+0.176000 0.047195 
+0.177000 0.047612 
+0.178000 0.048028 
+0.179000 0.048445 
+0.180000 0.048861 
+0.181000 0.049276 
+0.182000 0.049692 
+0.183000 0.050107 
+0.184000 0.050521 
+0.185000 0.050935 
+0.186000 0.051349 
+0.187000 0.051762 
+0.188000 0.052174 
+0.189000 0.052586 
+0.190000 0.052997 
+0.191000 0.053408 
+0.192000 0.053817 
+0.193000 0.054226 
+0.194000 0.054634 
+0.195000 0.055042 
+0.196000 0.055448 
+0.197000 0.055853 
+0.198000 0.056258 
+0.199000 0.056661 
+0.200000 0.057063 
+0.201000 0.057465 
+0.202000 0.057865 
+0.203000 0.058264 
+0.204000 0.058662 
+0.205000 0.059058 
+0.206000 0.059453 
+0.207000 0.059847 
+0.208000 0.060240 
+0.209000 0.060631 
+0.210000 0.061021 
+0.211000 0.061409 
+0.212000 0.061796 
+0.213000 0.062181 
+0.214000 0.062565 
+0.215000 0.062947 
+0.216000 0.063327 
+0.217000 0.063706 
+0.218000 0.064083 
+0.219000 0.064458 
+0.220000 0.064831 
+0.221000 0.065202 
+0.222000 0.065572 
+0.223000 0.065940 
+0.224000 0.066305 
+0.225000 0.066669 
+0.226000 0.067031 
+0.227000 0.067390 
+0.228000 0.067748 
+0.229000 0.068103 
+0.230000 0.068456 
+0.231000 0.068807 
+0.232000 0.069155 
+0.233000 0.069502 
+0.234000 0.069846 
+0.235000 0.070187 
+0.236000 0.070526 
+0.237000 0.070863 
+0.238000 0.071197 
+0.239000 0.071529 
+0.240000 0.071858 
+0.241000 0.072184 
+0.242000 0.072508 
+0.243000 0.072830 
+0.244000 0.073148 
+0.245000 0.073464 
+0.246000 0.073777 
+0.247000 0.074087 
+0.248000 0.074394 
+0.249000 0.074699 
+0.250000 0.075000 
+0.251000 0.075299 
+0.252000 0.075594 
+0.253000 0.075887 
+0.254000 0.076176 
+0.255000 0.076462 
+0.256000 0.076745 
+0.257000 0.077025 
+0.258000 0.077302 
+0.259000 0.077576 
+0.260000 0.077846 
+0.261000 0.078113 
+0.262000 0.078377 
+0.263000 0.078637 
+0.264000 0.078894 
+0.265000 0.079147 
+0.266000 0.079397 
+0.267000 0.079643 
+0.268000 0.079886 
+0.269000 0.080126 
+0.270000 0.080361 
+0.271000 0.080593 
+0.272000 0.080822 
+0.273000 0.081046 
+0.274000 0.081267 
+0.275000 0.081484 
+0.276000 0.081698 
+0.277000 0.081907 
+0.278000 0.082113 
+0.279000 0.082314 
+0.280000 0.082512 
+0.281000 0.082706 
+0.282000 0.082896 
+0.283000 0.083082 
+0.284000 0.083263 
+0.285000 0.083441 
+0.286000 0.083614 
+0.287000 0.083784 
+0.288000 0.083949 
+0.289000 0.084110 
+0.290000 0.084267 
+0.291000 0.084419 
+0.292000 0.084567 
+0.293000 0.084711 
+0.294000 0.084851 
+0.295000 0.084986 
+0.296000 0.085117 
+0.297000 0.085243 
+0.298000 0.085365 
+0.299000 0.085482 
+0.300000 0.085595 
+0.301000 0.085703 
+0.302000 0.085807 
+0.303000 0.085906 
+0.304000 0.086001 
+0.305000 0.086091 
+0.306000 0.086176 
+0.307000 0.086256 
+0.308000 0.086332 
+0.309000 0.086403 
+0.310000 0.086469 
+0.311000 0.086531 
+0.312000 0.086587 
+0.313000 0.086639 
+0.314000 0.086686 
+0.315000 0.086728 
+0.316000 0.086765 
+0.317000 0.086797 
+0.318000 0.086824 
+0.319000 0.086846 
+0.320000 0.086863 
+0.321000 0.086876 
+0.322000 0.086883 
+0.323000 0.086885 
+0.324000 0.086881 
+0.325000 0.086873 
+0.326000 0.086860 
+0.327000 0.086841 
+0.328000 0.086817 
+0.329000 0.086789 
+0.330000 0.086754 
+0.331000 0.086715 
+0.332000 0.086670 
+0.333000 0.086620 
+0.334000 0.086565 
+0.335000 0.086505 
+0.336000 0.086439 
+0.337000 0.086367 
+0.338000 0.086291 
+0.339000 0.086209 
+0.340000 0.086121 
+0.341000 0.086029 
+0.342000 0.085930 
+0.343000 0.085827 
+0.344000 0.085717 
+0.345000 0.085603 
+0.346000 0.085483 
+0.347000 0.085357 
+0.348000 0.085226 
+0.349000 0.085089 
+0.350000 0.084947 
+0.351000 0.084799 
+0.352000 0.084645 
+0.353000 0.084486 
+0.354000 0.084322 
+0.355000 0.084152 
+0.356000 0.083976 
+0.357000 0.083794 
+0.358000 0.083607 
+0.359000 0.083414 
+0.360000 0.083215 
+0.361000 0.083011 
+0.362000 0.082801 
+0.363000 0.082586 
+0.364000 0.082364 
+0.365000 0.082137 
+0.366000 0.081904 
+0.367000 0.081666 
+0.368000 0.081421 
+0.369000 0.081171 
+0.370000 0.080916 
+0.371000 0.080654 
+0.372000 0.080386 
+0.373000 0.080113 
+0.374000 0.079834 
+0.375000 0.079550 
+0.376000 0.079259 
+0.377000 0.078963 
+0.378000 0.078660 
+0.379000 0.078352 
+0.380000 0.078038 
+0.381000 0.077719 
+0.382000 0.077393 
+0.383000 0.077062 
+0.384000 0.076725 
+0.385000 0.076382 
+0.386000 0.076033 
+0.387000 0.075678 
+0.388000 0.075317 
+0.389000 0.074951 
+0.390000 0.074579 
+0.391000 0.074200 
+0.392000 0.073817 
+0.393000 0.073427 
+0.394000 0.073031 
+0.395000 0.072629 
+0.396000 0.072222 
+0.397000 0.071809 
+0.398000 0.071390 
+0.399000 0.070965 
+0.400000 0.070534 
+0.401000 0.070098 
+0.402000 0.069655 
+0.403000 0.069207 
+0.404000 0.068753 
+0.405000 0.068293 
+0.406000 0.067827 
+0.407000 0.067356 
+0.408000 0.066879 
+0.409000 0.066396 
+0.410000 0.065907 
+0.411000 0.065412 
+0.412000 0.064912 
+0.413000 0.064405 
+0.414000 0.063893 
+0.415000 0.063376 
+0.416000 0.062852 
+0.417000 0.062323 
+0.418000 0.061788 
+0.419000 0.061247 
+0.420000 0.060701 
+0.421000 0.060149 
+0.422000 0.059591 
+0.423000 0.059028 
+0.424000 0.058459 
+0.425000 0.057884 
+0.426000 0.057303 
+0.427000 0.056717 
+0.428000 0.056126 
+0.429000 0.055528 
+0.430000 0.054926 
+0.431000 0.054317 
+0.432000 0.053703 
+0.433000 0.053084 
+0.434000 0.052458 
+0.435000 0.051828 
+0.436000 0.051192 
+0.437000 0.050550 
+0.438000 0.049903 
+0.439000 0.049250 
+0.440000 0.048592 
+0.441000 0.047929 
+0.442000 0.047260 
+0.443000 0.046586 
+0.444000 0.045906 
+0.445000 0.045222 
+0.446000 0.044531 
+0.447000 0.043836 
+0.448000 0.043135 
+0.449000 0.042429 
+0.450000 0.041717 
+0.451000 0.041001 
+0.452000 0.040279 
+0.453000 0.039552 
+0.454000 0.038820 
+0.455000 0.038082 
+0.456000 0.037340 
+0.457000 0.036592 
+0.458000 0.035840 
+0.459000 0.035082 
+0.460000 0.034319 
+0.461000 0.033551 
+0.462000 0.032779 
+0.463000 0.032001 
+0.464000 0.031218 
+0.465000 0.030431 
+0.466000 0.029639 
+0.467000 0.028841 
+0.468000 0.028039 
+0.469000 0.027232 
+0.470000 0.026421 
+0.471000 0.025604 
+0.472000 0.024783 
+0.473000 0.023957 
+0.474000 0.023127 
+0.475000 0.022292 
+0.476000 0.021452 
+0.477000 0.020608 
+0.478000 0.019759 
+0.479000 0.018906 
+0.480000 0.018048 
+0.481000 0.017186 
+0.482000 0.016319 
+0.483000 0.015448 
+0.484000 0.014573 
+0.485000 0.013693 
+0.486000 0.012809 
+0.487000 0.011920 
+0.488000 0.011028 
+0.489000 0.010131 
+0.490000 0.009230 
+0.491000 0.008325 
+0.492000 0.007416 
+0.493000 0.006503 
+0.494000 0.005586 
+0.495000 0.004664 
+0.496000 0.003739 
+0.497000 0.002810 
+0.498000 0.001877 
+0.499000 0.000941 
+0.500000 0.000000 
+0.501000 -0.000944 
+0.502000 -0.001892 
+0.503000 -0.002844 
+0.504000 -0.003800 
+0.505000 -0.004759 
+0.506000 -0.005721 
+0.507000 -0.006688 
+0.508000 -0.007657 
+0.509000 -0.008630 
+0.510000 -0.009607 
+0.511000 -0.010587 
+0.512000 -0.011570 
+0.513000 -0.012557 
+0.514000 -0.013547 
+0.515000 -0.014540 
+0.516000 -0.015536 
+0.517000 -0.016535 
+0.518000 -0.017538 
+0.519000 -0.018543 
+0.520000 -0.019552 
+0.521000 -0.020564 
+0.522000 -0.021578 
+0.523000 -0.022595 
+0.524000 -0.023615 
+0.525000 -0.024638 
+0.526000 -0.025664 
+0.527000 -0.026693 
+0.528000 -0.027724 
+0.529000 -0.028757 
+0.530000 -0.029794 
+0.531000 -0.030832 
+0.532000 -0.031874 
+0.533000 -0.032917 
+0.534000 -0.033964 
+0.535000 -0.035012 
+0.536000 -0.036063 
+0.537000 -0.037116 
+0.538000 -0.038171 
+0.539000 -0.039228 
+0.540000 -0.040288 
+0.541000 -0.041349 
+0.542000 -0.042413 
+0.543000 -0.043478 
+0.544000 -0.044546 
+0.545000 -0.045615 
+0.546000 -0.046686 
+0.547000 -0.047759 
+0.548000 -0.048834 
+0.549000 -0.049910 
+0.550000 -0.050988 
+0.551000 -0.052067 
+0.552000 -0.053148 
+0.553000 -0.054231 
+0.554000 -0.055315 
+0.555000 -0.056400 
+0.556000 -0.057486 
+0.557000 -0.058574 
+0.558000 -0.059663 
+0.559000 -0.060754 
+0.560000 -0.061845 
+0.561000 -0.062937 
+0.562000 -0.064031 
+0.563000 -0.065125 
+0.564000 -0.066220 
+0.565000 -0.067317 
+0.566000 -0.068414 
+0.567000 -0.069511 
+0.568000 -0.070610 
+0.569000 -0.071709 
+0.570000 -0.072808 
+0.571000 -0.073908 
+0.572000 -0.075009 
+0.573000 -0.076110 
+0.574000 -0.077212 
+0.575000 -0.078313 
+0.576000 -0.079415 
+0.577000 -0.080518 
+0.578000 -0.081620 
+0.579000 -0.082723 
+0.580000 -0.083825 
+0.581000 -0.084928 
+0.582000 -0.086030 
+0.583000 -0.087133 
+0.584000 -0.088235 
+0.585000 -0.089337 
+0.586000 -0.090438 
+0.587000 -0.091540 
+0.588000 -0.092641 
+0.589000 -0.093741 
+0.590000 -0.094841 
+0.591000 -0.095941 
+0.592000 -0.097040 
+0.593000 -0.098138 
+0.594000 -0.099235 
+0.595000 -0.100332 
+0.596000 -0.101428 
+0.597000 -0.102523 
+0.598000 -0.103617 
+0.599000 -0.104709 
+0.600000 -0.105801 
+0.601000 -0.106892 
+0.602000 -0.107982 
+0.603000 -0.109070 
+0.604000 -0.110157 
+0.605000 -0.111243 
+0.606000 -0.112327 
+0.607000 -0.113410 
+0.608000 -0.114491 
+0.609000 -0.115571 
+0.610000 -0.116649 
+0.611000 -0.117725 
+0.612000 -0.118799 
+0.613000 -0.119872 
+0.614000 -0.120943 
+0.615000 -0.122012 
+0.616000 -0.123079 
+0.617000 -0.124144 
+0.618000 -0.125207 
+0.619000 -0.126267 
+0.620000 -0.127326 
+0.621000 -0.128382 
+0.622000 -0.129436 
+0.623000 -0.130487 
+0.624000 -0.131536 
+0.625000 -0.132583 
+0.626000 -0.133626 
+0.627000 -0.134668 
+0.628000 -0.135706 
+0.629000 -0.136742 
+0.630000 -0.137775 
+0.631000 -0.138805 
+0.632000 -0.139832 
+0.633000 -0.140857 
+0.634000 -0.141878 
+0.635000 -0.142896 
+0.636000 -0.143911 
+0.637000 -0.144923 
+0.638000 -0.145931 
+0.639000 -0.146937 
+0.640000 -0.147939 
+0.641000 -0.148937 
+0.642000 -0.149932 
+0.643000 -0.150923 
+0.644000 -0.151911 
+0.645000 -0.152895 
+0.646000 -0.153875 
+0.647000 -0.154852 
+0.648000 -0.155825 
+0.649000 -0.156793 
+0.650000 -0.157758 
+0.651000 -0.158719 
+0.652000 -0.159676 
+0.653000 -0.160629 
+0.654000 -0.161577 
+0.655000 -0.162521 
+0.656000 -0.163461 
+0.657000 -0.164397 
+0.658000 -0.165328 
+0.659000 -0.166255 
+0.660000 -0.167177 
+0.661000 -0.168095 
+0.662000 -0.169008 
+0.663000 -0.169916 
+0.664000 -0.170819 
+0.665000 -0.171718 
+0.666000 -0.172612 
+0.667000 -0.173501 
+0.668000 -0.174385 
+0.669000 -0.175264 
+0.670000 -0.176138 
+0.671000 -0.177006 
+0.672000 -0.177870 
+0.673000 -0.178728 
+0.674000 -0.179581 
+0.675000 -0.180429 
+0.676000 -0.181271 
+0.677000 -0.182108 
+0.678000 -0.182939 
+0.679000 -0.183765 
+0.680000 -0.184585 
+0.681000 -0.185399 
+0.682000 -0.186208 
+0.683000 -0.187011 
+0.684000 -0.187807 
+0.685000 -0.188599 
+0.686000 -0.189384 
+0.687000 -0.190163 
+0.688000 -0.190936 
+0.689000 -0.191703 
+0.690000 -0.192464 
+0.691000 -0.193218 
+0.692000 -0.193967 
+0.693000 -0.194709 
+0.694000 -0.195444 
+0.695000 -0.196174 
+0.696000 -0.196896 
+0.697000 -0.197613 
+0.698000 -0.198322 
+0.699000 -0.199025 
+0.700000 -0.199722 
+0.701000 -0.200412 
+0.702000 -0.201094 
+0.703000 -0.201771 
+0.704000 -0.202440 
+0.705000 -0.203102 
+0.706000 -0.203757 
+0.707000 -0.204406 
+0.708000 -0.205047 
+0.709000 -0.205681 
+0.710000 -0.206308 
+0.711000 -0.206928 
+0.712000 -0.207541 
+0.713000 -0.208146 
+0.714000 -0.208744 
+0.715000 -0.209334 
+0.716000 -0.209917 
+0.717000 -0.210493 
+0.718000 -0.211061 
+0.719000 -0.211621 
+0.720000 -0.212174 
+0.721000 -0.212719 
+0.722000 -0.213257 
+0.723000 -0.213786 
+0.724000 -0.214308 
+0.725000 -0.214822 
+0.726000 -0.215328 
+0.727000 -0.215827 
+0.728000 -0.216317 
+0.729000 -0.216799 
+0.730000 -0.217273 
+0.731000 -0.217739 
+0.732000 -0.218197 
+0.733000 -0.218647 
+0.734000 -0.219088 
+0.735000 -0.219521 
+0.736000 -0.219946 
+0.737000 -0.220363 
+0.738000 -0.220771 
+0.739000 -0.221171 
+0.740000 -0.221562 
+0.741000 -0.221945 
+0.742000 -0.222319 
+0.743000 -0.222684 
+0.744000 -0.223041 
+0.745000 -0.223390 
+0.746000 -0.223729 
+0.747000 -0.224060 
+0.748000 -0.224382 
+0.749000 -0.224696 
+0.750000 -0.225000 
+0.751000 -0.225296 
+0.752000 -0.225582 
+0.753000 -0.225860 
+0.754000 -0.226129 
+0.755000 -0.226388 
+0.756000 -0.226639 
+0.757000 -0.226880 
+0.758000 -0.227113 
+0.759000 -0.227336 
+0.760000 -0.227550 
+0.761000 -0.227755 
+0.762000 -0.227951 
+0.763000 -0.228137 
+0.764000 -0.228314 
+0.765000 -0.228481 
+0.766000 -0.228640 
+0.767000 -0.228789 
+0.768000 -0.228928 
+0.769000 -0.229058 
+0.770000 -0.229178 
+0.771000 -0.229289 
+0.772000 -0.229391 
+0.773000 -0.229483 
+0.774000 -0.229565 
+0.775000 -0.229638 
+0.776000 -0.229700 
+0.777000 -0.229754 
+0.778000 -0.229797 
+0.779000 -0.229831 
+0.780000 -0.229855 
+0.781000 -0.229870 
+0.782000 -0.229874 
+0.783000 -0.229869 
+0.784000 -0.229853 
+0.785000 -0.229828 
+0.786000 -0.229793 
+0.787000 -0.229749 
+0.788000 -0.229694 
+0.789000 -0.229629 
+0.790000 -0.229554 
+0.791000 -0.229469 
+0.792000 -0.229375 
+0.793000 -0.229270 
+0.794000 -0.229155 
+0.795000 -0.229030 
+0.796000 -0.228895 
+0.797000 -0.228750 
+0.798000 -0.228595 
+0.799000 -0.228429 
+0.800000 -0.228254 
+0.801000 -0.228068 
+0.802000 -0.227872 
+0.803000 -0.227666 
+0.804000 -0.227449 
+0.805000 -0.227223 
+0.806000 -0.226986 
+0.807000 -0.226739 
+0.808000 -0.226481 
+0.809000 -0.226214 
+0.810000 -0.225936 
+0.811000 -0.225647 
+0.812000 -0.225349 
+0.813000 -0.225040 
+0.814000 -0.224721 
+0.815000 -0.224391 
+0.816000 -0.224051 
+0.817000 -0.223701 
+0.818000 -0.223340 
+0.819000 -0.222969 
+0.820000 -0.222587 
+0.821000 -0.222196 
+0.822000 -0.221793 
+0.823000 -0.221381 
+0.824000 -0.220958 
+0.825000 -0.220524 
+0.826000 -0.220080 
+0.827000 -0.219626 
+0.828000 -0.219161 
+0.829000 -0.218686 
+0.830000 -0.218200 
+0.831000 -0.217704 
+0.832000 -0.217198 
+0.833000 -0.216681 
+0.834000 -0.216154 
+0.835000 -0.215616 
+0.836000 -0.215068 
+0.837000 -0.214509 
+0.838000 -0.213940 
+0.839000 -0.213361 
+0.840000 -0.212771 
+0.841000 -0.212170 
+0.842000 -0.211560 
+0.843000 -0.210938 
+0.844000 -0.210307 
+0.845000 -0.209665 
+0.846000 -0.209013 
+0.847000 -0.208350 
+0.848000 -0.207677 
+0.849000 -0.206993 
+0.850000 -0.206299 
+0.851000 -0.205595 
+0.852000 -0.204881 
+0.853000 -0.204156 
+0.854000 -0.203420 
+0.855000 -0.202675 
+0.856000 -0.201919 
+0.857000 -0.201153 
+0.858000 -0.200376 
+0.859000 -0.199589 
+0.860000 -0.198792 
+0.861000 -0.197985 
+0.862000 -0.197168 
+0.863000 -0.196340 
+0.864000 -0.195502 
+0.865000 -0.194654 
+0.866000 -0.193796 
+0.867000 -0.192927 
+0.868000 -0.192048 
+0.869000 -0.191160 
+0.870000 -0.190261 
+0.871000 -0.189352 
+0.872000 -0.188433 
+0.873000 -0.187504 
+0.874000 -0.186565 
+0.875000 -0.185616 
+0.876000 -0.184656 
+0.877000 -0.183687 
+0.878000 -0.182708 
+0.879000 -0.181719 
+0.880000 -0.180720 
+0.881000 -0.179712 
+0.882000 -0.178693 
+0.883000 -0.177665 
+0.884000 -0.176626 
+0.885000 -0.175578 
+0.886000 -0.174520 
+0.887000 -0.173453 
+0.888000 -0.172376 
+0.889000 -0.171289 
+0.890000 -0.170192 
+0.891000 -0.169086 
+0.892000 -0.167970 
+0.893000 -0.166845 
+0.894000 -0.165710 
+0.895000 -0.164566 
+0.896000 -0.163412 
+0.897000 -0.162248 
+0.898000 -0.161076 
+0.899000 -0.159893 
+0.900000 -0.158702 
+0.901000 -0.157501 
+0.902000 -0.156291 
+0.903000 -0.155072 
+0.904000 -0.153843 
+0.905000 -0.152606 
+0.906000 -0.151359 
+0.907000 -0.150103 
+0.908000 -0.148838 
+0.909000 -0.147564 
+0.910000 -0.146281 
+0.911000 -0.144989 
+0.912000 -0.143688 
+0.913000 -0.142378 
+0.914000 -0.141059 
+0.915000 -0.139732 
+0.916000 -0.138396 
+0.917000 -0.137051 
+0.918000 -0.135697 
+0.919000 -0.134335 
+0.920000 -0.132964 
+0.921000 -0.131585 
+0.922000 -0.130197 
+0.923000 -0.128800 
+0.924000 -0.127396 
+0.925000 -0.125982 
+0.926000 -0.124561 
+0.927000 -0.123131 
+0.928000 -0.121693 
+0.929000 -0.120247 
+0.930000 -0.118792 
+0.931000 -0.117330 
+0.932000 -0.115859 
+0.933000 -0.114381 
+0.934000 -0.112894 
+0.935000 -0.111400 
+0.936000 -0.109898 
+0.937000 -0.108388 
+0.938000 -0.106870 
+0.939000 -0.105344 
+0.940000 -0.103811 
+0.941000 -0.102270 
+0.942000 -0.100722 
+0.943000 -0.099166 
+0.944000 -0.097603 
+0.945000 -0.096032 
+0.946000 -0.094454 
+0.947000 -0.092869 
+0.948000 -0.091276 
+0.949000 -0.089677 
+0.950000 -0.088070 
+0.951000 -0.086456 
+0.952000 -0.084835 
+0.953000 -0.083207 
+0.954000 -0.081573 
+0.955000 -0.079931 
+0.956000 -0.078283 
+0.957000 -0.076628 
+0.958000 -0.074966 
+0.959000 -0.073298 
+0.960000 -0.071623 
+0.961000 -0.069941 
+0.962000 -0.068254 
+0.963000 -0.066560 
+0.964000 -0.064859 
+0.965000 -0.063152 
+0.966000 -0.061440 
+0.967000 -0.059721 
+0.968000 -0.057996 
+0.969000 -0.056265 
+0.970000 -0.054528 
+0.971000 -0.052785 
+0.972000 -0.051037 
+0.973000 -0.049282 
+0.974000 -0.047523 
+0.975000 -0.045757 
+0.976000 -0.043986 
+0.977000 -0.042210 
+0.978000 -0.040428 
+0.979000 -0.038640 
+0.980000 -0.036848 
+0.981000 -0.035050 
+0.982000 -0.033247 
+0.983000 -0.031440 
+0.984000 -0.029627 
+0.985000 -0.027809 
+0.986000 -0.025986 
+0.987000 -0.024159 
+0.988000 -0.022327 
+0.989000 -0.020490 
+0.990000 -0.018649 
+0.991000 -0.016803 
+0.992000 -0.014953 
+0.993000 -0.013098 
+0.994000 -0.011239 
+0.995000 -0.009376 
+0.996000 -0.007509 
+0.997000 -0.005638 
+0.998000 -0.003762 
+0.999000 -0.001883 
+1.000000 -0.000000 
+1.001000 0.001887 
+1.002000 0.003777 
+1.003000 0.005671 
+1.004000 0.007569 
+1.005000 0.009470 
+1.006000 0.011375 
+1.007000 0.013283 
+1.008000 0.015194 
+1.009000 0.017108 
+1.010000 0.019026 
+1.011000 0.020946 
+1.012000 0.022869 
+1.013000 0.024795 
+1.014000 0.026724 
+1.015000 0.028656 
+1.016000 0.030590 
+1.017000 0.032527 
+1.018000 0.034466 
+1.019000 0.036408 
+1.020000 0.038352 
+1.021000 0.040298 
+1.022000 0.042247 
+1.023000 0.044197 
+1.024000 0.046149 
+1.025000 0.048104 
+1.026000 0.050060 
+1.027000 0.052018 
+1.028000 0.053977 
+1.029000 0.055938 
+1.030000 0.057901 
+1.031000 0.059865 
+1.032000 0.061830 
+1.033000 0.063797 
+1.034000 0.065765 
+1.035000 0.067733 
+1.036000 0.069703 
+1.037000 0.071674 
+1.038000 0.073646 
+1.039000 0.075618 
+1.040000 0.077591 
+1.041000 0.079565 
+1.042000 0.081539 
+1.043000 0.083514 
+1.044000 0.085489 
+1.045000 0.087464 
+1.046000 0.089439 
+1.047000 0.091414 
+1.048000 0.093390 
+1.049000 0.095365 
+1.050000 0.097340 
+1.051000 0.099315 
+1.052000 0.101290 
+1.053000 0.103264 
+1.054000 0.105238 
+1.055000 0.107211 
+1.056000 0.109183 
+1.057000 0.111154 
+1.058000 0.113125 
+1.059000 0.115095 
+1.060000 0.117064 
+1.061000 0.119031 
+1.062000 0.120998 
+1.063000 0.122963 
+1.064000 0.124926 
+1.065000 0.126889 
+1.066000 0.128849 
+1.067000 0.130809 
+1.068000 0.132766 
+1.069000 0.134721 
+1.070000 0.136675 
+1.071000 0.138627 
+1.072000 0.140576 
+1.073000 0.142524 
+1.074000 0.144469 
+1.075000 0.146412 
+1.076000 0.148352 
+1.077000 0.150290 
+1.078000 0.152226 
+1.079000 0.154158 
+1.080000 0.156088 
+1.081000 0.158015 
+1.082000 0.159939 
+1.083000 0.161860 
+1.084000 0.163778 
+1.085000 0.165693 
+1.086000 0.167604 
+1.087000 0.169512 
+1.088000 0.171417 
+1.089000 0.173318 
+1.090000 0.175215 
+1.091000 0.177109 
+1.092000 0.178999 
+1.093000 0.180885 
+1.094000 0.182767 
+1.095000 0.184644 
+1.096000 0.186518 
+1.097000 0.188387 
+1.098000 0.190252 
+1.099000 0.192113 
+1.100000 0.193969 
+1.101000 0.195821 
+1.102000 0.197667 
+1.103000 0.199509 
+1.104000 0.201347 
+1.105000 0.203179 
+1.106000 0.205006 
+1.107000 0.206828 
+1.108000 0.208645 
+1.109000 0.210456 
+1.110000 0.212262 
+1.111000 0.214063 
+1.112000 0.215858 
+1.113000 0.217647 
+1.114000 0.219431 
+1.115000 0.221209 
+1.116000 0.222981 
+1.117000 0.224747 
+1.118000 0.226507 
+1.119000 0.228260 
+1.120000 0.230008 
+1.121000 0.231749 
+1.122000 0.233484 
+1.123000 0.235212 
+1.124000 0.236934 
+1.125000 0.238649 
+1.126000 0.240357 
+1.127000 0.242058 
+1.128000 0.243753 
+1.129000 0.245440 
+1.130000 0.247120 
+1.131000 0.248794 
+1.132000 0.250459 
+1.133000 0.252118 
+1.134000 0.253769 
+1.135000 0.255413 
+1.136000 0.257049 
+1.137000 0.258677 
+1.138000 0.260298 
+1.139000 0.261911 
+1.140000 0.263516 
+1.141000 0.265112 
+1.142000 0.266701 
+1.143000 0.268282 
+1.144000 0.269854 
+1.145000 0.271418 
+1.146000 0.272974 
+1.147000 0.274521 
+1.148000 0.276060 
+1.149000 0.277590 
+1.150000 0.279111 
+1.151000 0.280623 
+1.152000 0.282127 
+1.153000 0.283621 
+1.154000 0.285107 
+1.155000 0.286583 
+1.156000 0.288051 
+1.157000 0.289509 
+1.158000 0.290957 
+1.159000 0.292396 
+1.160000 0.293826 
+1.161000 0.295246 
+1.162000 0.296657 
+1.163000 0.298057 
+1.164000 0.299448 
+1.165000 0.300829 
+1.166000 0.302200 
+1.167000 0.303561 
+1.168000 0.304912 
+1.169000 0.306253 
+1.170000 0.307584 
+1.171000 0.308904 
+1.172000 0.310214 
+1.173000 0.311513 
+1.174000 0.312802 
+1.175000 0.314080 
+1.176000 0.315347 
+1.177000 0.316604 
+1.178000 0.317850 
+1.179000 0.319085 
+1.180000 0.320309 
+1.181000 0.321522 
+1.182000 0.322724 
+1.183000 0.323914 
+1.184000 0.325094 
+1.185000 0.326262 
+1.186000 0.327418 
+1.187000 0.328564 
+1.188000 0.329698 
+1.189000 0.330820 
+1.190000 0.331930 
+1.191000 0.333029 
+1.192000 0.334116 
+1.193000 0.335191 
+1.194000 0.336254 
+1.195000 0.337306 
+1.196000 0.338345 
+1.197000 0.339372 
+1.198000 0.340387 
+1.199000 0.341390 
+1.200000 0.342380 
+1.201000 0.343358 
+1.202000 0.344324 
+1.203000 0.345277 
+1.204000 0.346218 
+1.205000 0.347146 
+1.206000 0.348062 
+1.207000 0.348964 
+1.208000 0.349854 
+1.209000 0.350731 
+1.210000 0.351596 
+1.211000 0.352447 
+1.212000 0.353285 
+1.213000 0.354111 
+1.214000 0.354923 
+1.215000 0.355722 
+1.216000 0.356507 
+1.217000 0.357280 
+1.218000 0.358039 
+1.219000 0.358785 
+1.220000 0.359517 
+1.221000 0.360236 
+1.222000 0.360941 
+1.223000 0.361633 
+1.224000 0.362311 
+1.225000 0.362975 
+1.226000 0.363626 
+1.227000 0.364263 
+1.228000 0.364886 
+1.229000 0.365495 
+1.230000 0.366090 
+1.231000 0.366672 
+1.232000 0.367239 
+1.233000 0.367792 
+1.234000 0.368331 
+1.235000 0.368856 
+1.236000 0.369366 
+1.237000 0.369863 
+1.238000 0.370345 
+1.239000 0.370813 
+1.240000 0.371266 
+1.241000 0.371705 
+1.242000 0.372129 
+1.243000 0.372539 
+1.244000 0.372935 
+1.245000 0.373316 
+1.246000 0.373682 
+1.247000 0.374034 
+1.248000 0.374370 
+1.249000 0.374693 
+1.250000 0.375000 
+1.251000 0.375293 
+1.252000 0.375570 
+1.253000 0.375833 
+1.254000 0.376081 
+1.255000 0.376314 
+1.256000 0.376532 
+1.257000 0.376735 
+1.258000 0.376923 
+1.259000 0.377096 
+1.260000 0.377254 
+1.261000 0.377397 
+1.262000 0.377524 
+1.263000 0.377637 
+1.264000 0.377734 
+1.265000 0.377816 
+1.266000 0.377882 
+1.267000 0.377934 
+1.268000 0.377970 
+1.269000 0.377990 
+1.270000 0.377996 
+1.271000 0.377986 
+1.272000 0.377960 
+1.273000 0.377919 
+1.274000 0.377863 
+1.275000 0.377791 
+1.276000 0.377703 
+1.277000 0.377600 
+1.278000 0.377482 
+1.279000 0.377348 
+1.280000 0.377198 
+1.281000 0.377033 
+1.282000 0.376852 
+1.283000 0.376656 
+1.284000 0.376444 
+1.285000 0.376216 
+1.286000 0.375972 
+1.287000 0.375713 
+1.288000 0.375439 
+1.289000 0.375148 
+1.290000 0.374842 
+1.291000 0.374520 
+1.292000 0.374182 
+1.293000 0.373828 
+1.294000 0.373459 
+1.295000 0.373074 
+1.296000 0.372673 
+1.297000 0.372257 
+1.298000 0.371824 
+1.299000 0.371376 
+1.300000 0.370912 
+1.301000 0.370432 
+1.302000 0.369937 
+1.303000 0.369425 
+1.304000 0.368898 
+1.305000 0.368355 
+1.306000 0.367796 
+1.307000 0.367221 
+1.308000 0.366631 
+1.309000 0.366024 
+1.310000 0.365402 
+1.311000 0.364764 
+1.312000 0.364110 
+1.313000 0.363441 
+1.314000 0.362755 
+1.315000 0.362054 
+1.316000 0.361337 
+1.317000 0.360604 
+1.318000 0.359856 
+1.319000 0.359092 
+1.320000 0.358312 
+1.321000 0.357516 
+1.322000 0.356704 
+1.323000 0.355877 
+1.324000 0.355034 
+1.325000 0.354175 
+1.326000 0.353301 
+1.327000 0.352411 
+1.328000 0.351505 
+1.329000 0.350583 
+1.330000 0.349646 
+1.331000 0.348694 
+1.332000 0.347725 
+1.333000 0.346742 
+1.334000 0.345742 
+1.335000 0.344727 
+1.336000 0.343697 
+1.337000 0.342651 
+1.338000 0.341589 
+1.339000 0.340512 
+1.340000 0.339420 
+1.341000 0.338312 
+1.342000 0.337189 
+1.343000 0.336050 
+1.344000 0.334896 
+1.345000 0.333727 
+1.346000 0.332542 
+1.347000 0.331343 
+1.348000 0.330128 
+1.349000 0.328897 
+1.350000 0.327652 
+1.351000 0.326391 
+1.352000 0.325116 
+1.353000 0.323825 
+1.354000 0.322519 
+1.355000 0.321198 
+1.356000 0.319862 
+1.357000 0.318511 
+1.358000 0.317146 
+1.359000 0.315765 
+1.360000 0.314369 
+1.361000 0.312959 
+1.362000 0.311534 
+1.363000 0.310094 
+1.364000 0.308640 
+1.365000 0.307170 
+1.366000 0.305687 
+1.367000 0.304188 
+1.368000 0.302675 
+1.369000 0.301148 
+1.370000 0.299606 
+1.371000 0.298050 
+1.372000 0.296479 
+1.373000 0.294894 
+1.374000 0.293295 
+1.375000 0.291682 
+1.376000 0.290054 
+1.377000 0.288412 
+1.378000 0.286756 
+1.379000 0.285086 
+1.380000 0.283403 
+1.381000 0.281705 
+1.382000 0.279993 
+1.383000 0.278267 
+1.384000 0.276528 
+1.385000 0.274775 
+1.386000 0.273008 
+1.387000 0.271228 
+1.388000 0.269434 
+1.389000 0.267627 
+1.390000 0.265806 
+1.391000 0.263972 
+1.392000 0.262124 
+1.393000 0.260263 
+1.394000 0.258389 
+1.395000 0.256502 
+1.396000 0.254601 
+1.397000 0.252688 
+1.398000 0.250761 
+1.399000 0.248822 
+1.400000 0.246870 
+1.401000 0.244905 
+1.402000 0.242927 
+1.403000 0.240937 
+1.404000 0.238934 
+1.405000 0.236918 
+1.406000 0.234890 
+1.407000 0.232850 
+1.408000 0.230797 
+1.409000 0.228732 
+1.410000 0.226655 
+1.411000 0.224565 
+1.412000 0.222464 
+1.413000 0.220351 
+1.414000 0.218225 
+1.415000 0.216088 
+1.416000 0.213939 
+1.417000 0.211778 
+1.418000 0.209606 
+1.419000 0.207422 
+1.420000 0.205227 
+1.421000 0.203020 
+1.422000 0.200802 
+1.423000 0.198573 
+1.424000 0.196333 
+1.425000 0.194081 
+1.426000 0.191818 
+1.427000 0.189545 
+1.428000 0.187260 
+1.429000 0.184965 
+1.430000 0.182659 
+1.431000 0.180343 
+1.432000 0.178016 
+1.433000 0.175678 
+1.434000 0.173330 
+1.435000 0.170972 
+1.436000 0.168604 
+1.437000 0.166225 
+1.438000 0.163837 
+1.439000 0.161438 
+1.440000 0.159030 
+1.441000 0.156612 
+1.442000 0.154184 
+1.443000 0.151746 
+1.444000 0.149299 
+1.445000 0.146843 
+1.446000 0.144377 
+1.447000 0.141902 
+1.448000 0.139418 
+1.449000 0.136925 
+1.450000 0.134422 
+1.451000 0.131911 
+1.452000 0.129391 
+1.453000 0.126863 
+1.454000 0.124325 
+1.455000 0.121780 
+1.456000 0.119225 
+1.457000 0.116663 
+1.458000 0.114092 
+1.459000 0.111513 
+1.460000 0.108926 
+1.461000 0.106331 
+1.462000 0.103728 
+1.463000 0.101118 
+1.464000 0.098500 
+1.465000 0.095874 
+1.466000 0.093241 
+1.467000 0.090600 
+1.468000 0.087952 
+1.469000 0.085297 
+1.470000 0.082635 
+1.471000 0.079966 
+1.472000 0.077290 
+1.473000 0.074607 
+1.474000 0.071918 
+1.475000 0.069222 
+1.476000 0.066520 
+1.477000 0.063811 
+1.478000 0.061096 
+1.479000 0.058375 
+1.480000 0.055648 
+1.481000 0.052915 
+1.482000 0.050176 
+1.483000 0.047431 
+1.484000 0.044681 
+1.485000 0.041925 
+1.486000 0.039164 
+1.487000 0.036398 
+1.488000 0.033626 
+1.489000 0.030849 
+1.490000 0.028067 
+1.491000 0.025281 
+1.492000 0.022489 
+1.493000 0.019693 
+1.494000 0.016893 
+1.495000 0.014088 
+1.496000 0.011278 
+1.497000 0.008465 
+1.498000 0.005647 
+1.499000 0.002826 
+1.500000 0.000000 
+1.501000 -0.002829 
+1.502000 -0.005662 
+1.503000 -0.008499 
+1.504000 -0.011339 
+1.505000 -0.014182 
+1.506000 -0.017028 
+1.507000 -0.019878 
+1.508000 -0.022731 
+1.509000 -0.025586 
+1.510000 -0.028444 
+1.511000 -0.031305 
+1.512000 -0.034168 
+1.513000 -0.037034 
+1.514000 -0.039902 
+1.515000 -0.042772 
+1.516000 -0.045645 
+1.517000 -0.048519 
+1.518000 -0.051395 
+1.519000 -0.054273 
+1.520000 -0.057152 
+1.521000 -0.060033 
+1.522000 -0.062915 
+1.523000 -0.065799 
+1.524000 -0.068683 
+1.525000 -0.071569 
+1.526000 -0.074455 
+1.527000 -0.077343 
+1.528000 -0.080231 
+1.529000 -0.083119 
+1.530000 -0.086008 
+1.531000 -0.088897 
+1.532000 -0.091787 
+1.533000 -0.094676 
+1.534000 -0.097566 
+1.535000 -0.100455 
+1.536000 -0.103344 
+1.537000 -0.106233 
+1.538000 -0.109121 
+1.539000 -0.112008 
+1.540000 -0.114895 
+1.541000 -0.117781 
+1.542000 -0.120665 
+1.543000 -0.123549 
+1.544000 -0.126431 
+1.545000 -0.129312 
+1.546000 -0.132192 
+1.547000 -0.135070 
+1.548000 -0.137946 
+1.549000 -0.140820 
+1.550000 -0.143693 
+1.551000 -0.146563 
+1.552000 -0.149431 
+1.553000 -0.152297 
+1.554000 -0.155160 
+1.555000 -0.158021 
+1.556000 -0.160879 
+1.557000 -0.163735 
+1.558000 -0.166587 
+1.559000 -0.169436 
+1.560000 -0.172282 
+1.561000 -0.175125 
+1.562000 -0.177964 
+1.563000 -0.180800 
+1.564000 -0.183633 
+1.565000 -0.186461 
+1.566000 -0.189285 
+1.567000 -0.192106 
+1.568000 -0.194922 
+1.569000 -0.197734 
+1.570000 -0.200542 
+1.571000 -0.203345 
+1.572000 -0.206144 
+1.573000 -0.208938 
+1.574000 -0.211727 
+1.575000 -0.214511 
+1.576000 -0.217289 
+1.577000 -0.220063 
+1.578000 -0.222831 
+1.579000 -0.225594 
+1.580000 -0.228351 
+1.581000 -0.231103 
+1.582000 -0.233848 
+1.583000 -0.236588 
+1.584000 -0.239322 
+1.585000 -0.242049 
+1.586000 -0.244770 
+1.587000 -0.247485 
+1.588000 -0.250193 
+1.589000 -0.252895 
+1.590000 -0.255589 
+1.591000 -0.258277 
+1.592000 -0.260958 
+1.593000 -0.263632 
+1.594000 -0.266298 
+1.595000 -0.268957 
+1.596000 -0.271608 
+1.597000 -0.274252 
+1.598000 -0.276888 
+1.599000 -0.279517 
+1.600000 -0.282137 
+1.601000 -0.284749 
+1.602000 -0.287353 
+1.603000 -0.289949 
+1.604000 -0.292536 
+1.605000 -0.295115 
+1.606000 -0.297685 
+1.607000 -0.300246 
+1.608000 -0.302798 
+1.609000 -0.305342 
+1.610000 -0.307876 
+1.611000 -0.310401 
+1.612000 -0.312916 
+1.613000 -0.315422 
+1.614000 -0.317919 
+1.615000 -0.320406 
+1.616000 -0.322883 
+1.617000 -0.325350 
+1.618000 -0.327807 
+1.619000 -0.330253 
+1.620000 -0.332690 
+1.621000 -0.335116 
+1.622000 -0.337532 
+1.623000 -0.339937 
+1.624000 -0.342331 
+1.625000 -0.344715 
+1.626000 -0.347087 
+1.627000 -0.349449 
+1.628000 -0.351799 
+1.629000 -0.354138 
+1.630000 -0.356466 
+1.631000 -0.358782 
+1.632000 -0.361086 
+1.633000 -0.363379 
+1.634000 -0.365660 
+1.635000 -0.367929 
+1.636000 -0.370187 
+1.637000 -0.372432 
+1.638000 -0.374664 
+1.639000 -0.376885 
+1.640000 -0.379093 
+1.641000 -0.381288 
+1.642000 -0.383471 
+1.643000 -0.385640 
+1.644000 -0.387797 
+1.645000 -0.389941 
+1.646000 -0.392072 
+1.647000 -0.394190 
+1.648000 -0.396295 
+1.649000 -0.398386 
+1.650000 -0.400463 
+1.651000 -0.402527 
+1.652000 -0.404578 
+1.653000 -0.406614 
+1.654000 -0.408637 
+1.655000 -0.410646 
+1.656000 -0.412640 
+1.657000 -0.414620 
+1.658000 -0.416586 
+1.659000 -0.418538 
+1.660000 -0.420475 
+1.661000 -0.422398 
+1.662000 -0.424306 
+1.663000 -0.426199 
+1.664000 -0.428077 
+1.665000 -0.429941 
+1.666000 -0.431789 
+1.667000 -0.433622 
+1.668000 -0.435440 
+1.669000 -0.437243 
+1.670000 -0.439030 
+1.671000 -0.440801 
+1.672000 -0.442557 
+1.673000 -0.444298 
+1.674000 -0.446022 
+1.675000 -0.447731 
+1.676000 -0.449423 
+1.677000 -0.451100 
+1.678000 -0.452761 
+1.679000 -0.454405 
+1.680000 -0.456033 
+1.681000 -0.457644 
+1.682000 -0.459239 
+1.683000 -0.460818 
+1.684000 -0.462380 
+1.685000 -0.463925 
+1.686000 -0.465453 
+1.687000 -0.466965 
+1.688000 -0.468459 
+1.689000 -0.469936 
+1.690000 -0.471397 
+1.691000 -0.472840 
+1.692000 -0.474265 
+1.693000 -0.475674 
+1.694000 -0.477065 
+1.695000 -0.478438 
+1.696000 -0.479794 
+1.697000 -0.481132 
+1.698000 -0.482452 
+1.699000 -0.483754 
+1.700000 -0.485039 
+1.701000 -0.486305 
+1.702000 -0.487554 
+1.703000 -0.488784 
+1.704000 -0.489996 
+1.705000 -0.491190 
+1.706000 -0.492366 
+1.707000 -0.493523 
+1.708000 -0.494662 
+1.709000 -0.495782 
+1.710000 -0.496883 
+1.711000 -0.497966 
+1.712000 -0.499030 
+1.713000 -0.500075 
+1.714000 -0.501102 
+1.715000 -0.502109 
+1.716000 -0.503098 
+1.717000 -0.504067 
+1.718000 -0.505017 
+1.719000 -0.505948 
+1.720000 -0.506860 
+1.721000 -0.507753 
+1.722000 -0.508626 
+1.723000 -0.509480 
+1.724000 -0.510314 
+1.725000 -0.511129 
+1.726000 -0.511924 
+1.727000 -0.512699 
+1.728000 -0.513455 
+1.729000 -0.514191 
+1.730000 -0.514908 
+1.731000 -0.515604 
+1.732000 -0.516280 
+1.733000 -0.516937 
+1.734000 -0.517574 
+1.735000 -0.518190 
+1.736000 -0.518786 
+1.737000 -0.519363 
+1.738000 -0.519919 
+1.739000 -0.520454 
+1.740000 -0.520970 
+1.741000 -0.521465 
+1.742000 -0.521940 
+1.743000 -0.522394 
+1.744000 -0.522828 
+1.745000 -0.523242 
+1.746000 -0.523635 
+1.747000 -0.524007 
+1.748000 -0.524359 
+1.749000 -0.524690 
+1.750000 -0.525000 
+1.751000 -0.525290 
+1.752000 -0.525559 
+1.753000 -0.525807 
+1.754000 -0.526034 
+1.755000 -0.526240 
+1.756000 -0.526426 
+1.757000 -0.526590 
+1.758000 -0.526734 
+1.759000 -0.526856 
+1.760000 -0.526958 
+1.761000 -0.527039 
+1.762000 -0.527098 
+1.763000 -0.527137 
+1.764000 -0.527154 
+1.765000 -0.527150 
+1.766000 -0.527125 
+1.767000 -0.527079 
+1.768000 -0.527011 
+1.769000 -0.526923 
+1.770000 -0.526813 
+1.771000 -0.526682 
+1.772000 -0.526529 
+1.773000 -0.526356 
+1.774000 -0.526160 
+1.775000 -0.525944 
+1.776000 -0.525706 
+1.777000 -0.525447 
+1.778000 -0.525167 
+1.779000 -0.524865 
+1.780000 -0.524541 
+1.781000 -0.524197 
+1.782000 -0.523831 
+1.783000 -0.523443 
+1.784000 -0.523034 
+1.785000 -0.522603 
+1.786000 -0.522151 
+1.787000 -0.521678 
+1.788000 -0.521183 
+1.789000 -0.520667 
+1.790000 -0.520129 
+1.791000 -0.519570 
+1.792000 -0.518989 
+1.793000 -0.518387 
+1.794000 -0.517763 
+1.795000 -0.517118 
+1.796000 -0.516452 
+1.797000 -0.515763 
+1.798000 -0.515054 
+1.799000 -0.514323 
+1.800000 -0.513571 
+1.801000 -0.512797 
+1.802000 -0.512001 
+1.803000 -0.511185 
+1.804000 -0.510346 
+1.805000 -0.509487 
+1.806000 -0.508606 
+1.807000 -0.507704 
+1.808000 -0.506780 
+1.809000 -0.505835 
+1.810000 -0.504869 
+1.811000 -0.503881 
+1.812000 -0.502872 
+1.813000 -0.501842 
+1.814000 -0.500790 
+1.815000 -0.499717 
+1.816000 -0.498623 
+1.817000 -0.497508 
+1.818000 -0.496372 
+1.819000 -0.495214 
+1.820000 -0.494036 
+1.821000 -0.492836 
+1.822000 -0.491615 
+1.823000 -0.490373 
+1.824000 -0.489110 
+1.825000 -0.487826 
+1.826000 -0.486521 
+1.827000 -0.485195 
+1.828000 -0.483849 
+1.829000 -0.482481 
+1.830000 -0.481092 
+1.831000 -0.479683 
+1.832000 -0.478253 
+1.833000 -0.476802 
+1.834000 -0.475331 
+1.835000 -0.473838 
+1.836000 -0.472326 
+1.837000 -0.470792 
+1.838000 -0.469238 
+1.839000 -0.467664 
+1.840000 -0.466069 
+1.841000 -0.464454 
+1.842000 -0.462818 
+1.843000 -0.461162 
+1.844000 -0.459486 
+1.845000 -0.457789 
+1.846000 -0.456072 
+1.847000 -0.454335 
+1.848000 -0.452578 
+1.849000 -0.450801 
+1.850000 -0.449004 
+1.851000 -0.447187 
+1.852000 -0.445351 
+1.853000 -0.443494 
+1.854000 -0.441617 
+1.855000 -0.439721 
+1.856000 -0.437805 
+1.857000 -0.435870 
+1.858000 -0.433915 
+1.859000 -0.431940 
+1.860000 -0.429946 
+1.861000 -0.427933 
+1.862000 -0.425900 
+1.863000 -0.423848 
+1.864000 -0.421777 
+1.865000 -0.419687 
+1.866000 -0.417578 
+1.867000 -0.415450 
+1.868000 -0.413302 
+1.869000 -0.411136 
+1.870000 -0.408951 
+1.871000 -0.406748 
+1.872000 -0.404526 
+1.873000 -0.402285 
+1.874000 -0.400025 
+1.875000 -0.397748 
+1.876000 -0.395451 
+1.877000 -0.393137 
+1.878000 -0.390804 
+1.879000 -0.388453 
+1.880000 -0.386085 
+1.881000 -0.383698 
+1.882000 -0.381293 
+1.883000 -0.378870 
+1.884000 -0.376430 
+1.885000 -0.373972 
+1.886000 -0.371496 
+1.887000 -0.369003 
+1.888000 -0.366492 
+1.889000 -0.363965 
+1.890000 -0.361419 
+1.891000 -0.358857 
+1.892000 -0.356278 
+1.893000 -0.353681 
+1.894000 -0.351068 
+1.895000 -0.348438 
+1.896000 -0.345791 
+1.897000 -0.343127 
+1.898000 -0.340447 
+1.899000 -0.337751 
+1.900000 -0.335038 
+1.901000 -0.332308 
+1.902000 -0.329563 
+1.903000 -0.326802 
+1.904000 -0.324024 
+1.905000 -0.321231 
+1.906000 -0.318421 
+1.907000 -0.315597 
+1.908000 -0.312756 
+1.909000 -0.309900 
+1.910000 -0.307029 
+1.911000 -0.304142 
+1.912000 -0.301240 
+1.913000 -0.298323 
+1.914000 -0.295391 
+1.915000 -0.292444 
+1.916000 -0.289483 
+1.917000 -0.286506 
+1.918000 -0.283515 
+1.919000 -0.280510 
+1.920000 -0.277490 
+1.921000 -0.274456 
+1.922000 -0.271408 
+1.923000 -0.268346 
+1.924000 -0.265269 
+1.925000 -0.262180 
+1.926000 -0.259076 
+1.927000 -0.255959 
+1.928000 -0.252828 
+1.929000 -0.249684 
+1.930000 -0.246526 
+1.931000 -0.243356 
+1.932000 -0.240172 
+1.933000 -0.236976 
+1.934000 -0.233766 
+1.935000 -0.230544 
+1.936000 -0.227310 
+1.937000 -0.224063 
+1.938000 -0.220804 
+1.939000 -0.217532 
+1.940000 -0.214248 
+1.941000 -0.210953 
+1.942000 -0.207646 
+1.943000 -0.204326 
+1.944000 -0.200996 
+1.945000 -0.197654 
+1.946000 -0.194300 
+1.947000 -0.190935 
+1.948000 -0.187559 
+1.949000 -0.184173 
+1.950000 -0.180775 
+1.951000 -0.177367 
+1.952000 -0.173948 
+1.953000 -0.170518 
+1.954000 -0.167078 
+1.955000 -0.163628 
+1.956000 -0.160168 
+1.957000 -0.156698 
+1.958000 -0.153218 
+1.959000 -0.149729 
+1.960000 -0.146230 
+1.961000 -0.142721 
+1.962000 -0.139203 
+1.963000 -0.135676 
+1.964000 -0.132140 
+1.965000 -0.128595 
+1.966000 -0.125042 
+1.967000 -0.121480 
+1.968000 -0.117909 
+1.969000 -0.114330 
+1.970000 -0.110742 
+1.971000 -0.107147 
+1.972000 -0.103544 
+1.973000 -0.099933 
+1.974000 -0.096314 
+1.975000 -0.092687 
+1.976000 -0.089054 
+1.977000 -0.085413 
+1.978000 -0.081765 
+1.979000 -0.078110 
+1.980000 -0.074448 
+1.981000 -0.070779 
+1.982000 -0.067104 
+1.983000 -0.063423 
+1.984000 -0.059735 
+1.985000 -0.056042 
+1.986000 -0.052342 
+1.987000 -0.048636 
+1.988000 -0.044925 
+1.989000 -0.041208 
+1.990000 -0.037486 
+1.991000 -0.033759 
+1.992000 -0.030026 
+1.993000 -0.026289 
+1.994000 -0.022546 
+1.995000 -0.018799 
+1.996000 -0.015048 
+1.997000 -0.011292 
+1.998000 -0.007532 
+1.999000 -0.003768 
+2.000000 -0.000000 
+2.001000 0.003772 
+2.002000 0.007547 
+2.003000 0.011326 
+2.004000 0.015108 
+2.005000 0.018894 
+2.006000 0.022682 
+2.007000 0.026473 
+2.008000 0.030267 
+2.009000 0.034064 
+2.010000 0.037863 
+2.011000 0.041664 
+2.012000 0.045467 
+2.013000 0.049273 
+2.014000 0.053080 
+2.015000 0.056888 
+2.016000 0.060699 
+2.017000 0.064510 
+2.018000 0.068323 
+2.019000 0.072137 
+2.020000 0.075952 
+2.021000 0.079767 
+2.022000 0.083584 
+2.023000 0.087400 
+2.024000 0.091217 
+2.025000 0.095034 
+2.026000 0.098851 
+2.027000 0.102668 
+2.028000 0.106484 
+2.029000 0.110300 
+2.030000 0.114115 
+2.031000 0.117930 
+2.032000 0.121743 
+2.033000 0.125556 
+2.034000 0.129367 
+2.035000 0.133176 
+2.036000 0.136985 
+2.037000 0.140791 
+2.038000 0.144595 
+2.039000 0.148398 
+2.040000 0.152198 
+2.041000 0.155996 
+2.042000 0.159792 
+2.043000 0.163584 
+2.044000 0.167374 
+2.045000 0.171161 
+2.046000 0.174945 
+2.047000 0.178725 
+2.048000 0.182502 
+2.049000 0.186276 
+2.050000 0.190045 
+2.051000 0.193811 
+2.052000 0.197573 
+2.053000 0.201330 
+2.054000 0.205083 
+2.055000 0.208832 
+2.056000 0.212576 
+2.057000 0.216315 
+2.058000 0.220049 
+2.059000 0.223777 
+2.060000 0.227501 
+2.061000 0.231219 
+2.062000 0.234931 
+2.063000 0.238638 
+2.064000 0.242339 
+2.065000 0.246033 
+2.066000 0.249721 
+2.067000 0.253403 
+2.068000 0.257079 
+2.069000 0.260747 
+2.070000 0.264409 
+2.071000 0.268064 
+2.072000 0.271711 
+2.073000 0.275351 
+2.074000 0.278984 
+2.075000 0.282609 
+2.076000 0.286226 
+2.077000 0.289836 
+2.078000 0.293437 
+2.079000 0.297030 
+2.080000 0.300614 
+2.081000 0.304190 
+2.082000 0.307757 
+2.083000 0.311316 
+2.084000 0.314865 
+2.085000 0.318405 
+2.086000 0.321936 
+2.087000 0.325458 
+2.088000 0.328969 
+2.089000 0.332471 
+2.090000 0.335963 
+2.091000 0.339445 
+2.092000 0.342917 
+2.093000 0.346378 
+2.094000 0.349829 
+2.095000 0.353269 
+2.096000 0.356699 
+2.097000 0.360117 
+2.098000 0.363524 
+2.099000 0.366920 
+2.100000 0.370305 
+2.101000 0.373678 
+2.102000 0.377039 
+2.103000 0.380388 
+2.104000 0.383726 
+2.105000 0.387051 
+2.106000 0.390364 
+2.107000 0.393664 
+2.108000 0.396952 
+2.109000 0.400227 
+2.110000 0.403489 
+2.111000 0.406739 
+2.112000 0.409975 
+2.113000 0.413197 
+2.114000 0.416407 
+2.115000 0.419602 
+2.116000 0.422784 
+2.117000 0.425952 
+2.118000 0.429106 
+2.119000 0.432246 
+2.120000 0.435372 
+2.121000 0.438483 
+2.122000 0.441580 
+2.123000 0.444662 
+2.124000 0.447729 
+2.125000 0.450781 
+2.126000 0.453817 
+2.127000 0.456839 
+2.128000 0.459845 
+2.129000 0.462836 
+2.130000 0.465811 
+2.131000 0.468770 
+2.132000 0.471713 
+2.133000 0.474641 
+2.134000 0.477552 
+2.135000 0.480446 
+2.136000 0.483324 
+2.137000 0.486186 
+2.138000 0.489031 
+2.139000 0.491859 
+2.140000 0.494670 
+2.141000 0.497463 
+2.142000 0.500240 
+2.143000 0.502999 
+2.144000 0.505741 
+2.145000 0.508465 
+2.146000 0.511171 
+2.147000 0.513859 
+2.148000 0.516530 
+2.149000 0.519182 
+2.150000 0.521816 
+2.151000 0.524432 
+2.152000 0.527029 
+2.153000 0.529607 
+2.154000 0.532167 
+2.155000 0.534708 
+2.156000 0.537229 
+2.157000 0.539732 
+2.158000 0.542216 
+2.159000 0.544680 
+2.160000 0.547124 
+2.161000 0.549550 
+2.162000 0.551955 
+2.163000 0.554341 
+2.164000 0.556706 
+2.165000 0.559052 
+2.166000 0.561377 
+2.167000 0.563683 
+2.168000 0.565968 
+2.169000 0.568232 
+2.170000 0.570476 
+2.171000 0.572699 
+2.172000 0.574901 
+2.173000 0.577082 
+2.174000 0.579243 
+2.175000 0.581382 
+2.176000 0.583500 
+2.177000 0.585596 
+2.178000 0.587671 
+2.179000 0.589725 
+2.180000 0.591757 
+2.181000 0.593767 
+2.182000 0.595755 
+2.183000 0.597722 
+2.184000 0.599666 
+2.185000 0.601588 
+2.186000 0.603488 
+2.187000 0.605366 
+2.188000 0.607221 
+2.189000 0.609053 
+2.190000 0.610863 
+2.191000 0.612650 
+2.192000 0.614415 
+2.193000 0.616156 
+2.194000 0.617875 
+2.195000 0.619570 
+2.196000 0.621242 
+2.197000 0.622891 
+2.198000 0.624517 
+2.199000 0.626119 
+2.200000 0.627697 
+2.201000 0.629252 
+2.202000 0.630784 
+2.203000 0.632291 
+2.204000 0.633775 
+2.205000 0.635234 
+2.206000 0.636670 
+2.207000 0.638081 
+2.208000 0.639469 
+2.209000 0.640832 
+2.210000 0.642171 
+2.211000 0.643485 
+2.212000 0.644775 
+2.213000 0.646040 
+2.214000 0.647281 
+2.215000 0.648497 
+2.216000 0.649688 
+2.217000 0.650854 
+2.218000 0.651996 
+2.219000 0.653112 
+2.220000 0.654203 
+2.221000 0.655270 
+2.222000 0.656311 
+2.223000 0.657326 
+2.224000 0.658317 
+2.225000 0.659282 
+2.226000 0.660222 
+2.227000 0.661136 
+2.228000 0.662024 
+2.229000 0.662887 
+2.230000 0.663725 
+2.231000 0.664536 
+2.232000 0.665322 
+2.233000 0.666082 
+2.234000 0.666816 
+2.235000 0.667524 
+2.236000 0.668206 
+2.237000 0.668863 
+2.238000 0.669492 
+2.239000 0.670096 
+2.240000 0.670674 
+2.241000 0.671225 
+2.242000 0.671750 
+2.243000 0.672249 
+2.244000 0.672722 
+2.245000 0.673168 
+2.246000 0.673587 
+2.247000 0.673980 
+2.248000 0.674347 
+2.249000 0.674687 
+2.250000 0.675000 
+2.251000 0.675287 
+2.252000 0.675547 
+2.253000 0.675780 
+2.254000 0.675986 
+2.255000 0.676166 
+2.256000 0.676319 
+2.257000 0.676445 
+2.258000 0.676544 
+2.259000 0.676617 
+2.260000 0.676662 
+2.261000 0.676681 
+2.262000 0.676672 
+2.263000 0.676636 
+2.264000 0.676574 
+2.265000 0.676484 
+2.266000 0.676368 
+2.267000 0.676224 
+2.268000 0.676053 
+2.269000 0.675855 
+2.270000 0.675630 
+2.271000 0.675378 
+2.272000 0.675099 
+2.273000 0.674792 
+2.274000 0.674458 
+2.275000 0.674097 
+2.276000 0.673709 
+2.277000 0.673294 
+2.278000 0.672851 
+2.279000 0.672381 
+2.280000 0.671884 
+2.281000 0.671360 
+2.282000 0.670809 
+2.283000 0.670230 
+2.284000 0.669624 
+2.285000 0.668991 
+2.286000 0.668331 
+2.287000 0.667643 
+2.288000 0.666928 
+2.289000 0.666186 
+2.290000 0.665417 
+2.291000 0.664620 
+2.292000 0.663796 
+2.293000 0.662946 
+2.294000 0.662067 
+2.295000 0.661162 
+2.296000 0.660230 
+2.297000 0.659270 
+2.298000 0.658284 
+2.299000 0.657270 
+2.300000 0.656229 
+2.301000 0.655161 
+2.302000 0.654066 
+2.303000 0.652944 
+2.304000 0.651795 
+2.305000 0.650619 
+2.306000 0.649416 
+2.307000 0.648186 
+2.308000 0.646929 
+2.309000 0.645646 
+2.310000 0.644335 
+2.311000 0.642998 
+2.312000 0.641634 
+2.313000 0.640243 
+2.314000 0.638825 
+2.315000 0.637381 
+2.316000 0.635910 
+2.317000 0.634412 
+2.318000 0.632888 
+2.319000 0.631337 
+2.320000 0.629760 
+2.321000 0.628156 
+2.322000 0.626526 
+2.323000 0.624869 
+2.324000 0.623186 
+2.325000 0.621477 
+2.326000 0.619742 
+2.327000 0.617980 
+2.328000 0.616192 
+2.329000 0.614378 
+2.330000 0.612538 
+2.331000 0.610672 
+2.332000 0.608781 
+2.333000 0.606863 
+2.334000 0.604919 
+2.335000 0.602950 
+2.336000 0.600955 
+2.337000 0.598934 
+2.338000 0.596888 
+2.339000 0.594816 
+2.340000 0.592718 
+2.341000 0.590595 
+2.342000 0.588447 
+2.343000 0.586274 
+2.344000 0.584075 
+2.345000 0.581851 
+2.346000 0.579602 
+2.347000 0.577328 
+2.348000 0.575029 
+2.349000 0.572706 
+2.350000 0.570357 
+2.351000 0.567984 
+2.352000 0.565586 
+2.353000 0.563163 
+2.354000 0.560716 
+2.355000 0.558245 
+2.356000 0.555749 
+2.357000 0.553229 
+2.358000 0.550684 
+2.359000 0.548116 
+2.360000 0.545523 
+2.361000 0.542907 
+2.362000 0.540267 
+2.363000 0.537603 
+2.364000 0.534915 
+2.365000 0.532204 
+2.366000 0.529469 
+2.367000 0.526711 
+2.368000 0.523929 
+2.369000 0.521125 
+2.370000 0.518297 
+2.371000 0.515446 
+2.372000 0.512572 
+2.373000 0.509675 
+2.374000 0.506756 
+2.375000 0.503814 
+2.376000 0.500849 
+2.377000 0.497862 
+2.378000 0.494852 
+2.379000 0.491821 
+2.380000 0.488767 
+2.381000 0.485691 
+2.382000 0.482593 
+2.383000 0.479473 
+2.384000 0.476332 
+2.385000 0.473169 
+2.386000 0.469984 
+2.387000 0.466778 
+2.388000 0.463551 
+2.389000 0.460302 
+2.390000 0.457033 
+2.391000 0.453743 
+2.392000 0.450431 
+2.393000 0.447099 
+2.394000 0.443747 
+2.395000 0.440374 
+2.396000 0.436980 
+2.397000 0.433567 
+2.398000 0.430133 
+2.399000 0.426679 
+2.400000 0.423205 
+2.401000 0.419712 
+2.402000 0.416199 
+2.403000 0.412666 
+2.404000 0.409114 
+2.405000 0.405543 
+2.406000 0.401953 
+2.407000 0.398343 
+2.408000 0.394715 
+2.409000 0.391068 
+2.410000 0.387403 
+2.411000 0.383719 
+2.412000 0.380016 
+2.413000 0.376296 
+2.414000 0.372557 
+2.415000 0.368801 
+2.416000 0.365026 
+2.417000 0.361234 
+2.418000 0.357424 
+2.419000 0.353597 
+2.420000 0.349753 
+2.421000 0.345892 
+2.422000 0.342013 
+2.423000 0.338118 
+2.424000 0.334206 
+2.425000 0.330278 
+2.426000 0.326333 
+2.427000 0.322372 
+2.428000 0.318395 
+2.429000 0.314402 
+2.430000 0.310393 
+2.431000 0.306369 
+2.432000 0.302328 
+2.433000 0.298273 
+2.434000 0.294202 
+2.435000 0.290117 
+2.436000 0.286016 
+2.437000 0.281900 
+2.438000 0.277770 
+2.439000 0.273626 
+2.440000 0.269467 
+2.441000 0.265294 
+2.442000 0.261107 
+2.443000 0.256907 
+2.444000 0.252692 
+2.445000 0.248464 
+2.446000 0.244223 
+2.447000 0.239969 
+2.448000 0.235701 
+2.449000 0.231421 
+2.450000 0.227127 
+2.451000 0.222822 
+2.452000 0.218504 
+2.453000 0.214174 
+2.454000 0.209831 
+2.455000 0.205477 
+2.456000 0.201111 
+2.457000 0.196733 
+2.458000 0.192345 
+2.459000 0.187944 
+2.460000 0.183533 
+2.461000 0.179111 
+2.462000 0.174678 
+2.463000 0.170235 
+2.464000 0.165781 
+2.465000 0.161317 
+2.466000 0.156843 
+2.467000 0.152359 
+2.468000 0.147865 
+2.469000 0.143362 
+2.470000 0.138850 
+2.471000 0.134328 
+2.472000 0.129797 
+2.473000 0.125258 
+2.474000 0.120709 
+2.475000 0.116153 
+2.476000 0.111588 
+2.477000 0.107014 
+2.478000 0.102433 
+2.479000 0.097844 
+2.480000 0.093248 
+2.481000 0.088644 
+2.482000 0.084033 
+2.483000 0.079415 
+2.484000 0.074790 
+2.485000 0.070158 
+2.486000 0.065519 
+2.487000 0.060875 
+2.488000 0.056224 
+2.489000 0.051567 
+2.490000 0.046905 
+2.491000 0.042236 
+2.492000 0.037563 
+2.493000 0.032884 
+2.494000 0.028200 
+2.495000 0.023511 
+2.496000 0.018817 
+2.497000 0.014119 
+2.498000 0.009417 
+2.499000 0.004710 
+2.500000 0.000000 
+2.501000 -0.004714 
+2.502000 -0.009432 
+2.503000 -0.014153 
+2.504000 -0.018878 
+2.505000 -0.023605 
+2.506000 -0.028335 
+2.507000 -0.033068 
+2.508000 -0.037804 
+2.509000 -0.042542 
+2.510000 -0.047281 
+2.511000 -0.052023 
+2.512000 -0.056766 
+2.513000 -0.061511 
+2.514000 -0.066257 
+2.515000 -0.071005 
+2.516000 -0.075753 
+2.517000 -0.080502 
+2.518000 -0.085252 
+2.519000 -0.090002 
+2.520000 -0.094752 
+2.521000 -0.099502 
+2.522000 -0.104252 
+2.523000 -0.109002 
+2.524000 -0.113751 
+2.525000 -0.118499 
+2.526000 -0.123246 
+2.527000 -0.127993 
+2.528000 -0.132737 
+2.529000 -0.137481 
+2.530000 -0.142222 
+2.531000 -0.146962 
+2.532000 -0.151700 
+2.533000 -0.156435 
+2.534000 -0.161168 
+2.535000 -0.165898 
+2.536000 -0.170625 
+2.537000 -0.175349 
+2.538000 -0.180070 
+2.539000 -0.184788 
+2.540000 -0.189502 
+2.541000 -0.194212 
+2.542000 -0.198918 
+2.543000 -0.203620 
+2.544000 -0.208317 
+2.545000 -0.213010 
+2.546000 -0.217698 
+2.547000 -0.222381 
+2.548000 -0.227059 
+2.549000 -0.231731 
+2.550000 -0.236398 
+2.551000 -0.241059 
+2.552000 -0.245714 
+2.553000 -0.250364 
+2.554000 -0.255006 
+2.555000 -0.259643 
+2.556000 -0.264272 
+2.557000 -0.268895 
+2.558000 -0.273510 
+2.559000 -0.278119 
+2.560000 -0.282720 
+2.561000 -0.287313 
+2.562000 -0.291898 
+2.563000 -0.296476 
+2.564000 -0.301045 
+2.565000 -0.305605 
+2.566000 -0.310157 
+2.567000 -0.314701 
+2.568000 -0.319235 
+2.569000 -0.323760 
+2.570000 -0.328276 
+2.571000 -0.332782 
+2.572000 -0.337279 
+2.573000 -0.341765 
+2.574000 -0.346242 
+2.575000 -0.350708 
+2.576000 -0.355163 
+2.577000 -0.359608 
+2.578000 -0.364042 
+2.579000 -0.368465 
+2.580000 -0.372877 
+2.581000 -0.377278 
+2.582000 -0.381667 
+2.583000 -0.386044 
+2.584000 -0.390409 
+2.585000 -0.394762 
+2.586000 -0.399102 
+2.587000 -0.403430 
+2.588000 -0.407746 
+2.589000 -0.412048 
+2.590000 -0.416337 
+2.591000 -0.420614 
+2.592000 -0.424876 
+2.593000 -0.429125 
+2.594000 -0.433361 
+2.595000 -0.437582 
+2.596000 -0.441789 
+2.597000 -0.445982 
+2.598000 -0.450160 
+2.599000 -0.454324 
+2.600000 -0.458472 
+2.601000 -0.462606 
+2.602000 -0.466725 
+2.603000 -0.470828 
+2.604000 -0.474915 
+2.605000 -0.478987 
+2.606000 -0.483043 
+2.607000 -0.487082 
+2.608000 -0.491106 
+2.609000 -0.495113 
+2.610000 -0.499103 
+2.611000 -0.503077 
+2.612000 -0.507033 
+2.613000 -0.510972 
+2.614000 -0.514895 
+2.615000 -0.518799 
+2.616000 -0.522686 
+2.617000 -0.526555 
+2.618000 -0.530406 
+2.619000 -0.534239 
+2.620000 -0.538054 
+2.621000 -0.541850 
+2.622000 -0.545628 
+2.623000 -0.549386 
+2.624000 -0.553126 
+2.625000 -0.556847 
+2.626000 -0.560548 
+2.627000 -0.564230 
+2.628000 -0.567892 
+2.629000 -0.571534 
+2.630000 -0.575156 
+2.631000 -0.578758 
+2.632000 -0.582340 
+2.633000 -0.585902 
+2.634000 -0.589443 
+2.635000 -0.592963 
+2.636000 -0.596462 
+2.637000 -0.599940 
+2.638000 -0.603397 
+2.639000 -0.606833 
+2.640000 -0.610246 
+2.641000 -0.613639 
+2.642000 -0.617009 
+2.643000 -0.620358 
+2.644000 -0.623684 
+2.645000 -0.626988 
+2.646000 -0.630270 
+2.647000 -0.633529 
+2.648000 -0.636765 
+2.649000 -0.639978 
+2.650000 -0.643169 
+2.651000 -0.646336 
+2.652000 -0.649480 
+2.653000 -0.652600 
+2.654000 -0.655697 
+2.655000 -0.658770 
+2.656000 -0.661819 
+2.657000 -0.664844 
+2.658000 -0.667845 
+2.659000 -0.670821 
+2.660000 -0.673774 
+2.661000 -0.676701 
+2.662000 -0.679604 
+2.663000 -0.682482 
+2.664000 -0.685335 
+2.665000 -0.688163 
+2.666000 -0.690966 
+2.667000 -0.693743 
+2.668000 -0.696495 
+2.669000 -0.699221 
+2.670000 -0.701922 
+2.671000 -0.704596 
+2.672000 -0.707245 
+2.673000 -0.709867 
+2.674000 -0.712463 
+2.675000 -0.715033 
+2.676000 -0.717576 
+2.677000 -0.720092 
+2.678000 -0.722582 
+2.679000 -0.725045 
+2.680000 -0.727481 
+2.681000 -0.729890 
+2.682000 -0.732271 
+2.683000 -0.734625 
+2.684000 -0.736952 
+2.685000 -0.739251 
+2.686000 -0.741523 
+2.687000 -0.743766 
+2.688000 -0.745982 
+2.689000 -0.748170 
+2.690000 -0.750330 
+2.691000 -0.752461 
+2.692000 -0.754564 
+2.693000 -0.756639 
+2.694000 -0.758685 
+2.695000 -0.760702 
+2.696000 -0.762691 
+2.697000 -0.764651 
+2.698000 -0.766581 
+2.699000 -0.768483 
+2.700000 -0.770356 
+2.701000 -0.772199 
+2.702000 -0.774013 
+2.703000 -0.775798 
+2.704000 -0.777553 
+2.705000 -0.779278 
+2.706000 -0.780974 
+2.707000 -0.782640 
+2.708000 -0.784276 
+2.709000 -0.785882 
+2.710000 -0.787458 
+2.711000 -0.789004 
+2.712000 -0.790520 
+2.713000 -0.792005 
+2.714000 -0.793460 
+2.715000 -0.794884 
+2.716000 -0.796278 
+2.717000 -0.797641 
+2.718000 -0.798974 
+2.719000 -0.800276 
+2.720000 -0.801546 
+2.721000 -0.802786 
+2.722000 -0.803995 
+2.723000 -0.805173 
+2.724000 -0.806320 
+2.725000 -0.807435 
+2.726000 -0.808519 
+2.727000 -0.809572 
+2.728000 -0.810594 
+2.729000 -0.811584 
+2.730000 -0.812542 
+2.731000 -0.813469 
+2.732000 -0.814364 
+2.733000 -0.815227 
+2.734000 -0.816059 
+2.735000 -0.816859 
+2.736000 -0.817626 
+2.737000 -0.818362 
+2.738000 -0.819066 
+2.739000 -0.819738 
+2.740000 -0.820378 
+2.741000 -0.820986 
+2.742000 -0.821561 
+2.743000 -0.822104 
+2.744000 -0.822615 
+2.745000 -0.823094 
+2.746000 -0.823540 
+2.747000 -0.823954 
+2.748000 -0.824335 
+2.749000 -0.824684 
+2.750000 -0.825000 
+2.751000 -0.825284 
+2.752000 -0.825535 
+2.753000 -0.825753 
+2.754000 -0.825939 
+2.755000 -0.826092 
+2.756000 -0.826213 
+2.757000 -0.826300 
+2.758000 -0.826355 
+2.759000 -0.826377 
+2.760000 -0.826366 
+2.761000 -0.826322 
+2.762000 -0.826246 
+2.763000 -0.826136 
+2.764000 -0.825994 
+2.765000 -0.825819 
+2.766000 -0.825610 
+2.767000 -0.825369 
+2.768000 -0.825095 
+2.769000 -0.824788 
+2.770000 -0.824447 
+2.771000 -0.824074 
+2.772000 -0.823668 
+2.773000 -0.823228 
+2.774000 -0.822756 
+2.775000 -0.822251 
+2.776000 -0.821712 
+2.777000 -0.821141 
+2.778000 -0.820536 
+2.779000 -0.819898 
+2.780000 -0.819228 
+2.781000 -0.818524 
+2.782000 -0.817787 
+2.783000 -0.817017 
+2.784000 -0.816214 
+2.785000 -0.815378 
+2.786000 -0.814510 
+2.787000 -0.813608 
+2.788000 -0.812673 
+2.789000 -0.811705 
+2.790000 -0.810704 
+2.791000 -0.809670 
+2.792000 -0.808604 
+2.793000 -0.807504 
+2.794000 -0.806372 
+2.795000 -0.805206 
+2.796000 -0.804008 
+2.797000 -0.802777 
+2.798000 -0.801513 
+2.799000 -0.800217 
+2.800000 -0.798887 
+2.801000 -0.797525 
+2.802000 -0.796131 
+2.803000 -0.794704 
+2.804000 -0.793244 
+2.805000 -0.791751 
+2.806000 -0.790226 
+2.807000 -0.788669 
+2.808000 -0.787079 
+2.809000 -0.785456 
+2.810000 -0.783802 
+2.811000 -0.782114 
+2.812000 -0.780395 
+2.813000 -0.778644 
+2.814000 -0.776860 
+2.815000 -0.775044 
+2.816000 -0.773196 
+2.817000 -0.771316 
+2.818000 -0.769404 
+2.819000 -0.767460 
+2.820000 -0.765484 
+2.821000 -0.763476 
+2.822000 -0.761436 
+2.823000 -0.759365 
+2.824000 -0.757262 
+2.825000 -0.755128 
+2.826000 -0.752962 
+2.827000 -0.750765 
+2.828000 -0.748536 
+2.829000 -0.746276 
+2.830000 -0.743984 
+2.831000 -0.741662 
+2.832000 -0.739308 
+2.833000 -0.736923 
+2.834000 -0.734508 
+2.835000 -0.732061 
+2.836000 -0.729584 
+2.837000 -0.727076 
+2.838000 -0.724537 
+2.839000 -0.721967 
+2.840000 -0.719367 
+2.841000 -0.716737 
+2.842000 -0.714076 
+2.843000 -0.711385 
+2.844000 -0.708664 
+2.845000 -0.705913 
+2.846000 -0.703132 
+2.847000 -0.700321 
+2.848000 -0.697480 
+2.849000 -0.694610 
+2.850000 -0.691710 
+2.851000 -0.688780 
+2.852000 -0.685821 
+2.853000 -0.682832 
+2.854000 -0.679815 
+2.855000 -0.676768 
+2.856000 -0.673692 
+2.857000 -0.670587 
+2.858000 -0.667454 
+2.859000 -0.664291 
+2.860000 -0.661100 
+2.861000 -0.657881 
+2.862000 -0.654633 
+2.863000 -0.651357 
+2.864000 -0.648053 
+2.865000 -0.644720 
+2.866000 -0.641360 
+2.867000 -0.637972 
+2.868000 -0.634556 
+2.869000 -0.631113 
+2.870000 -0.627642 
+2.871000 -0.624144 
+2.872000 -0.620618 
+2.873000 -0.617066 
+2.874000 -0.613486 
+2.875000 -0.609880 
+2.876000 -0.606246 
+2.877000 -0.602587 
+2.878000 -0.598900 
+2.879000 -0.595188 
+2.880000 -0.591449 
+2.881000 -0.587684 
+2.882000 -0.583893 
+2.883000 -0.580076 
+2.884000 -0.576233 
+2.885000 -0.572365 
+2.886000 -0.568472 
+2.887000 -0.564553 
+2.888000 -0.560609 
+2.889000 -0.556640 
+2.890000 -0.552647 
+2.891000 -0.548628 
+2.892000 -0.544585 
+2.893000 -0.540518 
+2.894000 -0.536426 
+2.895000 -0.532310 
+2.896000 -0.528170 
+2.897000 -0.524006 
+2.898000 -0.519819 
+2.899000 -0.515608 
+2.900000 -0.511373 
+2.901000 -0.507116 
+2.902000 -0.502835 
+2.903000 -0.498531 
+2.904000 -0.494205 
+2.905000 -0.489856 
+2.906000 -0.485484 
+2.907000 -0.481090 
+2.908000 -0.476674 
+2.909000 -0.472237 
+2.910000 -0.467777 
+2.911000 -0.463295 
+2.912000 -0.458793 
+2.913000 -0.454268 
+2.914000 -0.449723 
+2.915000 -0.445157 
+2.916000 -0.440570 
+2.917000 -0.435962 
+2.918000 -0.431334 
+2.919000 -0.426685 
+2.920000 -0.422016 
+2.921000 -0.417328 
+2.922000 -0.412619 
+2.923000 -0.407891 
+2.924000 -0.403143 
+2.925000 -0.398377 
+2.926000 -0.393591 
+2.927000 -0.388786 
+2.928000 -0.383962 
+2.929000 -0.379120 
+2.930000 -0.374260 
+2.931000 -0.369381 
+2.932000 -0.364485 
+2.933000 -0.359570 
+2.934000 -0.354638 
+2.935000 -0.349689 
+2.936000 -0.344722 
+2.937000 -0.339738 
+2.938000 -0.334737 
+2.939000 -0.329720 
+2.940000 -0.324686 
+2.941000 -0.319636 
+2.942000 -0.314569 
+2.943000 -0.309487 
+2.944000 -0.304389 
+2.945000 -0.299275 
+2.946000 -0.294146 
+2.947000 -0.289002 
+2.948000 -0.283843 
+2.949000 -0.278669 
+2.950000 -0.273480 
+2.951000 -0.268277 
+2.952000 -0.263060 
+2.953000 -0.257829 
+2.954000 -0.252584 
+2.955000 -0.247326 
+2.956000 -0.242054 
+2.957000 -0.236769 
+2.958000 -0.231471 
+2.959000 -0.226160 
+2.960000 -0.220837 
+2.961000 -0.215501 
+2.962000 -0.210153 
+2.963000 -0.204793 
+2.964000 -0.199422 
+2.965000 -0.194038 
+2.966000 -0.188644 
+2.967000 -0.183238 
+2.968000 -0.177822 
+2.969000 -0.172395 
+2.970000 -0.166957 
+2.971000 -0.161509 
+2.972000 -0.156051 
+2.973000 -0.150583 
+2.974000 -0.145105 
+2.975000 -0.139618 
+2.976000 -0.134121 
+2.977000 -0.128616 
+2.978000 -0.123102 
+2.979000 -0.117579 
+2.980000 -0.112048 
+2.981000 -0.106509 
+2.982000 -0.100961 
+2.983000 -0.095406 
+2.984000 -0.089844 
+2.985000 -0.084274 
+2.986000 -0.078697 
+2.987000 -0.073113 
+2.988000 -0.067523 
+2.989000 -0.061926 
+2.990000 -0.056323 
+2.991000 -0.050714 
+2.992000 -0.045099 
+2.993000 -0.039479 
+2.994000 -0.033853 
+2.995000 -0.028223 
+2.996000 -0.022587 
+2.997000 -0.016947 
+2.998000 -0.011302 
+2.999000 -0.005653 
+3.000000 -0.000000 
@@ -25,3 +25,654 @@ f(xs)
 3.800000 -5.000000 1.993630 
 4.200000 -5.000000 1.995199 
 4.600000 -5.000000 1.996174 
+5.000000 -5.000000 1.996805 
+-5.000000 -4.600000 1.996174 
+-4.600000 -4.600000 1.995543 
+-4.200000 -4.600000 1.994568 
+-3.800000 -4.600000 1.992999 
+-3.400000 -4.600000 1.990344 
+-3.000000 -4.600000 1.985576 
+-2.600000 -4.600000 1.976357 
+-2.200000 -4.600000 1.956831 
+-1.800000 -4.600000 1.910797 
+-1.400000 -4.600000 1.791228 
+-1.000000 -4.600000 1.497772 
+-0.600000 -4.600000 1.112502 
+-0.200000 -4.600000 0.999369 
+0.200000 -4.600000 0.999369 
+0.600000 -4.600000 1.112502 
+1.000000 -4.600000 1.497772 
+1.400000 -4.600000 1.791228 
+1.800000 -4.600000 1.910797 
+2.200000 -4.600000 1.956831 
+2.600000 -4.600000 1.976357 
+3.000000 -4.600000 1.985576 
+3.400000 -4.600000 1.990344 
+3.800000 -4.600000 1.992999 
+4.200000 -4.600000 1.994568 
+4.600000 -4.600000 1.995543 
+5.000000 -4.600000 1.996174 
+-5.000000 -4.200000 1.995199 
+-4.600000 -4.200000 1.994568 
+-4.200000 -4.200000 1.993593 
+-3.800000 -4.200000 1.992024 
+-3.400000 -4.200000 1.989369 
+-3.000000 -4.200000 1.984601 
+-2.600000 -4.200000 1.975382 
+-2.200000 -4.200000 1.955856 
+-1.800000 -4.200000 1.909822 
+-1.400000 -4.200000 1.790253 
+-1.000000 -4.200000 1.496797 
+-0.600000 -4.200000 1.111527 
+-0.200000 -4.200000 0.998394 
+0.200000 -4.200000 0.998394 
+0.600000 -4.200000 1.111527 
+1.000000 -4.200000 1.496797 
+1.400000 -4.200000 1.790253 
+1.800000 -4.200000 1.909822 
+2.200000 -4.200000 1.955856 
+2.600000 -4.200000 1.975382 
+3.000000 -4.200000 1.984601 
+3.400000 -4.200000 1.989369 
+3.800000 -4.200000 1.992024 
+4.200000 -4.200000 1.993593 
+4.600000 -4.200000 1.994568 
+5.000000 -4.200000 1.995199 
+-5.000000 -3.800000 1.993630 
+-4.600000 -3.800000 1.992999 
+-4.200000 -3.800000 1.992024 
+-3.800000 -3.800000 1.990454 
+-3.400000 -3.800000 1.987799 
+-3.000000 -3.800000 1.983032 
+-2.600000 -3.800000 1.973813 
+-2.200000 -3.800000 1.954286 
+-1.800000 -3.800000 1.908252 
+-1.400000 -3.800000 1.788684 
+-1.000000 -3.800000 1.495227 
+-0.600000 -3.800000 1.109958 
+-0.200000 -3.800000 0.996824 
+0.200000 -3.800000 0.996824 
+0.600000 -3.800000 1.109958 
+1.000000 -3.800000 1.495227 
+1.400000 -3.800000 1.788684 
+1.800000 -3.800000 1.908252 
+2.200000 -3.800000 1.954286 
+2.600000 -3.800000 1.973813 
+3.000000 -3.800000 1.983032 
+3.400000 -3.800000 1.987799 
+3.800000 -3.800000 1.990454 
+4.200000 -3.800000 1.992024 
+4.600000 -3.800000 1.992999 
+5.000000 -3.800000 1.993630 
+-5.000000 -3.400000 1.990975 
+-4.600000 -3.400000 1.990344 
+-4.200000 -3.400000 1.989369 
+-3.800000 -3.400000 1.987799 
+-3.400000 -3.400000 1.985145 
+-3.000000 -3.400000 1.980377 
+-2.600000 -3.400000 1.971158 
+-2.200000 -3.400000 1.951632 
+-1.800000 -3.400000 1.905598 
+-1.400000 -3.400000 1.786029 
+-1.000000 -3.400000 1.492572 
+-0.600000 -3.400000 1.107303 
+-0.200000 -3.400000 0.994170 
+0.200000 -3.400000 0.994170 
+0.600000 -3.400000 1.107303 
+1.000000 -3.400000 1.492572 
+1.400000 -3.400000 1.786029 
+1.800000 -3.400000 1.905598 
+2.200000 -3.400000 1.951632 
+2.600000 -3.400000 1.971158 
+3.000000 -3.400000 1.980377 
+3.400000 -3.400000 1.985145 
+3.800000 -3.400000 1.987799 
+4.200000 -3.400000 1.989369 
+4.600000 -3.400000 1.990344 
+5.000000 -3.400000 1.990975 
+-5.000000 -3.000000 1.986207 
+-4.600000 -3.000000 1.985576 
+-4.200000 -3.000000 1.984601 
+-3.800000 -3.000000 1.983032 
+-3.400000 -3.000000 1.980377 
+-3.000000 -3.000000 1.975610 
+-2.600000 -3.000000 1.966391 
+-2.200000 -3.000000 1.946864 
+-1.800000 -3.000000 1.900830 
+-1.400000 -3.000000 1.781262 
+-1.000000 -3.000000 1.487805 
+-0.600000 -3.000000 1.102536 
+-0.200000 -3.000000 0.989402 
+0.200000 -3.000000 0.989402 
+0.600000 -3.000000 1.102536 
+1.000000 -3.000000 1.487805 
+1.400000 -3.000000 1.781262 
+1.800000 -3.000000 1.900830 
+2.200000 -3.000000 1.946864 
+2.600000 -3.000000 1.966391 
+3.000000 -3.000000 1.975610 
+3.400000 -3.000000 1.980377 
+3.800000 -3.000000 1.983032 
+4.200000 -3.000000 1.984601 
+4.600000 -3.000000 1.985576 
+5.000000 -3.000000 1.986207 
+-5.000000 -2.600000 1.976988 
+-4.600000 -2.600000 1.976357 
+-4.200000 -2.600000 1.975382 
+-3.800000 -2.600000 1.973813 
+-3.400000 -2.600000 1.971158 
+-3.000000 -2.600000 1.966391 
+-2.600000 -2.600000 1.957171 
+-2.200000 -2.600000 1.937645 
+-1.800000 -2.600000 1.891611 
+-1.400000 -2.600000 1.772042 
+-1.000000 -2.600000 1.478586 
+-0.600000 -2.600000 1.093317 
+-0.200000 -2.600000 0.980183 
+0.200000 -2.600000 0.980183 
+0.600000 -2.600000 1.093317 
+1.000000 -2.600000 1.478586 
+1.400000 -2.600000 1.772042 
+1.800000 -2.600000 1.891611 
+2.200000 -2.600000 1.937645 
+2.600000 -2.600000 1.957171 
+3.000000 -2.600000 1.966391 
+3.400000 -2.600000 1.971158 
+3.800000 -2.600000 1.973813 
+4.200000 -2.600000 1.975382 
+4.600000 -2.600000 1.976357 
+5.000000 -2.600000 1.976988 
+-5.000000 -2.200000 1.957462 
+-4.600000 -2.200000 1.956831 
+-4.200000 -2.200000 1.955856 
+-3.800000 -2.200000 1.954286 
+-3.400000 -2.200000 1.951632 
+-3.000000 -2.200000 1.946864 
+-2.600000 -2.200000 1.937645 
+-2.200000 -2.200000 1.918119 
+-1.800000 -2.200000 1.872085 
+-1.400000 -2.200000 1.752516 
+-1.000000 -2.200000 1.459059 
+-0.600000 -2.200000 1.073790 
+-0.200000 -2.200000 0.960657 
+0.200000 -2.200000 0.960657 
+0.600000 -2.200000 1.073790 
+1.000000 -2.200000 1.459059 
+1.400000 -2.200000 1.752516 
+1.800000 -2.200000 1.872085 
+2.200000 -2.200000 1.918119 
+2.600000 -2.200000 1.937645 
+3.000000 -2.200000 1.946864 
+3.400000 -2.200000 1.951632 
+3.800000 -2.200000 1.954286 
+4.200000 -2.200000 1.955856 
+4.600000 -2.200000 1.956831 
+5.000000 -2.200000 1.957462 
+-5.000000 -1.800000 1.911428 
+-4.600000 -1.800000 1.910797 
+-4.200000 -1.800000 1.909822 
+-3.800000 -1.800000 1.908252 
+-3.400000 -1.800000 1.905598 
+-3.000000 -1.800000 1.900830 
+-2.600000 -1.800000 1.891611 
+-2.200000 -1.800000 1.872085 
+-1.800000 -1.800000 1.826051 
+-1.400000 -1.800000 1.706482 
+-1.000000 -1.800000 1.413025 
+-0.600000 -1.800000 1.027756 
+-0.200000 -1.800000 0.914623 
+0.200000 -1.800000 0.914623 
+0.600000 -1.800000 1.027756 
+1.000000 -1.800000 1.413025 
+1.400000 -1.800000 1.706482 
+1.800000 -1.800000 1.826051 
+2.200000 -1.800000 1.872085 
+2.600000 -1.800000 1.891611 
+3.000000 -1.800000 1.900830 
+3.400000 -1.800000 1.905598 
+3.800000 -1.800000 1.908252 
+4.200000 -1.800000 1.909822 
+4.600000 -1.800000 1.910797 
+5.000000 -1.800000 1.911428 
+-5.000000 -1.400000 1.791859 
+-4.600000 -1.400000 1.791228 
+-4.200000 -1.400000 1.790253 
+-3.800000 -1.400000 1.788684 
+-3.400000 -1.400000 1.786029 
+-3.000000 -1.400000 1.781262 
+-2.600000 -1.400000 1.772042 
+-2.200000 -1.400000 1.752516 
+-1.800000 -1.400000 1.706482 
+-1.400000 -1.400000 1.586913 
+-1.000000 -1.400000 1.293457 
+-0.600000 -1.400000 0.908188 
+-0.200000 -1.400000 0.795054 
+0.200000 -1.400000 0.795054 
+0.600000 -1.400000 0.908188 
+1.000000 -1.400000 1.293457 
+1.400000 -1.400000 1.586913 
+1.800000 -1.400000 1.706482 
+2.200000 -1.400000 1.752516 
+2.600000 -1.400000 1.772042 
+3.000000 -1.400000 1.781262 
+3.400000 -1.400000 1.786029 
+3.800000 -1.400000 1.788684 
+4.200000 -1.400000 1.790253 
+4.600000 -1.400000 1.791228 
+5.000000 -1.400000 1.791859 
+-5.000000 -1.000000 1.498403 
+-4.600000 -1.000000 1.497772 
+-4.200000 -1.000000 1.496797 
+-3.800000 -1.000000 1.495227 
+-3.400000 -1.000000 1.492572 
+-3.000000 -1.000000 1.487805 
+-2.600000 -1.000000 1.478586 
+-2.200000 -1.000000 1.459059 
+-1.800000 -1.000000 1.413025 
+-1.400000 -1.000000 1.293457 
+-1.000000 -1.000000 1.000000 
+-0.600000 -1.000000 0.614731 
+-0.200000 -1.000000 0.501597 
+0.200000 -1.000000 0.501597 
+0.600000 -1.000000 0.614731 
+1.000000 -1.000000 1.000000 
+1.400000 -1.000000 1.293457 
+1.800000 -1.000000 1.413025 
+2.200000 -1.000000 1.459059 
+2.600000 -1.000000 1.478586 
+3.000000 -1.000000 1.487805 
+3.400000 -1.000000 1.492572 
+3.800000 -1.000000 1.495227 
+4.200000 -1.000000 1.496797 
+4.600000 -1.000000 1.497772 
+5.000000 -1.000000 1.498403 
+-5.000000 -0.600000 1.113133 
+-4.600000 -0.600000 1.112502 
+-4.200000 -0.600000 1.111527 
+-3.800000 -0.600000 1.109958 
+-3.400000 -0.600000 1.107303 
+-3.000000 -0.600000 1.102536 
+-2.600000 -0.600000 1.093317 
+-2.200000 -0.600000 1.073790 
+-1.800000 -0.600000 1.027756 
+-1.400000 -0.600000 0.908188 
+-1.000000 -0.600000 0.614731 
+-0.600000 -0.600000 0.229462 
+-0.200000 -0.600000 0.116328 
+0.200000 -0.600000 0.116328 
+0.600000 -0.600000 0.229462 
+1.000000 -0.600000 0.614731 
+1.400000 -0.600000 0.908188 
+1.800000 -0.600000 1.027756 
+2.200000 -0.600000 1.073790 
+2.600000 -0.600000 1.093317 
+3.000000 -0.600000 1.102536 
+3.400000 -0.600000 1.107303 
+3.800000 -0.600000 1.109958 
+4.200000 -0.600000 1.111527 
+4.600000 -0.600000 1.112502 
+5.000000 -0.600000 1.113133 
+-5.000000 -0.200000 1.000000 
+-4.600000 -0.200000 0.999369 
+-4.200000 -0.200000 0.998394 
+-3.800000 -0.200000 0.996824 
+-3.400000 -0.200000 0.994170 
+-3.000000 -0.200000 0.989402 
+-2.600000 -0.200000 0.980183 
+-2.200000 -0.200000 0.960657 
+-1.800000 -0.200000 0.914623 
+-1.400000 -0.200000 0.795054 
+-1.000000 -0.200000 0.501597 
+-0.600000 -0.200000 0.116328 
+-0.200000 -0.200000 0.003195 
+0.200000 -0.200000 0.003195 
+0.600000 -0.200000 0.116328 
+1.000000 -0.200000 0.501597 
+1.400000 -0.200000 0.795054 
+1.800000 -0.200000 0.914623 
+2.200000 -0.200000 0.960657 
+2.600000 -0.200000 0.980183 
+3.000000 -0.200000 0.989402 
+3.400000 -0.200000 0.994170 
+3.800000 -0.200000 0.996824 
+4.200000 -0.200000 0.998394 
+4.600000 -0.200000 0.999369 
+5.000000 -0.200000 1.000000 
+-5.000000 0.200000 1.000000 
+-4.600000 0.200000 0.999369 
+-4.200000 0.200000 0.998394 
+-3.800000 0.200000 0.996824 
+-3.400000 0.200000 0.994170 
+-3.000000 0.200000 0.989402 
+-2.600000 0.200000 0.980183 
+-2.200000 0.200000 0.960657 
+-1.800000 0.200000 0.914623 
+-1.400000 0.200000 0.795054 
+-1.000000 0.200000 0.501597 
+-0.600000 0.200000 0.116328 
+-0.200000 0.200000 0.003195 
+0.200000 0.200000 0.003195 
+0.600000 0.200000 0.116328 
+1.000000 0.200000 0.501597 
+1.400000 0.200000 0.795054 
+1.800000 0.200000 0.914623 
+2.200000 0.200000 0.960657 
+2.600000 0.200000 0.980183 
+3.000000 0.200000 0.989402 
+3.400000 0.200000 0.994170 
+3.800000 0.200000 0.996824 
+4.200000 0.200000 0.998394 
+4.600000 0.200000 0.999369 
+5.000000 0.200000 1.000000 
+-5.000000 0.600000 1.113133 
+-4.600000 0.600000 1.112502 
+-4.200000 0.600000 1.111527 
+-3.800000 0.600000 1.109958 
+-3.400000 0.600000 1.107303 
+-3.000000 0.600000 1.102536 
+-2.600000 0.600000 1.093317 
+-2.200000 0.600000 1.073790 
+-1.800000 0.600000 1.027756 
+-1.400000 0.600000 0.908188 
+-1.000000 0.600000 0.614731 
+-0.600000 0.600000 0.229462 
+-0.200000 0.600000 0.116328 
+0.200000 0.600000 0.116328 
+0.600000 0.600000 0.229462 
+1.000000 0.600000 0.614731 
+1.400000 0.600000 0.908188 
+1.800000 0.600000 1.027756 
+2.200000 0.600000 1.073790 
+2.600000 0.600000 1.093317 
+3.000000 0.600000 1.102536 
+3.400000 0.600000 1.107303 
+3.800000 0.600000 1.109958 
+4.200000 0.600000 1.111527 
+4.600000 0.600000 1.112502 
+5.000000 0.600000 1.113133 
+-5.000000 1.000000 1.498403 
+-4.600000 1.000000 1.497772 
+-4.200000 1.000000 1.496797 
+-3.800000 1.000000 1.495227 
+-3.400000 1.000000 1.492572 
+-3.000000 1.000000 1.487805 
+-2.600000 1.000000 1.478586 
+-2.200000 1.000000 1.459059 
+-1.800000 1.000000 1.413025 
+-1.400000 1.000000 1.293457 
+-1.000000 1.000000 1.000000 
+-0.600000 1.000000 0.614731 
+-0.200000 1.000000 0.501597 
+0.200000 1.000000 0.501597 
+0.600000 1.000000 0.614731 
+1.000000 1.000000 1.000000 
+1.400000 1.000000 1.293457 
+1.800000 1.000000 1.413025 
+2.200000 1.000000 1.459059 
+2.600000 1.000000 1.478586 
+3.000000 1.000000 1.487805 
+3.400000 1.000000 1.492572 
+3.800000 1.000000 1.495227 
+4.200000 1.000000 1.496797 
+4.600000 1.000000 1.497772 
+5.000000 1.000000 1.498403 
+-5.000000 1.400000 1.791859 
+-4.600000 1.400000 1.791228 
+-4.200000 1.400000 1.790253 
+-3.800000 1.400000 1.788684 
+-3.400000 1.400000 1.786029 
+-3.000000 1.400000 1.781262 
+-2.600000 1.400000 1.772042 
+-2.200000 1.400000 1.752516 
+-1.800000 1.400000 1.706482 
+-1.400000 1.400000 1.586913 
+-1.000000 1.400000 1.293457 
+-0.600000 1.400000 0.908188 
+-0.200000 1.400000 0.795054 
+0.200000 1.400000 0.795054 
+0.600000 1.400000 0.908188 
+1.000000 1.400000 1.293457 
+1.400000 1.400000 1.586913 
+1.800000 1.400000 1.706482 
+2.200000 1.400000 1.752516 
+2.600000 1.400000 1.772042 
+3.000000 1.400000 1.781262 
+3.400000 1.400000 1.786029 
+3.800000 1.400000 1.788684 
+4.200000 1.400000 1.790253 
+4.600000 1.400000 1.791228 
+5.000000 1.400000 1.791859 
+-5.000000 1.800000 1.911428 
+-4.600000 1.800000 1.910797 
+-4.200000 1.800000 1.909822 
+-3.800000 1.800000 1.908252 
+-3.400000 1.800000 1.905598 
+-3.000000 1.800000 1.900830 
+-2.600000 1.800000 1.891611 
+-2.200000 1.800000 1.872085 
+-1.800000 1.800000 1.826051 
+-1.400000 1.800000 1.706482 
+-1.000000 1.800000 1.413025 
+-0.600000 1.800000 1.027756 
+-0.200000 1.800000 0.914623 
+0.200000 1.800000 0.914623 
+0.600000 1.800000 1.027756 
+1.000000 1.800000 1.413025 
+1.400000 1.800000 1.706482 
+1.800000 1.800000 1.826051 
+2.200000 1.800000 1.872085 
+2.600000 1.800000 1.891611 
+3.000000 1.800000 1.900830 
+3.400000 1.800000 1.905598 
+3.800000 1.800000 1.908252 
+4.200000 1.800000 1.909822 
+4.600000 1.800000 1.910797 
+5.000000 1.800000 1.911428 
+-5.000000 2.200000 1.957462 
+-4.600000 2.200000 1.956831 
+-4.200000 2.200000 1.955856 
+-3.800000 2.200000 1.954286 
+-3.400000 2.200000 1.951632 
+-3.000000 2.200000 1.946864 
+-2.600000 2.200000 1.937645 
+-2.200000 2.200000 1.918119 
+-1.800000 2.200000 1.872085 
+-1.400000 2.200000 1.752516 
+-1.000000 2.200000 1.459059 
+-0.600000 2.200000 1.073790 
+-0.200000 2.200000 0.960657 
+0.200000 2.200000 0.960657 
+0.600000 2.200000 1.073790 
+1.000000 2.200000 1.459059 
+1.400000 2.200000 1.752516 
+1.800000 2.200000 1.872085 
+2.200000 2.200000 1.918119 
+2.600000 2.200000 1.937645 
+3.000000 2.200000 1.946864 
+3.400000 2.200000 1.951632 
+3.800000 2.200000 1.954286 
+4.200000 2.200000 1.955856 
+4.600000 2.200000 1.956831 
+5.000000 2.200000 1.957462 
+-5.000000 2.600000 1.976988 
+-4.600000 2.600000 1.976357 
+-4.200000 2.600000 1.975382 
+-3.800000 2.600000 1.973813 
+-3.400000 2.600000 1.971158 
+-3.000000 2.600000 1.966391 
+-2.600000 2.600000 1.957171 
+-2.200000 2.600000 1.937645 
+-1.800000 2.600000 1.891611 
+-1.400000 2.600000 1.772042 
+-1.000000 2.600000 1.478586 
+-0.600000 2.600000 1.093317 
+-0.200000 2.600000 0.980183 
+0.200000 2.600000 0.980183 
+0.600000 2.600000 1.093317 
+1.000000 2.600000 1.478586 
+1.400000 2.600000 1.772042 
+1.800000 2.600000 1.891611 
+2.200000 2.600000 1.937645 
+2.600000 2.600000 1.957171 
+3.000000 2.600000 1.966391 
+3.400000 2.600000 1.971158 
+3.800000 2.600000 1.973813 
+4.200000 2.600000 1.975382 
+4.600000 2.600000 1.976357 
+5.000000 2.600000 1.976988 
+-5.000000 3.000000 1.986207 
+-4.600000 3.000000 1.985576 
+-4.200000 3.000000 1.984601 
+-3.800000 3.000000 1.983032 
+-3.400000 3.000000 1.980377 
+-3.000000 3.000000 1.975610 
+-2.600000 3.000000 1.966391 
+-2.200000 3.000000 1.946864 
+-1.800000 3.000000 1.900830 
+-1.400000 3.000000 1.781262 
+-1.000000 3.000000 1.487805 
+-0.600000 3.000000 1.102536 
+-0.200000 3.000000 0.989402 
+0.200000 3.000000 0.989402 
+0.600000 3.000000 1.102536 
+1.000000 3.000000 1.487805 
+1.400000 3.000000 1.781262 
+1.800000 3.000000 1.900830 
+2.200000 3.000000 1.946864 
+2.600000 3.000000 1.966391 
+3.000000 3.000000 1.975610 
+3.400000 3.000000 1.980377 
+3.800000 3.000000 1.983032 
+4.200000 3.000000 1.984601 
+4.600000 3.000000 1.985576 
+5.000000 3.000000 1.986207 
+-5.000000 3.400000 1.990975 
+-4.600000 3.400000 1.990344 
+-4.200000 3.400000 1.989369 
+-3.800000 3.400000 1.987799 
+-3.400000 3.400000 1.985145 
+-3.000000 3.400000 1.980377 
+-2.600000 3.400000 1.971158 
+-2.200000 3.400000 1.951632 
+-1.800000 3.400000 1.905598 
+-1.400000 3.400000 1.786029 
+-1.000000 3.400000 1.492572 
+-0.600000 3.400000 1.107303 
+-0.200000 3.400000 0.994170 
+0.200000 3.400000 0.994170 
+0.600000 3.400000 1.107303 
+1.000000 3.400000 1.492572 
+1.400000 3.400000 1.786029 
+1.800000 3.400000 1.905598 
+2.200000 3.400000 1.951632 
+2.600000 3.400000 1.971158 
+3.000000 3.400000 1.980377 
+3.400000 3.400000 1.985145 
+3.800000 3.400000 1.987799 
+4.200000 3.400000 1.989369 
+4.600000 3.400000 1.990344 
+5.000000 3.400000 1.990975 
+-5.000000 3.800000 1.993630 
+-4.600000 3.800000 1.992999 
+-4.200000 3.800000 1.992024 
+-3.800000 3.800000 1.990454 
+-3.400000 3.800000 1.987799 
+-3.000000 3.800000 1.983032 
+-2.600000 3.800000 1.973813 
+-2.200000 3.800000 1.954286 
+-1.800000 3.800000 1.908252 
+-1.400000 3.800000 1.788684 
+-1.000000 3.800000 1.495227 
+-0.600000 3.800000 1.109958 
+-0.200000 3.800000 0.996824 
+0.200000 3.800000 0.996824 
+0.600000 3.800000 1.109958 
+1.000000 3.800000 1.495227 
+1.400000 3.800000 1.788684 
+1.800000 3.800000 1.908252 
+2.200000 3.800000 1.954286 
+2.600000 3.800000 1.973813 
+3.000000 3.800000 1.983032 
+3.400000 3.800000 1.987799 
+3.800000 3.800000 1.990454 
+4.200000 3.800000 1.992024 
+4.600000 3.800000 1.992999 
+5.000000 3.800000 1.993630 
+-5.000000 4.200000 1.995199 
+-4.600000 4.200000 1.994568 
+-4.200000 4.200000 1.993593 
+-3.800000 4.200000 1.992024 
+-3.400000 4.200000 1.989369 
+-3.000000 4.200000 1.984601 
+-2.600000 4.200000 1.975382 
+-2.200000 4.200000 1.955856 
+-1.800000 4.200000 1.909822 
+-1.400000 4.200000 1.790253 
+-1.000000 4.200000 1.496797 
+-0.600000 4.200000 1.111527 
+-0.200000 4.200000 0.998394 
+0.200000 4.200000 0.998394 
+0.600000 4.200000 1.111527 
+1.000000 4.200000 1.496797 
+1.400000 4.200000 1.790253 
+1.800000 4.200000 1.909822 
+2.200000 4.200000 1.955856 
+2.600000 4.200000 1.975382 
+3.000000 4.200000 1.984601 
+3.400000 4.200000 1.989369 
+3.800000 4.200000 1.992024 
+4.200000 4.200000 1.993593 
+4.600000 4.200000 1.994568 
+5.000000 4.200000 1.995199 
+-5.000000 4.600000 1.996174 
+-4.600000 4.600000 1.995543 
+-4.200000 4.600000 1.994568 
+-3.800000 4.600000 1.992999 
+-3.400000 4.600000 1.990344 
+-3.000000 4.600000 1.985576 
+-2.600000 4.600000 1.976357 
+-2.200000 4.600000 1.956831 
+-1.800000 4.600000 1.910797 
+-1.400000 4.600000 1.791228 
+-1.000000 4.600000 1.497772 
+-0.600000 4.600000 1.112502 
+-0.200000 4.600000 0.999369 
+0.200000 4.600000 0.999369 
+0.600000 4.600000 1.112502 
+1.000000 4.600000 1.497772 
+1.400000 4.600000 1.791228 
+1.800000 4.600000 1.910797 
+2.200000 4.600000 1.956831 
+2.600000 4.600000 1.976357 
+3.000000 4.600000 1.985576 
+3.400000 4.600000 1.990344 
+3.800000 4.600000 1.992999 
+4.200000 4.600000 1.994568 
+4.600000 4.600000 1.995543 
+5.000000 4.600000 1.996174 
+-5.000000 5.000000 1.996805 
+-4.600000 5.000000 1.996174 
+-4.200000 5.000000 1.995199 
+-3.800000 5.000000 1.993630 
+-3.400000 5.000000 1.990975 
+-3.000000 5.000000 1.986207 
+-2.600000 5.000000 1.976988 
+-2.200000 5.000000 1.957462 
+-1.800000 5.000000 1.911428 
+-1.400000 5.000000 1.791859 
+-1.000000 5.000000 1.498403 
+-0.600000 5.000000 1.113133 
+-0.200000 5.000000 1.000000 
+0.200000 5.000000 1.000000 
+0.600000 5.000000 1.113133 
+1.000000 5.000000 1.498403 
+1.400000 5.000000 1.791859 
+1.800000 5.000000 1.911428 
+2.200000 5.000000 1.957462 
+2.600000 5.000000 1.976988 
+3.000000 5.000000 1.986207 
+3.400000 5.000000 1.990975 
+3.800000 5.000000 1.993630 
+4.200000 5.000000 1.995199 
+4.600000 5.000000 1.996174 
+5.000000 5.000000 1.996805 
@@ -0,0 +1,406 @@
+# DampedOscillator testing data, parameter set 0
+# Seed = 1
+$ k 1
+$ c 0.1
+Time x v 
+d(x) d(v) 
+0 -1.6121219243420617 -0.39817627882942586 -0.39817627882942586 1.6519395522250042 
+0.1 -1.6436480073563013 -0.23209675107126132 -0.23209675107126132 1.6668576824634276 
+0.2 -1.6585194539193124 -0.06536226482188728 -0.06536226482188728 1.665055680401501 
+0.30000000000000004 -1.656754130336734 0.10036300918198092 0.10036300918198092 1.6467178294185358 
+0.4 -1.6385351258676393 0.26344149244335635 0.26344149244335635 1.6121909766233036 
+0.5 -1.604207288477748 0.4222782147121317 0.4222782147121317 1.5619794670065348 
+0.6000000000000001 -1.5542721868772682 0.5753362470243376 0.5753362470243376 1.4967385621748344 
+0.7000000000000001 -1.4893815645888968 0.7211514041407538 0.7211514041407538 1.4172664241748214 
+0.8 -1.41032936612939 0.858346077484632 0.858346077484632 1.3244947583809268 
+0.9 -1.318042428787125 0.9856420697048401 0.9856420697048401 1.2194782218166411 
+1 -1.213569945814403 1.1018723131987704 1.1018723131987704 1.1033827144945259 
+1.1 -1.0980718180147717 1.2059913671956803 1.2059913671956803 0.9774726812952037 
+1.2000000000000002 -0.972806020593109 1.2970846011932806 1.2970846011932806 0.8430975604737809 
+1.3 -0.8391151206639895 1.3743759865179732 1.3743759865179732 0.7016775220121922 
+1.4000000000000001 -0.6984120879107056 1.4372344323954083 1.4372344323954083 0.5546886446711647 
+1.5 -0.5521655464968971 1.4851786180212831 1.4851786180212831 0.40364768469476875 
+1.6 -0.40188462041409867 1.5178802875575845 1.5178802875575845 0.2500965916583402 
+1.7000000000000002 -0.2491035269762848 1.5351659905899628 1.5351659905899628 0.09558692791728851 
+1.8 -0.09536607413697343 1.537017266210758 1.537017266210758 -0.058335652484102385 
+1.9000000000000001 0.05778978328835947 1.523569284383716 1.523569284383716 -0.2101467117267311 
+2 0.20884717554053017 1.495107973448095 1.495107973448095 -0.35835797288533966 
+2.1 0.35632518864973844 1.4520656773833225 1.4520656773833225 -0.5015317563880707 
+2.2 0.49879322973434853 1.3950154006382744 1.3950154006382744 -0.638294769798176 
+2.3000000000000003 0.6348847490764791 1.324663711796342 1.324663711796342 -0.7673511202561133 
+2.4000000000000004 0.7633101889513891 1.2418423899719824 1.2418423899719824 -0.8874944279485873 
+2.5 0.8828690381689148 1.1474989094992898 1.1474989094992898 -0.9976189291188438 
+2.6 0.9924608813805033 1.0426858690718248 1.0426858690718248 -1.0967294682876858 
+2.7 1.0910953433032347 0.928549480930787 0.928549480930787 -1.1839502913963134 
+2.8000000000000003 1.1779008399923419 0.806317243893282 0.806317243893282 -1.25853256438167 
+2.9000000000000004 1.2521320620282133 0.6772849308948469 0.6772849308948469 -1.3198605551176978 
+3 1.3131761278386165 0.5428030272351919 0.5428030272351919 -1.3674564305621357 
+3.1 1.3605573592129447 0.4042627598220404 0.4042627598220404 -1.4009836351951488 
+3.2 1.393940645240324 0.2630818603782539 0.2630818603782539 -1.4202488312781494 
+3.3000000000000003 1.4131333752731445 0.12069020679979275 0.12069020679979275 -1.4252023959531237 
+3.4000000000000004 1.4180859359371603 -0.02148451337120799 -0.02148451337120799 -1.4159374846000394 
+3.5 1.4088907815349225 -0.16203097504630842 -0.16203097504630842 -1.3926876840302918 
+3.6 1.3857801012794515 -0.29956808400237767 -0.29956808400237767 -1.3558232928792138 
+3.7 1.3491221205119994 -0.4327584067486557 -0.4327584067486557 -1.3058462798371337 
+3.8000000000000003 1.2994160862688269 -0.5603210326947644 -0.5603210326947644 -1.2433839829993505 
+3.9000000000000004 1.237286000140817 -0.6810437463942737 -0.6810437463942737 -1.1691816255013896 
+4 1.1634731731977155 -0.7937943957137317 -0.7937943957137317 -1.0840937336263423 
+4.1000000000000005 1.0788276887156756 -0.8975313509068982 -0.8975313509068982 -0.9890745536249858 
+4.2 0.9842988684521602 -0.9913129596569894 -0.9913129596569894 -0.8851675724864613 
+4.3 0.8809248471662005 -1.0743059140763522 -1.0743059140763522 -0.7734942557585652 
+4.4 0.7698213679059509 -1.14579245730609 -1.14579245730609 -0.655242122175342 
+4.5 0.6521699172129153 -1.2051763696146807 -1.2051763696146807 -0.5316522802514472 
+4.6000000000000005 0.5292053247691243 -1.2519876866267245 -1.2519876866267245 -0.40400655610645186 
+4.7 0.40220295609893114 -1.2858861153892762 -1.2858861153892762 -0.27361434456000355 
+4.800000000000001 0.2724656297031861 -1.3066631272703946 -1.3066631272703946 -0.14179931697614664 
+4.9 0.14131039143597873 -1.3142427200482985 -1.3142427200482985 -0.009886119431148893 
+5 0.010055279031932863 -1.308680854856308 -1.308680854856308 0.12081280645369795 
+5.1000000000000005 -0.11999379153250098 -1.2901635867668102 -1.2901635867668102 0.249010150209182 
+5.2 -0.24755588868183556 -1.2590039205982506 -1.2590039205982506 0.3734562807416606 
+5.300000000000001 -0.37138755150705605 -1.2156374358885782 -1.2156374358885782 0.49295129509591384 
+5.4 -0.49029477926194637 -1.1606167367781246 -1.1606167367781246 0.6063564529397588 
+5.5 -0.6031444098350675 -1.0946047936728385 -1.0946047936728385 0.7126048892023513 
+5.6000000000000005 -0.7088747801538104 -1.0183672539112187 -1.0183672539112187 0.8107115055449323 
+5.7 -0.8065055696809929 -0.9327638081399318 -0.9327638081399318 0.899781950494986 
+5.800000000000001 -0.8951467372695256 -0.838738707628402 -0.838738707628402 0.9790206080323658 
+5.9 -0.9740064715472797 -0.7373105352463721 -0.7373105352463721 1.047737525071917 
+6 -1.0423980856059383 -0.6295613392262911 -0.6295613392262911 1.1053542195285675 
+6.1000000000000005 -1.0997457979520382 -0.5166252440817306 -0.5166252440817306 1.1514083223602112 
+6.2 -1.1455893533285684 -0.39967665711306194 -0.39967665711306194 1.1855570190398745 
+6.300000000000001 -1.1795874490109657 -0.27991819177384286 -0.27991819177384286 1.20757926818835 
+6.4 -1.2015199443994256 -0.15856843077960436 -0.15856843077960436 1.217376787477386 
+6.5 -1.2112888440464336 -0.03684965221129713 -0.03684965221129713 1.2149738092675633 
+6.6000000000000005 -1.208918056550899 0.08402435899281963 0.08402435899281963 1.200515620651617 
+6.7 -1.194551943896274 0.20286029382982554 0.20286029382982554 1.1742659145132914 
+6.800000000000001 -1.1684526876902364 0.31849697922477266 0.31849697922477266 1.1366029897677592 
+6.9 -1.130996510262488 0.4298166025130035 0.4298166025130035 1.0880148500111875 
+7 -1.0826687995844018 0.5357553931531283 0.5357553931531283 1.029093260269089 
+7.1000000000000005 -1.0240581973851244 0.635313660875028 0.635313660875028 0.9605268312976216 
+7.2 -0.9558497195557202 0.7275650968684275 0.7275650968684275 0.8830932098688774 
+7.300000000000001 -0.8788169868663552 0.8116652528736309 0.8116652528736309 0.7976504615789921 
+7.4 -0.793813652090308 0.8868591220563521 0.8868591220563521 0.7051277398846727 
+7.5 -0.7017641167611705 0.9524877552359103 0.9524877552359103 0.6065153412375794 
+7.6000000000000005 -0.6036536369243723 1.0079938562874569 1.0079938562874569 0.5028542512956267 
+7.7 -0.5005179223300354 1.0529263112468183 1.0529263112468183 0.3952252912053536 
+7.800000000000001 -0.3934323375110517 1.0869436166999922 1.0869436166999922 0.2847379758410525 
+7.9 -0.2835008160692485 1.1098161843249594 1.1098161843249594 0.17251919763675255 
+8 -0.17184460123607295 1.1214275098568265 1.1214275098568265 0.05970185025039029 
+8.1 -0.059590926376357434 1.1217742061537523 1.1217742061537523 -0.0525864942390178 
+8.200000000000001 0.05213825143021633 1.110964911337317 1.110964911337317 -0.16323474256394804 
+8.3 0.16223735324873656 1.08921809405566 1.08921809405566 -0.27115916265430257 
+8.4 0.26962800685382743 1.0568587886630076 1.0568587886630076 -0.3753138857201282 
+8.5 0.3732694970626297 1.0143143034215363 1.0143143034215363 -0.4747009274047833 
+8.6 0.4721687377035376 0.9621089546127092 0.9621089546127092 -0.5683796331648086 
+8.700000000000001 0.565389670840407 0.9008578886032609 0.9008578886032609 -0.6554754597007331 
+8.8 0.652062005505205 0.8312600623610977 0.8312600623610977 -0.7351880117413148 
+8.9 0.7313892156316942 0.7540904605815252 0.7540904605815252 -0.8067982616898468 
+9 0.8026557250467515 0.6701916343960314 0.6701916343960314 -0.8696748884863547 
+9.1 0.8652332161759286 0.5804646525351125 0.5804646525351125 -0.9232796814294398 
+9.200000000000001 0.9185860084620711 0.48585956075374853 0.48585956075374853 -0.9671719645374459 
+9.3 0.9622754622819234 0.38736544926361577 0.38736544926361577 -1.0010120072082849 
+9.4 0.995963374273984 0.2860002308208069 0.2860002308208069 -1.0245633973560646 
+9.5 1.0194143403572982 0.1828002339730796 0.1828002339730796 -1.037694363754606 
+9.600000000000001 1.0324970732199974 0.07880971676848454 0.07880971676848454 -1.0403780448968458 
+9.700000000000001 1.0351846715824742 -0.024929594029781264 -0.024929594029781264 -1.032691712179496 
+9.8 1.0275538489882243 -0.1273888344762474 -0.1273888344762474 -1.0148149655405996 
+9.9 1.009783140142502 -0.22756210429501844 -0.22756210429501844 -0.9870269297130001 
+10 0.9821501128047126 -0.3244762389244841 -0.3244762389244841 -0.9497024889122643 
+10.100000000000001 0.9450276228484766 -0.41720015887410683 -0.41720015887410683 -0.9033076069610659 
+10.200000000000001 0.8988791592417336 -0.5048537076489472 -0.5048537076489472 -0.8483937884768389 
+10.3 0.8442533342819749 -0.5866158954651995 -0.5866158954651995 -0.785591744735455 
+10.4 0.7817775823688582 -0.6617324727112103 -0.6617324727112103 -0.7156043350977371 
+10.5 0.7121511378354223 -0.7295227645287035 -0.7295227645287035 -0.6391988613825519 
+10.600000000000001 0.6361373688249244 -0.7893857059176214 -0.7893857059176214 -0.5571987982331623 
+10.700000000000001 0.5545555498364131 -0.8408050253192325 -0.8408050253192325 -0.47047504730448986 
+10.8 0.4682721603207118 -0.8833535336157443 -0.8833535336157443 -0.37993680695913734 
+10.9 0.37819180055098794 -0.9166964848069653 -0.9166964848069653 -0.2865221520702914 
+11 0.2852478188893821 -0.94059398418955 -0.94059398418955 -0.1911884204704271 
+11.100000000000001 0.19039274650392257 -0.9549024295745141 -0.9549024295745141 -0.09490250354647116 
+11.200000000000001 0.09458863654849545 -0.95957498083604 -0.95957498083604 0.0013688615351085537 
+11.3 -0.0012025951968334635 -0.9546610627916408 -0.9546610627916408 0.09666870147599754 
+11.4 -0.0960287322075092 -0.9403049159745038 -0.9403049159745038 0.1900592238049596 
+11.5 -0.18895662752505793 -0.9167432191796855 -0.9167432191796855 0.28063094944302647 
+11.600000000000001 -0.27908129124422476 -0.8843018166565165 -0.8843018166565165 0.3675114729098764 
+11.700000000000001 -0.36553460764413787 -0.8433915913939889 -0.8433915913939889 0.44987376678353674 
+11.8 -0.44749359898395413 -0.7945035340229288 -0.7945035340229288 0.526943952386247 
+11.9 -0.5241881583161092 -0.7382030643630755 -0.7382030643630755 0.5980084647524168 
+12 -0.5949081797218548 -0.6751236695058511 -0.6751236695058511 0.66242054667244 
+12.100000000000001 -0.6590100210769059 -0.6059599284828073 -0.6059599284828073 0.7196060139251866 
+12.200000000000001 -0.715922241736609 -0.5314599989712127 -0.5314599989712127 0.7690682416337302 
+12.3 -0.7651505653119463 -0.4524176460858501 -0.4524176460858501 0.8103923299205312 
+12.4 -0.806282025907536 -0.36966389706211933 -0.36966389706211933 0.8432484156137479 
+12.5 -0.8389882647249316 -0.28405840852099884 -0.28405840852099884 0.8673941055770314 
+12.600000000000001 -0.8630279527108013 -0.19648063500132237 -0.19648063500132237 0.8826760162109336 
+12.700000000000001 -0.8782483238603127 -0.10782088853815763 -0.10782088853815763 0.8890304127141284 
+12.8 -0.8845858127809405 -0.01897137925597278 -0.01897137925597278 0.8864829507065378 
+12.9 -0.8820657990908738 0.06918267376118174 0.06918267376118174 0.8751475317147556 
+13 -0.870801470080323 0.15577177364710643 0.15577177364710643 0.8552242927156124 
+13.100000000000001 -0.8509918217164457 0.23995063374585932 0.23995063374585932 0.8269967583418598 
+13.200000000000001 -0.8229188264392704 0.3209063404522893 0.3209063404522893 0.7908281923940415 
+13.3 -0.7869438041966185 0.39786611298205227 0.39786611298205227 0.7471571928984132 
+13.4 -0.7435030407246532 0.47010458693505003 0.47010458693505003 0.6964925820311483 
+13.5 -0.693102704126599 0.5369505539783299 0.5369505539783299 0.639407648728766 
+13.600000000000001 -0.6363131172704363 0.5977930960550495 0.5977930960550495 0.5765338076649313 
+13.700000000000001 -0.5737624493583968 0.6520870591585199 0.6520870591585199 0.5085537434425448 
+13.8 -0.506129895165238 0.6993578188231747 0.6993578188231747 0.4361941132829205 
+13.9 -0.43413841485425464 0.7392052970020736 0.7392052970020736 0.3602178851540473 
+14 -0.3585471109232061 0.7713071978444658 0.7713071978444658 0.28141639113875955 
+14.100000000000001 -0.28014332167824985 0.7954214379757827 0.7954214379757827 0.20060117788067156 
+14.200000000000001 -0.1997345126621819 0.8113877551333533 0.8113877551333533 0.11859573714884657 
+14.3 -0.11814004866179953 0.8191284873404768 0.8191284873404768 0.036227199927751835 
+14.4 -0.03618292928433533 0.8186485231256823 0.8186485231256823 -0.04568192302823291 
+14.5 0.04531842937065856 0.8100344315303266 0.8100344315303266 -0.12632187252369123 
+14.600000000000001 0.12555828569702474 0.7934527887151338 0.7934527887151338 -0.20490356456853812 
+14.700000000000001 0.2037514570528047 0.7691477257963172 0.7691477257963172 -0.2806662296324364 
+14.8 0.2791409213845498 0.7374377300392965 0.7374377300392965 -0.35288469438847947 
+14.9 0.3510050632450931 0.6987117386413622 0.6987117386413622 -0.42087623710922933 
+15 0.41866449570410724 0.653424570977279 0.653424570977279 -0.48400695280183514 
+15.100000000000001 0.48148839454878906 0.6020917513022858 0.6020917513022858 -0.5416975696790176 
+15.200000000000001 0.5389002866536179 0.5452837794495922 0.5452837794495922 -0.5934286645985771 
+15.3 0.5903832404030375 0.4836199119749422 0.4836199119749422 -0.6387452316005316 
+15.4 0.6354844125141865 0.4177615204464736 0.4177615204464736 -0.6772605645588339 
+15.5 0.6738189124596528 0.3484050971184149 0.3484050971184149 -0.7086594221714942 
+15.600000000000001 0.705072952860626 0.27627498103394277 0.27627498103394277 -0.7327004509640203 
+15.700000000000001 0.7290062616343432 0.20211587965516942 0.20211587965516942 -0.7492178495998602 
+15.8 0.7454537392602175 0.12668526240383307 0.12668526240383307 -0.7581222655006008 
+15.9 0.7543263521995097 0.05074570300968877 0.05074570300968877 -0.7594009225004786 
+16 0.7556112611866748 -0.02494275269259845 -0.02494275269259845 -0.7531169859174149 
+16.1 0.7493711907300772 -0.099630117892848 -0.099630117892848 -0.7394081789407924 
+16.2 0.7357430536405187 -0.17258382099318215 -0.17258382099318215 -0.7184846715412005 
+16.3 0.7149358516749393 -0.2430958154606504 -0.2430958154606504 -0.6906262701288743 
+16.400000000000002 0.6872278803696125 -0.31048937511045355 -0.31048937511045355 -0.6561789428585671 
+16.5 0.652963272775468 -0.37412551039191483 -0.37412551039191483 -0.6155507217362765 
+16.6 0.6125479230353359 -0.4334089456583547 -0.4334089456583547 -0.5692070284695004 
+16.7 0.5664448365010819 -0.48779360236387126 -0.48779360236387126 -0.5176654762646948 
+16.8 0.515168958325221 -0.5367875385898652 -0.5367875385898652 -0.4614902044662345 
+16.900000000000002 0.45928153712978526 -0.5799573012027525 -0.5799573012027525 -0.40128580700951 
+17 0.39938408441421225 -0.6169316532186656 -0.6169316532186656 -0.33769091909234566 
+17.1 0.3361119937795478 -0.6474046455349337 -0.6474046455349337 -0.27137152922605445 
+17.2 0.27012788679073285 -0.6711380090132262 -0.6711380090132262 -0.20301408588941022 
+17.3 0.2021147543515935 -0.687962849895174 -0.687962849895174 -0.13331846936207611 
+17.400000000000002 0.13276896381482362 -0.697780638626815 -0.697780638626815 -0.0629908999521421 
+17.5 0.06279320268537349 -0.7005634892917916 -0.7005634892917916 0.007263146243805668 
+17.6 -0.007110570299015056 -0.6963537339337665 -0.6963537339337665 0.07674594369239171 
+17.7 -0.07624809670403879 -0.6852628030160693 -0.6852628030160693 0.14477437700564572 
+17.8 -0.1439396440116473 -0.6674694300529459 -0.6674694300529459 0.21068658701694187 
+17.900000000000002 -0.20952661890199933 -0.6432172049863598 -0.6432172049863598 0.2738483394006353 
+18 -0.2723779045688506 -0.6128115071124592 -0.6128115071124592 0.33365905528009654 
+18.1 -0.331895861809476 -0.5766158542237619 -0.5766158542237619 0.3895574472318522 
+18.2 -0.38752193757252457 -0.5350477100721903 -0.5350477100721903 0.4410267085797436 
+18.3 -0.438741829110174 -0.488573797224441 -0.488573797224441 0.4875992088326181 
+18.400000000000002 -0.4850901568150274 -0.43770496683013393 -0.43770496683013393 0.5288606534980408 
+18.5 -0.5261546041736502 -0.38299068071566866 -0.38299068071566866 0.5644536722452171 
+18.6 -0.5615794889801174 -0.3250131645195552 -0.3250131645195552 0.594080805432073 
+18.7 -0.5910687359640592 -0.26438129327122406 -0.26438129327122406 0.6175068652911816 
+18.8 -0.6143882272357285 -0.20172427286436964 -0.20172427286436964 0.6345606545221655 
+18.900000000000002 -0.6313675133710797 -0.13768518227372978 -0.13768518227372978 0.6451360315984527 
+19 -0.641900874487196 -0.07291444210341198 -0.07291444210341198 0.6491923186975372 
+19.1 -0.6459477272266901 -0.008063275134672677 -0.008063275134672677 0.6467540547401575 
+19.200000000000003 -0.6435323801132026 0.05622277603281376 0.05622277603281376 0.6379101025099212 
+19.3 -0.6347431461940402 0.11931021036747104 0.11931021036747104 0.622812125157293 
+19.400000000000002 -0.6197308281870516 0.18058374677969183 0.18058374677969183 0.6016724535090824 
+19.5 -0.5987065974359109 0.23945225987943602 0.23945225987943602 0.5747613714479674 
+19.6 -0.5719392937926839 0.29535441651905375 0.29535441651905375 0.5424038521407786 
+19.700000000000003 -0.5397521790337803 0.3477639600607556 0.3477639600607556 0.5049757830277047 
+19.8 -0.5025191815238674 0.3961945933383873 0.3961945933383873 0.46289972219002873 
+19.900000000000002 -0.4606606745250038 0.440204415760763 0.440204415760763 0.4166402329489275 
+0 0.06085051400826158 0.6272799219801937 0.6272799219801937 -0.12357850620628096 
+0.1 0.12285894713666523 0.6118807700056959 0.6118807700056959 -0.1840470241372348 
+0.2 0.18302893747876536 0.5905515986639517 0.5905515986639517 -0.24208409734516054 
+0.30000000000000004 0.2407805751895348 0.5635634646362236 0.5635634646362236 -0.2971369216531572 
+0.4 0.29556376358825104 0.5312409882569569 0.5312409882569569 -0.3486878624139467 
+0.5 0.3468633915062148 0.4939585932110171 0.4939585932110171 -0.3962592508273165 
+0.6000000000000001 0.39420410634708314 0.4521362885077702 0.4521362885077702 -0.43941773519786015 
+0.7000000000000001 0.4371546443796149 0.40623503922152276 0.40623503922152276 -0.4777781483017671 
+0.8 0.47533167961719924 0.3567517761136942 0.3567517761136942 -0.5110068572285686 
+0.9 0.5084031578092217 0.3042140973436335 0.3042140973436335 -0.5388245675435851 
+1 0.5360910875213576 0.2491747180067899 0.2491747180067899 -0.5610085593220366 
+1.1 0.5581737659582706 0.19220572519140453 0.19220572519140453 -0.5773943384774111 
+1.2000000000000002 0.5744874230242681 0.13389269760437114 0.13389269760437114 -0.5878766927847052 
+1.3 0.5849272730654924 0.07482874957578016 0.07482874957578016 -0.5924101480230705 
+1.4000000000000001 0.589447969731024 0.015608559408367043 0.015608559408367043 -0.5910088256718606 
+1.5 0.5880634653697883 -0.04317755840294741 -0.04317755840294741 -0.5837457095294936 
+1.6 0.5808462822860945 -0.10094947858467426 -0.10094947858467426 -0.570751334427627 
+1.7000000000000002 0.5679262089509973 -0.15714293123455125 -0.15714293123455125 -0.5522119158275423 
+1.8 0.5494884398533734 -0.21121495396005127 -0.21121495396005127 -0.5283669444573682 
+1.9000000000000001 0.5257711830199621 -0.2626490779305602 -0.2626490779305602 -0.49950627522690605 
+2 0.4970627642868961 -0.31096019893173416 -0.31096019893173416 -0.4659667443937227 
+2.1 0.46369826211910287 -0.35569908812889184 -0.35569908812889184 -0.4281283533062137 
+2.2 0.42605571110491863 -0.3964565012789416 -0.3964565012789416 -0.38641006097702446 
+2.3000000000000003 0.3845519161620555 -0.4328668495330655 -0.4328668495330655 -0.3412652312087489 
+2.4000000000000004 0.33963792294306233 -0.46461139969773774 -0.46461139969773774 -0.29317678297328853 
+2.5 0.29179419289382186 -0.491420976819441 -0.491420976819441 -0.24265209521187775 
+2.6 0.24152553387281184 -0.5130781471762786 -0.5130781471762786 -0.19021771915518398 
+2.7 0.189355839162503 -0.5294188651434847 -0.5294188651434847 -0.13641395264815454 
+2.8000000000000003 0.1358226890835263 -0.5403335728944423 -0.5403335728944423 -0.08178933179408207 
+2.9000000000000004 0.08147187024882324 -0.5457677474484943 -0.5457677474484943 -0.026895095503973808 
+3 0.026851867766178338 -0.5457218951258562 -0.5457218951258562 0.02772032174640729 
+3.1 -0.02749161458385245 -0.5402509989631507 -0.5402509989631507 0.08151671448016752 
+3.2 -0.08102105199325892 -0.5294634300264222 -0.5294634300264222 0.13396739499590113 
+3.3000000000000003 -0.1332123612500846 -0.5135193387795379 -0.5135193387795379 0.1845642951280384 
+3.4000000000000004 -0.18355997736325855 -0.492628547674321 -0.492628547674321 0.23282283213069066 
+3.5 -0.23158169556828748 -0.46704797087695854 -0.46704797087695854 0.2782864926559833 
+3.6 -0.27682323290968186 -0.4370785914885181 -0.4370785914885181 0.3205310920585337 
+3.7 -0.3188624668396815 -0.4030620307146834 -0.4030620307146834 0.3591686699111498 
+3.8000000000000003 -0.35731331190739424 -0.36537674715377366 -0.36537674715377366 0.3938509866227716 
+3.9000000000000004 -0.3918291995978411 -0.3244339076696454 -0.3244339076696454 0.4242725903648057 
+4 -0.42210613067349856 -0.2806729741685346 -0.2806729741685346 0.450173428090352 
+4.1000000000000005 -0.4478852739260061 -0.23455705298236584 -0.23455705298236584 0.4713409792242427 
+4.2 -0.46895509001486113 -0.18656805545649358 -0.18656805545649358 0.4876118955605105 
+4.3 -0.4851529640036229 -0.13720171973326498 -0.13720171973326498 0.4988731359769494 
+4.4 -0.4963663352516905 -0.08696254460534635 -0.08696254460534635 0.5050625897122252 
+4.5 -0.502533318429807 -0.036358686680740916 -0.036358686680740916 0.5061691870978811 
+4.6000000000000005 -0.5036428145486324 0.014103128043687824 0.014103128043687824 0.5022325017442636 
+4.7 -0.4997341159710072 0.06392262775504305 0.06392262775504305 0.4933418531955029 
+4.800000000000001 -0.4908960143697738 0.11261090424513229 0.11261090424513229 0.4796349239452606 
+4.9 -0.47726542544550377 0.15969516050608962 0.15969516050608962 0.4612959093948948 
+5 -0.45902554888533603 0.20472325091105695 0.20472325091105695 0.43855322379423034 
+5.1000000000000005 -0.4364035864808197 0.24726797090581806 0.24726797090581806 0.41167678939023794 
+5.2 -0.4096680454873442 0.28693105605620584 0.28693105605620584 0.3809749398817236 
+5.300000000000001 -0.3791256581617406 0.3233468535852025 0.3233468535852025 0.34679097280322035 
+5.4 -0.3451179519227256 0.35618563315654445 0.35618563315654445 0.30949938860707116 
+5.5 -0.3080175077095915 0.3851565075789838 0.3851565075789838 0.26950185695169315 
+5.6000000000000005 -0.26822394684056033 0.4100099382752784 0.4100099382752784 0.2272229530130325 
+5.7 -0.22615968897043764 0.43053980473850556 0.43053980473850556 0.18310570849658708 
+5.800000000000001 -0.1822655255990293 0.44658502173981707 0.44658502173981707 0.1376070234250476 
+5.9 -0.13699605497325812 0.45803069270950225 0.45803069270950225 0.09119298570230788 
+6 -0.0908150251477789 0.46480879243974016 0.46480879243974016 0.04433414590380488 
+6.1000000000000005 -0.044190632416649375 0.46689837700502085 0.46689837700502085 -0.0024992052838527096 
+6.2 0.0024091776974622574 0.4643253235174953 0.4643253235174953 -0.04884171004921179 
+6.300000000000001 0.04852136020499307 0.4571616069827947 0.4571616069827947 -0.09423752090327253 
+6.4 0.0936923254248004 0.44552412605165775 0.44552412605165775 -0.13824473802996617 
+6.5 0.13748235443518986 0.42957309383016073 0.42957309383016073 -0.18043966381820592 
+6.6000000000000005 0.17946983268908423 0.4095100140746557 0.4095100140746557 -0.2204208340965498 
+6.7 0.21925526151254815 0.3855752670173309 0.3855752670173309 -0.2578127882142812 
+6.800000000000001 0.2564650098149397 0.3580453327080584 0.3580453327080584 -0.29226954308574554 
+6.9 0.29075477129658556 0.327229683084496 0.327229683084496 -0.3234777396050352 
+7 0.32181269571319376 0.29346737696531194 0.29346737696531194 -0.35115943340972494 
+7.1000000000000005 0.34936216630954675 0.2571233947746542 0.2571233947746542 -0.3750745057870122 
+7.2 0.3731641993302455 0.2185847520273009 0.2185847520273009 -0.3950226745329756 
+7.300000000000001 0.3930194455122592 0.17825643241511546 0.17825643241511546 -0.41084508875377074 
+7.4 0.40876977762103417 0.13655718272255557 0.13655718272255557 -0.4224254958932897 
+7.5 0.4202994523660084 0.09391521275249642 0.09391521275249642 -0.4296909736412581 
+7.6000000000000005 0.42753583937890727 0.050763843958338925 0.050763843958338925 -0.4326122237747412 
+7.7 0.4304497143152028 0.007537150553550108 0.007537150553550108 -0.4312034293705578 
+7.800000000000001 0.42905511750180636 -0.03533436349095981 -0.03533436349095981 -0.4255216811527104 
+7.9 0.4234087838591895 -0.07742800893995253 -0.07742800893995253 -0.41566598296519425 
+8 0.4136091540314661 -0.11833303586042207 -0.11833303586042207 -0.40177585044542385 
+8.1 0.3997949807227117 -0.15765459844895524 -0.15765459844895524 -0.38402952087781617 
+8.200000000000001 0.3821435481229168 -0.19501752229149705 -0.19501752229149705 -0.3626417958937671 
+8.3 0.36086852597564634 -0.23006983856771743 -0.23006983856771743 -0.3378615421188746 
+8.4 0.3362174832573762 -0.2624860523822932 -0.2624860523822932 -0.3099688780191469 
+8.5 0.30846908957412206 -0.2919701153752386 -0.2919701153752386 -0.2792720780365982 
+8.6 0.2779300352059969 -0.31825807600042966 -0.31825807600042966 -0.24610422760595393 
+8.700000000000001 0.2449317032197354 -0.34112038433083436 -0.34112038433083436 -0.21081966478665196 
+8.8 0.20982662920159934 -0.360363831913874 -0.360363831913874 -0.17379024601021192 
+8.9 0.1729847859207772 -0.3758331110224247 -0.3758331110224247 -0.13540147481853473 
+9 0.1347897316026685 -0.3874119815866526 -0.3874119815866526 -0.09604853344400324 
+9.1 0.09563466146255167 -0.39502403810883124 -0.39502403810883124 -0.05613225765166854 
+9.200000000000001 0.055918402717378676 -0.3986330729168048 -0.3986330729168048 -0.016055095425698197 
+9.3 0.016041393455198432 -0.398243036161171 -0.398243036161171 0.023782910160918675 
+9.4 -0.023598314499584452 -0.3938975969663576 -0.3938975969663576 0.06298807419622021 
+9.5 -0.06260898922485568 -0.3856793140671549 -0.3856793140671549 0.10117692063157117 
+9.600000000000001 -0.1006090503673861 -0.37370842806182325 -0.37370842806182325 0.13797989317356843 
+9.700000000000001 -0.1372307617580555 -0.3581412910540222 -0.3581412910540222 0.1730448908634577 
+9.8 -0.1721237496477623 -0.33916845290391007 -0.33916845290391007 0.20604059493815333 
+9.9 -0.2049583133212533 -0.3170124265314674 -0.3170124265314674 0.23665955597440005 
+10 -0.23542849724103052 -0.29192515768260746 -0.29192515768260746 0.26462101300929125 
+10.100000000000001 -0.2632548965512742 -0.26418522725399307 -0.26418522725399307 0.2896734192766735 
+10.200000000000001 -0.2881871707029177 -0.23409481665178744 -0.23409481665178744 0.31159665236809647 
+10.3 -0.31000624311396546 -0.20197646871222247 -0.20197646871222247 0.3302038899851877 
+10.4 -0.328526168120304 -0.16816967842070843 -0.16816967842070843 0.3453431359623748 
+10.5 -0.343595649966523 -0.1330273490176689 -0.1330273490176689 0.35689838486828984 
+10.600000000000001 -0.35509920219738755 -0.09691215006348741 -0.09691215006348741 0.3647904172037363 
+10.700000000000001 -0.36295793950150745 -0.06019281464575849 -0.06019281464575849 0.3689772209660833 
+10.8 -0.3671299977919512 -0.023240413147100007 -0.023240413147100007 0.3694540391066612 
+10.9 -0.3676105820465201 0.013575359147505479 0.013575359147505479 0.3662530461317695 
+11 -0.3644316451359137 0.04988984383339321 0.04988984383339321 0.35944266075257436 
+11.100000000000001 -0.35766120450456906 0.08534699465750659 0.08534699465750659 0.3491265050388184 
+11.200000000000001 -0.34740230710107434 0.11960283159418991 0.11960283159418991 0.33544202394165534 
+11.3 -0.3337916563486688 0.15232874059140145 0.15232874059140145 0.31855878228952866 
+11.4 -0.3169979181690747 0.18321458771727986 0.18321458771727986 0.2986764593973467 
+11.5 -0.2972197260944385 0.21197161859334895 0.21197161859334895 0.2760225642351036 
+11.600000000000001 -0.2746834082944368 0.23833511642498528 0.23833511642498528 0.2508498966519383 
+11.700000000000001 -0.249640461883154 0.262066794605333 0.262066794605333 0.22343378242262069 
+11.8 -0.22236480213047133 0.28295690274673035 0.28295690274673035 0.19406911185579828 
+11.9 -0.19314981616570767 0.30082602805318626 0.30082602805318626 0.16306721336038904 
+12 -0.1623052524105631 0.31552657715628907 0.31552657715628907 0.1307525946949342 
+12.100000000000001 -0.1301539783007614 0.3269439268617599 0.3269439268617599 0.0974595856145854 
+12.200000000000001 -0.097028639841275 0.33499723566046247 0.33499723566046247 0.06352891627522875 
+12.3 -0.06326825718219571 0.3396399113113789 0.3396399113113789 0.02930426605105782 
+12.4 -0.02921479069822333 0.340859733270037 0.340859733270037 -0.00487118262878037 
+12.5 0.004790287995117874 0.33867863217956895 0.33867863217956895 -0.03865815121307477 
+12.600000000000001 0.038409386047120124 0.33315213202895483 0.33315213202895483 -0.0717245992500156 
+12.700000000000001 0.07131210708420992 0.324368463880966 0.324368463880966 -0.10374895347230652 
+12.8 0.10317846436398459 0.31244736324895017 0.31244736324895017 -0.1344232006888796 
+12.9 0.13370195846655702 0.2975385662265376 0.2975385662265376 -0.16345581508921078 
+13 0.16259249027305514 0.27982002231902164 0.27982002231902164 -0.1905744925049573 
+13.100000000000001 0.18957908190972234 0.259495844563089 0.259495844563089 -0.21552866636603124 
+13.200000000000001 0.21441238051710027 0.23679401992858265 0.23679401992858265 -0.23809178250995852 
+13.3 0.2368669221130548 0.2119639051504358 0.2119639051504358 -0.2580633126280984 
+13.4 0.25674313542970373 0.18527353502191382 0.18527353502191382 -0.2752704889318951 
+13.5 0.2738690683896472 0.15700677177582256 0.15700677177582256 -0.2895697455672295 
+13.600000000000001 0.2881018228168724 0.12746032547538763 0.12746032547538763 -0.30084785536441117 
+13.700000000000001 0.2993286860217259 0.09694067632118696 0.09694067632118696 -0.30902275365384463 
+13.8 0.30746795102602764 0.06576093044813475 0.06576093044813475 -0.31404404407084113 
+13.9 0.31246942037183245 0.03423764113358293 0.03423764113358293 -0.31589318448519077 
+14 0.31431459165342923 0.0026876273638633498 0.0026876273638633498 -0.31458335438981555 
+14.100000000000001 0.3130165260949478 -0.028575178585024247 -0.028575178585024247 -0.3101590082364454 
+14.200000000000001 0.3086194046339077 -0.05924282350030827 -0.05924282350030827 -0.30269512228387685 
+14.3 0.30119777903344036 -0.08901633537280344 -0.08901633537280344 -0.29229614549616 
+14.4 0.2908555285030496 -0.11760860637544988 -0.11760860637544988 -0.2790946678655046 
+14.5 0.2777245351312978 -0.14474712920349464 -0.14474712920349464 -0.26324982221094834 
+14.600000000000001 0.26196309409697166 -0.17017656103103287 -0.17017656103103287 -0.24494543799386836 
+14.700000000000001 0.24375407710321095 -0.19366109130861478 -0.19366109130861478 -0.22438796797234947 
+14.8 0.22330286974898597 -0.21498659181337712 -0.21498659181337712 -0.20180421056764825 
+14.9 0.20083510559368295 -0.233962529742791 -0.233962529742791 -0.17743885261940384 
+15 0.17659422146538736 -0.2504236271900358 -0.2504236271900358 -0.15155185874638377 
+15.100000000000001 0.15083886009634476 -0.26423125302624556 -0.26423125302624556 -0.1244157347937202 
+15.200000000000001 0.1238401474273982 -0.2752745360143008 -0.2752745360143008 -0.09631269382596812 
+15.3 0.09587887289717208 -0.2834711908615169 -0.2834711908615169 -0.06753175381102039 
+15.4 0.06724260171453877 -0.2887680518550221 -0.2887680518550221 -0.038365796529036565 
+15.5 0.0382227485005786 -0.2911413116840869 -0.2911413116840869 -0.009108617332169909 
+15.600000000000001 0.009111641777905074 -0.2905964660085024 -0.2905964660085024 0.01994800482294517 
+15.700000000000001 -0.019800391417087344 -0.287167967251977 -0.287167967251977 0.04851718814228505 
+15.8 -0.04822789201485248 -0.28091859395575497 -0.28091859395575497 0.07631975141042799 
+15.9 -0.0758930584746342 -0.2719385447924889 -0.2719385447924889 0.1030869129538831 
+16 -0.10252843245959192 -0.2603442689872443 -0.2603442689872443 0.12856285935831635 
+16.1 -0.12787945495814879 -0.24627704739624115 -0.24627704739624115 0.1525071596977729 
+16.2 -0.15170686872799966 -0.22990134083106956 -0.22990134083106956 0.17469700281110662 
+16.3 -0.17378894470708997 -0.2114029243650833 -0.2114029243650833 0.1949292371435983 
+16.400000000000002 -0.19392351200841992 -0.19098682829998226 -0.19098682829998226 0.21302219483841817 
+16.5 -0.21192977327108717 -0.1688751081870028 -0.1688751081870028 0.22881728408978744 
+16.6 -0.22764988945531314 -0.14530446777382783 -0.14530446777382783 0.24218033623269591 
+16.7 -0.24095032061968502 -0.12052375997299974 -0.12052375997299974 0.253002696616985 
+16.8 -0.25172291177878936 -0.09479139191060769 -0.09479139191060769 0.2612020509698501 
+16.900000000000002 -0.2598857155822653 -0.06837266080835365 -0.06837266080835365 0.26672298166310066 
+17 -0.26538354625497484 -0.04153704787355036 -0.04153704787355036 0.2695372510423299 
+17.1 -0.26818826196508116 -0.014555497518692347 -0.014555497518692347 0.2696438117169504 
+17.2 -0.26829877551495357 0.01230229089376164 0.01230229089376164 0.2670685464255774 
+17.3 -0.2657407959518451 0.03877053198088205 0.03877053198088205 0.26186374275375685 
+17.400000000000002 -0.2605663063446096 0.06458995780016581 0.06458995780016581 0.254107310564593 
+17.5 -0.2528527855435304 0.08951033060997236 0.08951033060997236 0.24390175248253318 
+17.6 -0.24270218420784057 0.11329284085042739 0.11329284085042739 0.23137290012279782 
+17.7 -0.23023966772621782 0.1357123676464378 0.1357123676464378 0.21666843096157404 
+17.8 -0.21561214084744132 0.15655958072710255 0.15655958072710255 0.19995618277473107 
+17.900000000000002 -0.19898657086116858 0.17564286444242797 0.17564286444242797 0.1814222844169258 
+18 -0.1805481280040249 0.1927900465191705 0.1927900465191705 0.16126912335210786 
+18.1 -0.1604981633974969 0.20784991631160718 0.20784991631160718 0.13971317176633619 
+18.2 -0.13905204623731046 0.2206935195475312 0.2206935195475312 0.11698269428255734 
+18.3 -0.11643688313716001 0.23121521892117697 0.23121521892117697 0.0933153612450423 
+18.400000000000002 -0.09288914347337919 0.23933351231865155 0.23933351231865155 0.06895579224151403 
+18.5 -0.06865221527438982 0.2449916029527726 0.2449916029527726 0.04415305497911255 
+18.6 -0.0439739166450678 0.24815771820764532 0.24815771820764532 0.019158144824303266 
+18.7 -0.019103987909573995 0.248825176523444 0.248825176523444 -0.005778529742770405 
+18.8 0.005708410402687252 0.24701220416348035 0.24701220416348035 -0.030409630819035288 
+18.900000000000002 0.03021716891140556 0.2427615061739504 0.2427615061739504 -0.0544933195288006 
+19 0.05418164581516589 0.23613959824764916 0.23613959824764916 -0.0777956056399308 
+19.1 0.0773690049193735 0.22723590851322764 0.22723590851322764 -0.10009259577069626 
+19.200000000000003 0.09955645279802779 0.21616166046917165 0.21616166046917165 -0.12117261884494496 
+19.3 0.12053335385139219 0.20304855034588257 0.20304855034588257 -0.14083820888598045 
+19.400000000000002 0.14010320344687266 0.18804723409083793 0.18804723409083793 -0.15890792685595645 
+19.5 0.1580854409384761 0.17132564091332225 0.17132564091332225 -0.17521800502980833 
+19.6 0.1743170861332663 0.1530671318810196 0.1530671318810196 -0.18962379932136825 
+19.700000000000003 0.1886541846920012 0.13346852341723528 0.13346852341723528 -0.20200103703372474 
+19.8 0.20097304999509186 0.11273799669315458 0.11273799669315458 -0.21224684966440732 
+19.900000000000002 0.21117129115260821 0.0910929148350684 0.0910929148350684 -0.22028058263611505 
@@ -0,0 +1,406 @@
+# DampedOscillator testing data, parameter set 1
+# Seed = 1
+$ k 4
+$ c 0.4
+Time x v 
+d(x) d(v) 
+0 -1.1872524934107087 -0.278257166286188 -0.278257166286188 4.86031284015731 
+0.1 -1.190993769559932 0.20048543034580374 0.20048543034580374 4.683780906101406 
+0.2 -1.1480442681764778 0.6526212834389606 0.6526212834389606 4.331128559330327 
+0.30000000000000004 -1.0619132289048074 1.061524172677094 1.061524172677094 3.823043246548392 
+0.4 -0.9376598282128377 1.4129093469693033 1.4129093469693033 3.1854755740636294 
+0.5 -0.7816346926991096 1.6952999627231717 1.6952999627231717 2.4484187857071698 
+0.6000000000000001 -0.6011810778898355 1.9003654996842225 1.9003654996842225 1.6445781116856533 
+0.7000000000000001 -0.40430897200810356 2.023123948869674 2.023123948869674 0.8079863084845447 
+0.8 -0.1993559223788544 2.062005212657211 2.062005212657211 -0.027378395547466794 
+0.9 0.0053516400009961836 2.018778681300201 2.018778681300201 -0.8289180325240652 
+1 0.20182336181246083 1.8983531419002353 1.8983531419002353 -1.5666347040099375 
+1.1 0.38270405291975346 1.7084618453175917 1.7084618453175917 -2.2142009498060506 
+1.2000000000000002 0.5415362223648423 1.459249541637876 1.459249541637876 -2.7498447061145197 
+1.3 0.6729772380618342 1.16278146673176 1.16278146673176 -3.157021538940041 
+1.4000000000000001 0.772964402812574 0.8324965290723808 0.8324965290723808 -3.424856222879248 
+1.5 0.8388235376969866 0.48262825422872363 0.48262825422872363 -3.548345452479436 
+1.6 0.8693190445028078 0.12761738078492174 0.12761738078492174 -3.5283231303252 
+1.7000000000000002 0.8646457853927982 -0.21846060918932952 -0.21846060918932952 -3.3711988978954612 
+1.8 0.8263653785310738 -0.5424312385792546 -0.5424312385792546 -3.0884890186925933 
+1.9000000000000001 0.7572915787192102 -0.8325005653622481 -0.8325005653622481 -2.696166088731941 
+2 0.6613312187763523 -1.0786619587378106 -1.0786619587378106 -2.2138600916102846 
+2.1 0.5432886701681475 -1.2730170771495706 -1.2730170771495706 -1.6639478498127618 
+2.2 0.4086428950009626 -1.4100018685584903 -1.4100018685584903 -1.0705708325804544 
+2.3000000000000003 0.2633068769055274 -1.4865124849990936 -1.4865124849990936 -0.4586225136224721 
+2.4000000000000004 0.1133795231934761 -1.5019301150903444 -1.5019301150903444 0.14725395326223334 
+2.5 -0.035099970834820166 -1.4580477275293997 -1.4580477275293997 0.7236189743510406 
+2.6 -0.17638579252164588 -1.3589054345605325 -1.3589054345605325 1.2491053439107964 
+2.7 -0.3052385547224922 -1.2105444924828717 -1.2105444924828717 1.7051720158831174 
+2.8000000000000003 -0.41711019401867444 -1.0206927431534163 -1.0206927431534163 2.0767178733360643 
+2.9000000000000004 -0.5082946020109321 -0.7983964776798832 -0.7983964776798832 2.352536999115682 
+3 -0.5760394466426628 -0.5536152098996816 -0.5536152098996816 2.525603870530524 
+3.1 -0.6186163353320905 -0.2967966502403362 -0.2967966502403362 2.5931840014244965 
+3.2 -0.6353482113766274 -0.03844926635254812 -0.03844926635254812 2.556772552047529 
+3.3000000000000003 -0.6265945898843194 0.21127077051403945 0.21127077051403945 2.4218700513316618 
+3.4000000000000004 -0.5936968633484918 0.44294267531469217 0.44294267531469217 2.19761038326809 
+3.5 -0.5388873800174099 0.6482203789277263 0.6482203789277263 1.896261368498549 
+3.6 -0.4651672687686057 0.8201165526266607 0.8201165526266607 1.5326224540237585 
+3.7 -0.3761590105633134 0.953222396169336 0.953222396169336 1.1233470837855193 
+3.8000000000000003 -0.27594050819471183 1.0438571189220474 1.0438571189220474 0.6862191852100283 
+3.9000000000000004 -0.16886786435253806 1.0901440290664082 1.0901440290664082 0.23941384578358893 
+4 -0.0593942365970268 1.0920131350581903 1.0920131350581903 -0.19922830763516894 
+4.1000000000000005 0.048107997777010005 1.051133039168825 1.051133039168825 -0.61288520677557 
+4.2 0.14951494696500633 0.9707775572095326 0.9707775572095326 -0.9863708107438385 
+4.3 0.24110262323955167 0.855634836367101 0.855634836367101 -1.3066644275050472 
+4.4 0.3196767960636857 0.7115686855145402 0.7115686855145402 -1.563334658460559 
+4.5 0.3826770506604797 0.5453433189924344 0.5453433189924344 -1.7488455302388926 
+4.6000000000000005 0.428251996844726 0.3643237054623925 0.3643237054623925 -1.858737469563861 
+4.7 0.45530382016724297 0.17616418874914289 0.17616418874914289 -1.891680956168629 
+4.800000000000001 0.4635016364165598 -0.011501990757968407 -0.011501990757968407 -1.8494057493630518 
+4.9 0.4532643508214127 -0.19136017594055052 -0.19136017594055052 -1.7365133329094307 
+5 0.4257148875548227 -0.35668765818975706 -0.35668765818975706 -1.5601844869433878 
+5.1000000000000005 0.3826087008389315 -0.5015931904712543 -0.5015931904712543 -1.3297975271672242 
+5.2 0.3262403698720811 -0.621214615399951 -0.621214615399951 -1.056475633328344 
+5.300000000000001 0.25933278719741243 -0.7118685273460613 -0.7118685273460613 -0.7525837378512252 
+5.4 0.18491395355650114 -0.7711480005444526 -0.7711480005444526 -0.4311966140082235 
+5.5 0.10618668004631793 -0.7979666003503261 -0.7979666003503261 -0.10556008004514128 
+5.6000000000000005 0.026396567732589332 -0.7925490639476929 -0.7925490639476929 0.21143335464871982 
+5.7 -0.05129650837701076 -0.7563711058295564 -0.7563711058295564 0.5077344758398656 
+5.800000000000001 -0.12393852599673286 -0.692052695300053 -0.692052695300053 0.7725751821069526 
+5.9 -0.18888868726090044 -0.6032108011057676 -0.6032108011057676 0.9968390694859088 
+6 -0.24391033949580862 -0.49427894725799726 -0.49427894725799726 1.1733529368864333 
+6.1000000000000005 -0.28724253692832774 -0.37030193316282456 -0.37030193316282456 1.2970909209784407 
+6.2 -0.31765020577675246 -0.2367147142252267 -0.2367147142252267 1.3652867087971006 
+6.300000000000001 -0.33445179124369107 -0.09911470535797809 -0.09911470535797809 1.3774530471179556 
+6.4 -0.33752418891274816 0.036963336325878796 0.036963336325878796 1.3353114211206412 
+6.5 -0.32728565852110164 0.16626115317563112 0.16626115317563112 1.242638172814154 
+6.6000000000000005 -0.3046582511617474 0.28399163820493767 0.28399163820493767 1.1050363493650144 
+6.7 -0.2710120215516297 0.3860074413259509 0.3860074413259509 0.9296451096761383 
+6.800000000000001 -0.22809391977003307 0.46893796271552596 0.46893796271552596 0.7248004939939219 
+6.9 -0.17794474210725436 0.5302906191660378 0.5302906191660378 0.4996627207626023 
+7 -0.1228078547964021 0.5685138268044205 0.5685138268044205 0.2638258884638402 
+7.1000000000000005 -0.0650335802980816 0.5830207399351348 0.5830207399351348 0.026926025218272448 
+7.2 -0.006983152799183493 0.5741743570665934 0.5741743570665934 -0.20173713162990337 
+7.300000000000001 0.04906398676025311 0.543236090992472 0.543236090992472 -0.41355038343800127 
+7.4 0.10099606416313656 0.49228124540589346 0.49228124540589346 -0.6008967548149036 
+7.5 0.146944925822625 0.4240859988368908 0.4240859988368908 -0.7574141028252563 
+7.6000000000000005 0.18534949379101842 0.34199142979882263 0.34199142979882263 -0.8781945470836028 
+7.7 0.21500473213498947 0.24975079727305532 0.24975079727305532 -0.95991924744918 
+7.800000000000001 0.2350947791768699 0.15136670138371708 0.15136670138371708 -1.0009257972609664 
+7.9 0.24520957165806187 0.050924884832722325 0.050924884832722325 -1.0012082405653364 
+8 0.24534495844264964 -0.047568698141274984 -0.047568698141274984 -0.9623523545140886 
+8.1 0.23588694670205432 -0.14034130605041026 -0.14034130605041026 -0.8874112643880532 
+8.200000000000001 0.21758131841472478 -0.223991704629997 -0.223991704629997 -0.7807285918069002 
+8.3 0.19149037820731513 -0.2956085201912783 -0.2956085201912783 -0.6477181047527492 
+8.4 0.15893902742060884 -0.35286480850367535 -0.35286480850367535 -0.4946101862809652 
+8.5 0.12145269035237395 -0.39408607605358364 -0.39408607605358364 -0.3281763309880623 
+8.6 0.08068983800255491 -0.41829013635363754 -0.41829013635363754 -0.15544329746876462 
+8.700000000000001 0.03837195813026453 -0.42519834807988477 -0.42519834807988477 0.016591506710895793 
+8.8 -0.003786191473148081 -0.415218917984628 -0.415218917984628 0.18123233308644354 
+8.9 -0.04414333189538033 -0.3894040164635184 -0.3894040164635184 0.3323349341669287 
+9 -0.08119292319495146 -0.34938340852261157 -0.34938340852261157 0.4645250561888504 
+9.1 -0.11361675684653753 -0.29727811469395016 -0.29727811469395016 0.5733782732637303 
+9.200000000000001 -0.14032908679786096 -0.2355982590711568 -0.2355982590711568 0.6555556508199065 
+9.3 -0.16050994548116548 -0.1671297165994269 -0.1671297165994269 0.7088916685644326 
+9.4 -0.173626766852325 -0.09481442863547972 -0.09481442863547972 0.7324328388634919 
+9.5 -0.17944392991643088 -0.02162931232043958 -0.02162931232043958 0.7264274445938993 
+9.600000000000001 -0.17802032342302543 0.04953144887084017 0.04953144887084017 0.6922687141437657 
+9.700000000000001 -0.16969549659943528 0.11596626589220531 0.11596626589220531 0.632395480040859 
+9.8 -0.15506538469989586 0.17526417058923976 0.17526417058923976 0.5501558705638876 
+9.9 -0.13494896679421095 0.2253876410146747 0.2253876410146747 0.4496408107709739 
+10 -0.11034751436275535 0.26473758482533816 0.26473758482533816 0.3354950235208861 
+10.100000000000001 -0.08239831378285195 0.29219864079874486 0.29219864079874486 0.21271379881190985 
+10.200000000000001 -0.05232488791526356 0.3071637998219665 0.3071637998219665 0.08643403173226763 
+10.3 -0.02138579940526291 0.3095381940587246 0.3095381940587246 -0.038272080002438216 
+10.4 0.009175907921088926 0.29972272304132763 0.29972272304132763 -0.15659272090088677 
+10.5 0.03818067875344708 0.2785789471858576 0.2785789471858576 -0.26415429388813133 
+10.600000000000001 0.06455605394148317 0.24737735520815085 0.24737735520815085 -0.357175157849193 
+10.700000000000001 0.08737438293716225 0.20773167907051576 0.20773167907051576 -0.43259020337685533 
+10.8 0.10588339838555155 0.16152237051760915 0.16152237051760915 -0.48814254174924987 
+10.9 0.11952873788719355 0.11081265472140853 0.11081265472140853 -0.5224400134373376 
+11 0.12796784853666515 0.057760732717613596 0.057760732717613596 -0.534975687233706 
+11.100000000000001 0.13107506873362473 0.004531714773514403 0.004531714773514403 -0.5261129608439047 
+11.200000000000001 0.12893803461307818 -0.0467872631151463 -0.0467872631151463 -0.4970372332062542 
+11.3 0.12184589169824338 -0.09426554716018737 -0.09426554716018737 -0.4496773479288986 
+11.4 0.1102700937868669 -0.13619828004643564 -0.13619828004643564 -0.38660106312889336 
+11.5 0.09483883010994713 -0.17116417321155702 -0.17116417321155702 -0.3108896511551657 
+11.600000000000001 0.0763063299894468 -0.1980699319651935 -0.1980699319651935 -0.22599734717170977 
+11.700000000000001 0.0555184454262279 -0.21618012066178224 -0.21618012066178224 -0.1356017334401987 
+11.8 0.033376002596371954 -0.22513187169866933 -0.22513187169866933 -0.04345126170602008 
+11.9 0.010797441963100295 -0.2249344577934426 -0.2249344577934426 0.04678401526497586 
+12 -0.011317765076134294 -0.2159543377752078 -0.2159543377752078 0.1316527954146203 
+12.100000000000001 -0.03212352377699662 -0.1988868298924937 -0.1988868298924937 0.20804882706498395 
+12.200000000000001 -0.05085808853327642 -0.17471604398851626 -0.17471604398851626 0.27331877172851216 
+12.3 -0.06687052454599751 -0.14466509877224937 -0.14466509877224937 0.3253481376928898 
+12.4 -0.07964180142525426 -0.11013895062300552 -0.11013895062300552 0.36262278595021924 
+12.5 -0.08879990487097239 -0.07266235788684344 -0.07266235788684344 0.38426456263862696 
+12.600000000000001 -0.09412861042203735 -0.03381559576245728 -0.03381559576245728 0.3900406799931323 
+12.700000000000001 -0.09556982490920617 0.004829477758199061 0.004829477758199061 0.38034750853354504 
+12.8 -0.09321965632167846 0.04177052011423092 0.04177052011423092 0.3561704172410215 
+12.9 -0.08731861158950054 0.07563069003891022 0.07563069003891022 0.31902217034243807 
+13 -0.07823653560053637 0.10520753359054609 0.10520753359054609 0.270863128965927 
+13.100000000000001 -0.06645308623491289 0.12951313827165 0.12951313827165 0.21400708963099155 
+13.200000000000001 -0.052534683488563605 0.14780432839510504 0.14780432839510504 0.1510170025962124 
+13.3 -0.03710897176790719 0.15960211214961567 0.15960211214961567 0.08459504221178248 
+13.4 -0.020837890880071326 0.16470004181930617 0.16470004181930617 0.01747154679256284 
+13.5 -0.004390462659174736 0.1631615951612225 0.1631615951612225 -0.04770278742779005 
+13.600000000000001 0.011583632121624693 0.1553071107631536 0.1553071107631536 -0.10845737279176021 
+13.700000000000001 0.026478684924621663 0.1416911975427532 0.1416911975427532 -0.16259121871558793 
+13.8 0.03975490638332804 0.12307187473982116 0.12307187473982116 -0.20824837542924063 
+13.9 0.05095693656296459 0.10037297266397192 0.10037297266397192 -0.24397693531744713 
+14 0.05972832991798804 0.0746415278274033 0.0746415278274033 -0.2687699308029135 
+14.100000000000001 0.06582160670837064 0.0470020337354988 0.0470020337354988 -0.2820872403276821 
+14.200000000000001 0.06910365190655435 0.018609458507456177 0.018609458507456177 -0.28385839102919985 
+14.3 0.06955643303309803 -0.009397086246670537 -0.009397086246670537 -0.2744668976337239 
+14.4 0.06727319241540447 -0.03593824020666801 -0.03593824020666801 -0.2547174735789507 
+14.5 0.06245044012660327 -0.060034232901313135 -0.060034232901313135 -0.22578806734588783 
+14.600000000000001 0.05537622517851554 -0.08083926820034994 -0.08083926820034994 -0.18916919343392216 
+14.700000000000001 0.0464152892626517 -0.09766932484912336 -0.09766932484912336 -0.14659342711095744 
+14.8 0.03599180546862538 -0.11002254476062008 -0.11002254476062008 -0.0999582039702535 
+14.9 0.024570471245887432 -0.11759170292790623 -0.11759170292790623 -0.05124520381238723 
+15 0.012636759027567716 -0.12026858211643221 -0.12026858211643221 -0.0024396032636979786 
+15.100000000000001 0.0006771293513072459 -0.11814039879118933 -0.11814039879118933 0.04454764211124675 
+15.200000000000001 -0.010840018780445342 -0.11147873162915266 -0.11147873162915266 0.08795156777344243 
+15.3 -0.021481944747630315 -0.10072167926898273 -0.10072167926898273 0.12621645069811435 
+15.4 -0.03086707964664423 -0.08645021005918146 -0.08645021005918146 0.1580484026102495 
+15.5 -0.03867793154533424 -0.06935985579240467 -0.06935985579240467 0.18245566849829883 
+15.600000000000001 -0.04467098058532555 -0.050229038200740796 -0.050229038200740796 0.19877553762159852 
+15.700000000000001 -0.04868329526049535 -0.029885398045006818 -0.029885398045006818 0.20668734025998411 
+15.8 -0.05063573987171038 -0.009171520977889319 -0.009171520977889319 0.20621156787799724 
+15.9 -0.050532781472269576 0.01108857673171362 0.01108857673171362 0.19769569519639285 
+16 -0.04845903718450676 0.03012092266861724 0.03012092266861724 0.18178777967058016 
+16.1 -0.04457282463042078 0.04722987289403404 0.04722987289403404 0.15939934936406952 
+16.2 -0.039097085035318634 0.06182222643262838 0.06182222643262838 0.13165944956822317 
+16.3 -0.03230813674887662 0.07342639074226288 0.07342639074226288 0.09986199069860133 
+16.400000000000002 -0.02452278376697646 0.0817060432329225 0.0817060432329225 0.06540871777473684 
+16.5 -0.016084347555095403 0.08646797075515528 0.08646797075515528 0.0297502019183195 
+16.6 -0.007348210268051989 0.08766400897561413 0.08766400897561413 -0.005672762518037697 
+16.7 0.0013325465039696282 0.0853872371955675 0.0853872371955675 -0.03948508089410552 
+16.8 0.009620849970426023 0.07986280256631172 0.07986280256631172 -0.07042852090822878 
+16.900000000000002 0.01720818124336685 0.07143394287584033 0.07143394287584033 -0.09740630212380354 
+17 0.023825512652311684 0.060543942403490106 0.060543942403490106 -0.11951962757064277 
+17.1 0.029252272882658487 0.0477148854751079 0.0477148854751079 -0.1360950457206771 
+17.2 0.03332306690983219 0.03352416359453553 0.03352416359453553 -0.14670193307714297 
+17.3 0.03593197614961943 0.018579742337104327 0.018579742337104327 -0.15115980153331945 
+17.400000000000002 0.0370343656557371 0.0034952032062712203 0.0034952032062712203 -0.1495355439054569 
+17.5 0.03664622548936327 -0.011134455357628316 -0.011134455357628316 -0.14213111981440174 
+17.6 0.03484116868643311 -0.024755341885829094 -0.024755341885829094 -0.1294625379914008 
+17.7 0.03174529502836279 -0.03687470615255788 -0.03687470615255788 -0.11223129765242801 
+17.8 0.027530205023272986 -0.04707779848943284 -0.04707779848943284 -0.09128970069731882 
+17.900000000000002 0.02240450964286424 -0.05504101977206326 -0.05504101977206326 -0.06760163066263165 
+18 0.016604226597001157 -0.06054099362171565 -0.06054099362171565 -0.042200508939318364 
+18.1 0.010382482119426065 -0.06345936617230716 -0.06345936617230716 -0.016146182008781394 
+18.2 0.00399894795049029 -0.06378331335221551 -0.06378331335221551 0.009517533538925049 
+18.3 -0.002290563296922952 -0.06160190416706461 -0.06160190416706461 0.03380301485451766 
+18.400000000000002 -0.008243943985098248 -0.0570986245950518 -0.0570986245950518 0.05581522577841371 
+18.5 -0.013641715647049772 -0.05054050480579531 -0.05054050480579531 0.07478306451051722 
+18.6 -0.018294718910553818 -0.04226440779697511 -0.04226440779697511 0.09008463876100531 
+18.7 -0.02205031702461872 -0.03266112659174751 -0.03266112659174751 0.10126571873517388 
+18.8 -0.024796928836832936 -0.022157997406599923 -0.022157997406599923 0.10805091430997171 
+18.900000000000002 -0.026466779575838685 -0.01120076645731146 -0.01120076645731146 0.11034742488627933 
+19 -0.027036831819111685 -0.00023544831417317454 -0.00023544831417317454 0.10824150660211601 
+19.1 -0.026527931619746585 0.010309114886033734 0.010309114886033734 0.10198808052457285 
+19.200000000000003 -0.025002273146260457 0.020037339265655384 0.020037339265655384 0.09199415687877968 
+19.3 -0.022559346849430638 0.028601054563561407 0.028601054563561407 0.07879696557229798 
+19.400000000000002 -0.019330588963709903 0.03571125152717721 0.03571125152717721 0.06303785524396872 
+19.5 -0.015472992362298314 0.04114705666831462 0.04114705666831462 0.04543314678186741 
+19.6 -0.011161969179794248 0.04476169236563529 0.04476169236563529 0.026743199772922874 
+19.700000000000003 -0.006583773470004551 0.046485307598973465 0.046485307598973465 0.007740970840428817 
+19.8 -0.0019277972729914233 0.04632469139459656 0.04632469139459656 -0.010818687465872931 
+19.900000000000002 0.002620953874968153 0.04436000241062508 0.04436000241062508 -0.028227816464122646 
+0 0.28269310428409034 0.7249828748957727 0.7249828748957727 -1.4207655670946704 
+0.1 0.3477270411122524 0.5723761986318213 0.5723761986318213 -1.6198586439017382 
+0.2 0.39662177535664106 0.40338483295687994 0.40338483295687994 -1.7478410346093163 
+0.30000000000000004 0.4280993261989308 0.22525541946858904 0.22525541946858904 -1.802499472583159 
+0.4 0.441612487328384 0.04530757012719018 0.04530757012719018 -1.7845729773644121 
+0.5 0.4373375577063309 -0.12935504465594405 -0.12935504465594405 -1.6976082129629462 
+0.6000000000000001 0.41613908274900435 -0.2921138405281511 -0.2921138405281511 -1.547710794784757 
+0.7000000000000001 0.3795090813317742 -0.4370749532173187 -0.4370749532173187 -1.3432063440401691 
+0.8 0.32948413405092486 -0.5592711542821809 -0.5592711542821809 -1.094228074490827 
+0.9 0.2685444410092341 -0.654819636670748 -0.654819636670748 -0.8122499093686371 
+1 0.19949950093585805 -0.7210312341910495 -0.7210312341910495 -0.5095855100670124 
+1.1 0.12536540388967324 -0.7564687108968112 -0.7564687108968112 -0.19887413119996844 
+1.2000000000000002 0.04923886178780307 -0.7609538394339315 -0.7609538394339315 0.10742608862236036 
+1.3 -0.02582697243742279 -0.7355249997392767 -0.7355249997392767 0.3975178896454018 
+1.4000000000000001 -0.09694011419432118 -0.6823488952609181 -0.6823488952609181 0.660700014881652 
+1.5 -0.16147644659429528 -0.6045916359108527 -0.6045916359108527 0.8877424407415222 
+1.6 -0.21717176779284028 -0.5062558190863208 -0.5062558190863208 1.0711893988058894 
+1.7000000000000002 -0.2621961636096421 -0.3919913088741489 -0.3919913088741489 1.205581177988228 
+1.8 -0.2952084919787757 -0.2668881395027094 -0.2668881395027094 1.2875892237161866 
+1.9000000000000001 -0.31538962980099106 -0.1362603373004251 -0.1362603373004251 1.3160626541241343 
+2 -0.3224540156480065 -0.005429465497024876 -0.005429465497024876 1.291987848790836 
+2.1 -0.31663988826131395 0.12048363818788901 0.12048363818788901 1.2183660977701003 
+2.2 -0.298679437675159 0.23675111351010397 0.23675111351010397 1.1000173052965942 
+2.3000000000000003 -0.26975082309616866 0.3392074337846378 0.3392074337846378 0.9433203188708195 
+2.4000000000000004 -0.2314146436972784 0.4243901454065646 0.4243901454065646 0.7559025166264878 
+2.5 -0.18553795465959844 0.48964761277469115 0.48964761277469115 0.5462927735285172 
+2.6 -0.13420928627588735 0.5332108516791839 0.5332108516791839 0.32355280443187584 
+2.7 -0.07964833994717054 0.5542280520131987 0.5542280520131987 0.09690213898340266 
+2.8000000000000003 -0.02411409887825629 0.5527619036322002 0.5527619036322002 -0.12464836593985494 
+2.9000000000000004 0.030184993329931603 0.5297512883759241 0.5297512883759241 -0.3326404886700961 
+3 0.08117535705070664 0.4869402325528967 0.4869402325528967 -0.5194775212239853 
+3.1 0.126994024107101 0.4267781801626317 0.4267781801626317 -0.6786873684934567 
+3.2 0.16605318444322414 0.35229660871157287 0.35229660871157287 -0.8051313812575257 
+3.3000000000000003 0.1970914486950343 0.2669677371013639 0.2669677371013639 -0.8951528896206828 
+3.4000000000000004 0.2192103441944169 0.17455154947021245 0.17455154947021245 -0.9466619965657526 
+3.5 0.23189519714675572 0.07893757143924261 0.07893757143924261 -0.9591558171627199 
+3.6 0.23502019713262676 -0.01601221208495174 -0.01601221208495174 -0.9336759036965263 
+3.7 0.22883806623264083 -0.10661320353460121 -0.10661320353460121 -0.8727069835167228 
+3.8000000000000003 0.21395534016777173 -0.18949523143123337 -0.18949523143123337 -0.7800232680985936 
+3.9000000000000004 0.19129479163004606 -0.2617219279868648 -0.2617219279868648 -0.6604903953254383 
+4 0.16204696846312852 -0.32088847277846366 -0.32088847277846366 -0.5198324847411286 
+4.1000000000000005 0.12761316739531176 -0.36519473094771215 -0.36519473094771215 -0.36437477720216216 
+4.2 0.08954240773029967 -0.3934918924770072 -0.3934918924770072 -0.20077287393039578 
+4.3 0.04946510333126754 -0.40530182891474903 -0.40530182891474903 -0.03573968175917053 
+4.4 0.00902615446125566 -0.400809480852493 -0.400809480852493 0.12421917449597458 
+4.5 -0.03017990299902549 -0.3808296311309752 -0.3808296311309752 0.27305146444849204 
+4.6000000000000005 -0.06666923725596381 -0.3467503666292632 -0.3467503666292632 0.4053770956755605 
+4.7 -0.09912236803439776 -0.3004563522061008 -0.3004563522061008 0.5166720130200314 
+4.800000000000001 -0.12642928759976835 -0.24423570682745102 -0.24423570682745102 0.6034114331300537 
+4.9 -0.14772462636516293 -0.18067476413204253 -0.18067476413204253 0.6631684111134687 
+5 -0.16241187916745073 -0.11254530525384084 -0.11254530525384084 0.6946656387713392 
+5.1000000000000005 -0.17017617438605215 -0.042688966013883646 -0.042688966013883646 0.6977802839497621 
+5.2 -0.1709855361282773 0.02609655335279441 0.02609655335279441 0.6735035231719915 
+5.300000000000001 -0.1650810413482954 0.09116509968553287 0.09116509968553287 0.6238581255189685 
+5.4 -0.152956691995763 0.15011953641443 0.15011953641443 0.55177895341728 
+5.5 -0.13533019207073035 0.2008956596648892 0.2008956596648892 0.46096250441696573 
+5.6000000000000005 -0.11310612824231094 0.24182981294277456 0.24182981294277456 0.3556925877921339 
+5.7 -0.08733329079440415 0.2717081972036029 0.2717081972036029 0.24064988429617543 
+5.800000000000001 -0.05915803255853162 0.2897966670007234 0.2897966670007234 0.12071346343383714 
+5.9 -0.02977564392114151 0.29585061342970703 0.29585061342970703 0.000762330312683232 
+6 -0.00038172196270265697 0.29010532845100373 0.29010532845100373 -0.11451524352959086 
+6.1000000000000005 0.0278745655454958 0.2732479924895884 0.2732479924895884 -0.22079745917781857 
+6.2 0.053933569140560744 0.24637310059883774 0.24637310059883774 -0.3142835168017781 
+6.300000000000001 0.07686305874463573 0.2109237185189607 0.2109237185189607 -0.39182172238612717 
+6.4 0.09588965906485954 0.16862141996314206 0.16862141996314206 -0.451007204244695 
+6.5 0.11042284434287197 0.12138808700022266 0.12138808700022266 -0.49024661217157695 
+6.6000000000000005 0.12007084649025583 0.0712629486303613 0.0712629486303613 -0.5087885654131679 
+6.7 0.12464817200007197 0.020318286402293863 0.020318286402293863 -0.5067200025612054 
+6.800000000000001 0.12417476289790357 -0.029422846434209386 -0.029422846434209386 -0.4849299130179305 
+6.9 0.11886716216436932 -0.07606375565400608 -0.07606375565400608 -0.44504314639587483 
+7 0.1091223423938716 -0.11790327432795475 -0.11790327432795475 -0.3893280598443045 
+7.1000000000000005 0.09549511734480695 -0.1534945777537246 -0.1534945777537246 -0.320582638277738 
+7.2 0.07867027075710444 -0.18169175251668654 -0.18169175251668654 -0.24200438202174313 
+7.300000000000001 0.05943069878282955 -0.20168277992770942 -0.20168277992770942 -0.15704968316023443 
+7.4 0.0386229673332956 -0.21300817754380724 -0.21300817754380724 -0.0692885983156595 
+7.5 0.01712173172538749 -0.21556513261494037 -0.21556513261494037 0.017739126144426187 
+7.6000000000000005 -0.0042055462814952664 -0.20959753479142815 -0.20959753479142815 0.10066119904255233 
+7.7 -0.02453221685924511 -0.19567285051202765 -0.19567285051202765 0.17639800764179153 
+7.800000000000001 -0.043103147357804274 -0.17464725876751427 -0.17464725876751427 0.2422714929382228 
+7.9 -0.059261428688054375 -0.14762087101344382 -0.14762087101344382 0.296094063157595 
+8 -0.07247019595211346 -0.11588517404560945 -0.11588517404560945 0.3362348534266976 
+8.1 -0.08232890214310994 -0.08086505471453306 -0.08086505471453306 0.36166163045825295 
+8.200000000000001 -0.08858362643590743 -0.044057884604392965 -0.044057884604392965 0.3719576595853869 
+8.3 -0.09113124812743609 -0.0069721605298031526 -0.0069721605298031526 0.3673138567216656 
+8.4 -0.09001756364020734 0.028931883801299213 0.028931883801299213 0.3484975010403097 
+8.5 -0.08542965767247186 0.06229745135080344 0.06229745135080344 0.31679965014956607 
+8.6 -0.07768305192888231 0.0919201496183998 0.0919201496183998 0.2739641478681693 
+8.700000000000001 -0.06720433843373191 0.11678908247709746 0.11678908247709746 0.22210172074408868 
+8.8 -0.05451015316957015 0.1361187689295013 0.1361187689295013 0.16359310510648006 
+8.9 -0.04018345525005973 0.14937100108026707 0.14937100108026707 0.10098542056813209 
+9 -0.024848144289378576 0.1562661816033461 0.1562661816033461 0.036886104516175855 
+9.1 -0.009143073082542471 0.15678411015029423 0.15678411015029423 -0.026141351729947804 
+9.200000000000001 0.006303505107752176 0.15115460167971448 0.15115460167971448 -0.08567586110289449 
+9.3 0.020898073097967547 0.13983870203993395 0.13983870203993395 -0.13952777320784376 
+9.4 0.03410368243578916 0.12350160358760258 0.12350160358760258 -0.18581537117819766 
+9.5 0.04545871927358206 0.10297864474707145 0.10297864474707145 -0.22302633499315683 
+9.600000000000001 0.05459199034232719 0.079235993431571 0.079235993431571 -0.25006235874193716 
+9.700000000000001 0.06123368375654873 0.0533277592682842 0.0533277592682842 -0.2662658387335086 
+9.8 0.06522193805176535 0.02635135071172586 0.02635135071172586 -0.27142829249175177 
+9.9 0.06650493493990647 -0.0005971094900194904 -0.0005971094900194904 -0.2657808959636181 
+10 0.06513860951526376 -0.026465570399042158 -0.026465570399042158 -0.2499682099014382 
+10.100000000000001 0.06128023923843416 -0.0502854389962871 -0.0502854389962871 -0.2250067813552218 
+10.200000000000001 0.055178323836421225 -0.07120616040031634 -0.07120616040031634 -0.19223083118555837 
+10.3 0.04715929704802538 -0.08852382924157999 -0.08852382924157999 -0.15322765649546952 
+10.4 0.03761171374512052 -0.10170294810038373 -0.10170294810038373 -0.10976567574032858 
+10.5 0.026968629389621558 -0.11039075139547658 -0.11039075139547658 -0.06371821700029559 
+10.600000000000001 0.015688931317139075 -0.1144238264580192 -0.1144238264580192 -0.01698619468534862 
+10.700000000000001 0.004238392520900541 -0.11382707507079977 -0.11382707507079977 0.028577259944717746 
+10.8 -0.006928800764325655 -0.10880535639043444 -0.10880535639043444 0.0712373456134764 
+10.9 -0.017387344353572434 -0.09972842481453388 -0.09972842481453388 0.10944074734010328 
+11 -0.026756304533386142 -0.08711001433150388 -0.08711001433150388 0.14186922386614612 
+11.100000000000001 -0.03471226517419361 -0.07158211625705077 -0.07158211625705077 0.16748190719959474 
+11.200000000000001 -0.040999711547745 -0.05386564405439011 -0.05386564405439011 0.18554510381273606 
+11.3 -0.04543835340001062 -0.034738773322529407 -0.034738773322529407 0.19564892292905425 
+11.4 -0.04792722110708509 -0.015004285385001705 -0.015004285385001705 0.19771059858234105 
+11.5 -0.04844550136540095 0.0045427702378203795 0.0045427702378203795 0.19196489736647565 
+11.600000000000001 -0.04705020760213516 0.023145843224671576 0.023145843224671576 0.178942493118672 
+11.700000000000001 -0.043870900258650716 0.040114938892390574 0.040114938892390574 0.15943762547764664 
+11.8 -0.03910177895462117 0.054850973529447934 0.054850973529447934 0.1344667264067055 
+11.9 -0.032991558621384705 0.06686562193910192 0.06686562193910192 0.10521998570989805 
+12 -0.0258316121374148 0.07579605830245892 0.07579605830245892 0.07300802522867562 
+12.100000000000001 -0.017942910823569875 0.08141421452376098 0.08141421452376098 0.03920595748477511 
+12.200000000000001 -0.009662320300636464 0.08363040921402443 0.08363040921402443 0.005197117516936085 
+12.3 -0.0013288125441592628 0.08249142622264201 0.08249142622264201 -0.027681320312419752 
+12.4 0.00672986374408989 0.07817333544434031 0.07817333544434031 -0.058188789154095685 
+12.5 0.014209551496486835 0.07096954262294913 0.07096954262294913 -0.085226023035127 
+12.600000000000001 0.020840646707661975 0.06127472223979753 0.06127472223979753 -0.10787247572656691 
+12.700000000000001 0.02639727972393162 0.049565422816604456 0.049565422816604456 -0.12541528802236826 
+12.8 0.030704427389162903 0.036378233054828284 0.036378233054828284 -0.13736900277858294 
+12.9 0.03364275923643162 0.022286457720516375 0.022286457720516375 -0.14348562003393303 
+13 0.035151117185460705 0.007876273215640734 0.007876273215640734 -0.1437549780280991 
+13.100000000000001 0.035226624630737904 -0.006276684921152841 -0.006276684921152841 -0.13839582455449048 
+13.200000000000001 0.03392251361006561 -0.019629404559251114 -0.019629404559251114 -0.127838292616562 
+13.3 0.031343844443220706 -0.03169143872784517 -0.03169143872784517 -0.11269880228174475 
+13.4 0.027641367754830296 -0.04204201119207139 -0.04204201119207139 -0.09374866654249263 
+13.5 0.023003841591989864 -0.05034372857501801 -0.05034372857501801 -0.07187787493795225 
+13.600000000000001 0.01764916446054894 -0.056352496214607535 -0.056352496214607535 -0.04805565935635275 
+13.700000000000001 0.011814717229822917 -0.059923399096989925 -0.059923399096989925 -0.023289509280495696 
+13.8 0.005747322365159961 -0.06101247625096668 -0.06101247625096668 0.0014157010397468292 
+13.9 -0.0003067721132897264 -0.05967448027974224 -0.05967448027974224 0.025096880565055803 
+14 -0.006111493487873642 -0.056056867012599654 -0.056056867012599654 0.04686872075653443 
+14.100000000000001 -0.011449771094546519 -0.05039039798024387 -0.05039039798024387 0.06595524357028362 
+14.200000000000001 -0.016131274371171796 -0.042976855782502044 -0.042976855782502044 0.081715839797688 
+14.3 -0.0199988024884523 -0.03417446563736891 -0.03417446563736891 0.09366499620875676 
+14.4 -0.02293312872868109 -0.024381682779708185 -0.024381682779708185 0.10148518802660764 
+14.5 -0.02485617088401837 -0.014020043374611862 -0.014020043374611862 0.10503270088591822 
+14.600000000000001 -0.02573242941272673 -0.003516785852464493 -0.003516785852464493 0.10433643199189271 
+14.700000000000001 -0.025568705072348284 0.006712069173533525 0.006712069173533525 0.09958999261997972 
+14.8 -0.024412174527402953 0.016277537151218092 0.016277537151218092 0.09113768324912458 
+14.9 -0.022346963521668903 0.024831811020851875 0.024831811020851875 0.07945512967833486 
+15 -0.0194894104469326 0.03208023893515475 0.03208023893515475 0.0651255462136685 
+15.100000000000001 -0.015982256758403198 0.0377907550185735 0.0377907550185735 0.04881272502618339 
+15.200000000000001 -0.011988033354512878 0.04180049447913344 0.04180049447913344 0.031231935626398134 
+15.3 -0.0076819329083092315 0.0440194449571732 0.0440194449571732 0.013119953650367645 
+15.4 -0.0032444668561153224 0.04443110762693384 0.04443110762693384 -0.004794575626312247 
+15.5 0.001145797547682056 0.0430902594643953 0.0430902594643953 -0.021819293976486347 
+15.600000000000001 0.0053191394129896395 0.040118017796911126 0.040118017796911126 -0.03732376477072301 
+15.700000000000001 0.009120970041685736 0.035694505791265214 0.035694505791265214 -0.05076168248324903 
+15.8 0.012417280917856393 0.03004949958169436 0.03004949958169436 -0.061688923504103314 
+15.9 0.015099073805008167 0.023451501689483435 0.023451501689483435 -0.06977689589582604 
+16 0.017085639734052728 0.016195729451284117 0.016195729451284117 -0.07482085071672456 
+16.1 0.01832660387473136 0.008591530411933036 0.008591530411933036 -0.07674302766369866 
+16.2 0.018802704767231553 0.0009497389577209069 0.0009497389577209069 -0.07559071465201457 
+16.3 0.018525327088042078 -0.006429529402659229 -0.006429529402659229 -0.07152949659110462 
+16.400000000000002 0.017534855084195316 -0.013268186269949196 -0.013268186269949196 -0.06483214582880159 
+16.5 0.01589795726600034 -0.01932017436381217 -0.01932017436381217 -0.055863759318476494 
+16.6 0.013703950378527747 -0.0243798274922019 -0.0243798274922019 -0.04506387051723022 
+16.7 0.011060420849969532 -0.028288324236441297 -0.028288324236441297 -0.03292635370530161 
+16.8 0.008088303945580048 -0.030938057888237475 -0.030938057888237475 -0.019977992627025203 
+16.900000000000002 0.004916634197709845 -0.032274833566264975 -0.032274833566264975 -0.006756603364333388 
+17 0.001677185154767002 -0.03229789184642811 -0.03229789184642811 0.006210416119503237 
+17.1 -0.0015007877322208286 -0.031057843205947906 -0.031057843205947906 0.01842628821126248 
+17.2 -0.004495499681341731 -0.028652675921435604 -0.028652675921435604 0.02944306909394117 
+17.3 -0.007197103004398848 -0.025222068973390546 -0.025222068973390546 0.03887723960695161 
+17.400000000000002 -0.009511511898439039 -0.02094029867067101 -0.02094029867067101 0.04642216706202456 
+17.5 -0.01136346129001068 -0.01600807134534071 -0.01600807134534071 0.051857073698179004 
+17.6 -0.012698710225627277 -0.010643643405857156 -0.010643643405857156 0.05505229826485197 
+17.7 -0.0134853372426799 -0.005073603720326524 -0.005073603720326524 0.055970790458850214 
+17.8 -0.013714112701611578 0.00047630820514877887 0.00047630820514877887 0.0546659275243868 
+17.900000000000002 -0.01339796972146506 0.005789990830649972 0.005789990830649972 0.05127588255360025 
+18 -0.012570629738929116 0.0106690479284546 0.0106690479284546 0.04601489978433462 
+18.1 -0.011284469551772541 0.01493984767964238 0.01493984767964238 0.03916193913523321 
+18.2 -0.009607742944508788 0.01845933718556561 0.01845933718556561 0.03104723690380891 
+18.3 -0.007621290782454203 0.021119433963744483 0.021119433963744483 0.022037389544319018 
+18.400000000000002 -0.0054148882012652584 0.022849878605605792 0.022849878605605792 0.012519601362818716 
+18.5 -0.0030833858736107546 0.023619497452803333 0.023619497452803333 0.0028857445133216846 
+18.6 -0.0007228042267692866 0.023435888290746002 0.023435888290746002 -0.006483138409221255 
+18.7 0.0015734649059085232 0.022343603176821374 0.022343603176821374 -0.015231300894362643 
+18.8 0.003718204972615566 0.02042095834664524 0.02042095834664524 -0.023041203229120358 
+18.900000000000002 0.0056335409928659 0.017775649700357582 0.017775649700357582 -0.029644423851606632 
+19 0.0072536166263268784 0.014539392049796667 0.014539392049796667 -0.03483022332522618 
+19.1 0.008526696583385594 0.010861829903850607 0.010861829903850607 -0.038451518295082615 
+19.200000000000003 0.009416634747840696 0.0069039863221136265 0.0069039863221136265 -0.040428133520208236 
+19.3 0.00990367550821434 0.0028315239684860907 0.0028315239684860907 -0.040747311620251796 
+19.400000000000002 0.009984583126891145 -0.0011919109132649019 -0.0011919109132649019 -0.03946156814225862 
+19.5 0.009672120439347003 -0.005011004735722795 -0.005011004735722795 -0.03668407986309889 
+19.6 0.008993922769052875 -0.008484519500361436 -0.008484519500361436 -0.032581883276066925 
+19.700000000000003 0.007990834779432635 -0.011490260471952772 -0.011490260471952772 -0.027367234928949432 
+19.8 0.006714796317300421 -0.013929104856251144 -0.013929104856251144 -0.021287543326701224 
+19.900000000000002 0.005226377553390012 -0.015727970858671118 -0.015727970858671118 -0.014614321870091599 
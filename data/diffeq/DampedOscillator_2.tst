@@ -0,0 +1,406 @@
+# DampedOscillator testing data, parameter set 2
+# Seed = 1
+$ k 2
+$ c 1
+Time x v 
+d(x) d(v) 
+0 -1.3666868890194894 0.21450687909103072 0.21450687909103072 2.518866898947948 
+0.1 -1.3331412278977277 0.4513387966153753 0.4513387966153753 2.21494365918008 
+0.2 -1.2774570793917148 0.6570573692820928 0.6570573692820928 1.8978567895013367 
+0.30000000000000004 -1.2027992437111497 0.8307146013474177 0.8307146013474177 1.5748838860748817 
+0.4 -1.112391866158407 0.9720619026144666 0.9720619026144666 1.2527218297023472 
+0.5 -1.0094515282854597 1.0814882546136844 1.0814882546136844 0.937414801957235 
+0.6000000000000001 -0.8971268499865362 1.1599521466623366 1.1599521466623366 0.6343015533107359 
+0.7000000000000001 -0.7784451305389358 1.2089091679482535 1.2089091679482535 0.3479810931296181 
+0.8 -0.6562663726116087 1.2302370448317028 1.2302370448317028 0.08229570039151457 
+0.9 -0.5332448602408537 1.2261597908379074 1.2261597908379074 -0.15967007035619996 
+1 -0.4117983019681595 1.199172494991346 1.199172494991346 -0.3755758910550271 
+1.1 -0.29408440552557313 1.1519681168290525 1.1519681168290525 -0.5637993057779063 
+1.2000000000000002 -0.18198462196357745 1.0873674880870756 1.0873674880870756 -0.7233982441599207 
+1.3 -0.07709468583374972 1.0082535459303 1.0082535459303 -0.8540641742628005 
+1.4000000000000001 0.019278515559613053 0.9175106446637212 0.9175106446637212 -0.9560676757829474 
+1.5 0.10611428664265325 0.8179696157711402 0.8179696157711402 -1.0301981890564467 
+1.6 0.18267028288283688 0.7123590731600187 0.7123590731600187 -1.0776996389256923 
+1.7000000000000002 0.24847012734148133 0.6032632945429275 0.6032632945429275 -1.1002035492258901 
+1.8 0.3032871520642218 0.49308685344182146 0.49308685344182146 -1.099661157570265 
+1.9000000000000001 0.34712494200678784 0.3840260314294323 0.3840260314294323 -1.078275915443008 
+2 0.3801953554564874 0.27804690857382425 0.27804690857382425 -1.038437619486799 
+2.1 0.4028946787226412 0.1768699128706143 0.1768699128706143 -0.9826592703158966 
+2.2 0.4157785457242316 0.08196050758240601 0.08196050758240601 -0.9135175990308693 
+2.3000000000000003 0.419536216558786 -0.005474390665458047 -0.005474390665458047 -0.8335980424521139 
+2.4000000000000004 0.41496476478182803 -0.08448474032726619 -0.08448474032726619 -0.7454447892363899 
+2.5 0.4029436725471426 -0.15437098161139576 -0.15437098161139576 -0.6515163634828894 
+2.6 0.3844102775079825 -0.2146734922009253 -0.2146734922009253 -0.5541470628150398 
+2.7 0.36033645695250544 -0.2651584873858606 -0.2651584873858606 -0.45551442651915025 
+2.8000000000000003 0.3317068744556278 -0.3058009708750235 -0.3058009708750235 -0.3576127780362321 
+2.9000000000000004 0.29949905368569524 -0.336765340901129 -0.336765340901129 -0.2622327664702615 
+3 0.26466548410325064 -0.3583842432473478 -0.3583842432473478 -0.17094672495915347 
+3.1 0.22811790519823363 -0.3711362398327117 -0.3711362398327117 -0.08509957056375556 
+3.2 0.19071386056268014 -0.37562282988607054 -0.37562282988607054 -0.005804891239289733 
+3.3000000000000003 0.1532455612791862 -0.37254532193452894 -0.37254532193452894 0.06605419937615653 
+3.4000000000000004 0.11643105046945883 -0.36268201025447205 -0.36268201025447205 0.1298199093155544 
+3.5 0.08090761789945776 -0.3468660604779249 -0.3468660604779249 0.18505082467900938 
+3.6 0.0472273756478496 -0.3259644570608479 -0.3259644570608479 0.23150970576514873 
+3.7 0.01585487325134502 -0.30085831157925286 -0.30085831157925286 0.2691485650765628 
+3.8000000000000003 -0.012833396440767305 -0.2724247765118087 -0.2724247765118087 0.2980915693933433 
+3.9000000000000004 -0.0385477712384891 -0.24152075537969556 -0.24152075537969556 0.3186162978566738 
+4 -0.061082660401086286 -0.2089685478184438 -0.2089685478184438 0.33113386862061633 
+4.1000000000000005 -0.08031245057397414 -0.17554351820407033 -0.17554351820407033 0.3361684193520186 
+4.2 -0.0961862816911444 -0.1419638295730987 -0.1419638295730987 0.33433639295538753 
+4.3 -0.10872189964227902 -0.1088822413617234 -0.1088822413617234 0.32632604064628146 
+4.4 -0.11799879036638398 -0.07687993041545703 -0.07687993041545703 0.312877511148225 
+4.5 -0.12415079421111565 -0.046462260135513356 -0.046462260135513356 0.2947638485577446 
+4.6000000000000005 -0.12735839035030108 -0.018056392761900818 -0.018056392761900818 0.27277317346250296 
+4.7 -0.12784082926017604 0.00798938523583791 0.00798938523583791 0.24769227328451415 
+4.800000000000001 -0.12584827720288308 0.031404774865856494 0.031404774865856494 0.22029177953990967 
+4.9 -0.12165412083585864 0.05199517902729077 0.05199517902729077 0.1913130626444265 
+5 -0.11554756292786264 0.06963819602772538 0.06963819602772538 0.16145692982799992 
+5.1000000000000005 -0.1078266221666726 0.08427907501449008 0.08427907501449008 0.13137416931885512 
+5.2 -0.09879163161344358 0.09592531845728644 0.09592531845728644 0.10165794476960072 
+5.300000000000001 -0.08873931188594099 0.10464061540820929 0.10464061540820929 0.07283800836367268 
+5.4 -0.07795747699235288 0.11053828449716012 0.11053828449716012 0.04537666948754564 
+5.5 -0.06672041320452851 0.1137743979059747 0.1137743979059747 0.019666428503082325 
+5.6000000000000005 -0.05528495472768008 0.11454074733029787 0.11454074733029787 -0.0039708378749377105 
+5.7 -0.043887264423098864 0.1130578006185652 0.1130578006185652 -0.02528327177236747 
+5.800000000000001 -0.032740313658134686 0.10956778380493079 0.10956778380493079 -0.04408715648866142 
+5.9 -0.02203204263760526 0.10432800805039052 0.10432800805039052 -0.06026392277518001 
+6 -0.01192417141548096 0.0976045449796073 0.0976045449796073 -0.07375620214864538 
+6.1000000000000005 -0.0025516222581852613 0.08966633743300369 0.08966633743300369 -0.08456309291663316 
+6.2 0.005977493842715208 0.0807798160994309 0.0807798160994309 -0.09273480378486132 
+6.300000000000001 0.013581379938073537 0.07120407617691722 0.07120407617691722 -0.09836683605306429 
+6.4 0.020203603218213073 0.06118665241518792 0.06118665241518792 -0.10159385885161407 
+6.5 0.025811753565697145 0.05095991587406696 0.05095991587406696 -0.10258342300546125 
+6.6000000000000005 0.030395773312782634 0.04073810169822457 0.04073810169822457 -0.10152964832378983 
+6.7 0.03396602122854981 0.030714964333848373 0.030714964333848373 -0.098647006790948 
+6.800000000000001 0.03655113281293448 0.021062045031055385 0.021062045031055385 -0.09416431065692434 
+6.9 0.03819573693779566 0.011927526284231101 0.011927526284231101 -0.08831900015982243 
+7 0.038958085891102334 0.0034356391223422655 0.0034356391223422655 -0.08135181090454693 
+7.1000000000000005 0.03890765209613039 -0.00431341809943629 -0.00431341809943629 -0.07350188609282449 
+7.2 0.03812274034127257 -0.011243096539540635 -0.011243096539540635 -0.0650023841430045 
+7.300000000000001 0.036688159414027664 -0.017299700812151448 -0.017299700812151448 -0.056076618015903884 
+7.4 0.03469299172698181 -0.022451234449806044 -0.022451234449806044 -0.046934749004157575 
+7.5 0.03222849398955063 -0.02668594293748488 -0.02668594293748488 -0.03777104504161639 
+7.6000000000000005 0.029386156343791745 -0.030010610781601776 -0.030010610781601776 -0.028761701905981714 
+7.7 0.026255941762452224 -0.03244866837766036 -0.03244866837766036 -0.020063215147244087 
+7.800000000000001 0.022924722007915788 -0.034038162747697415 -0.034038162747697415 -0.01181128126813416 
+7.9 0.01947492116487247 -0.03482964365967203 -0.03482964365967203 -0.004120198670072905 
+8 0.015983372767453582 -0.034884013346742404 -0.034884013346742404 0.002917267811835239 
+8.1 0.012520391910155092 -0.03427038414745996 -0.03427038414745996 0.009229600327149777 
+8.200000000000001 0.009149059514792154 -0.03306398401970315 -0.03306398401970315 0.014765864990118845 
+8.3 0.005924712163685209 -0.03134414516951911 -0.03134414516951911 0.01949472084214869 
+8.4 0.002894627630453202 -0.029192406103149063 -0.029192406103149063 0.02340315084224266 
+8.5 9.789346049769758e-05 -0.026690752371166214 -0.026690752371166214 0.02649496545017082 
+8.6 -0.0024345563212244585 -0.023920016233733854 -0.023920016233733854 0.02878912887618277 
+8.700000000000001 -0.004679753072044655 -0.02095845053131512 -0.02095845053131512 0.03031795667540443 
+8.8 -0.006622371948328112 -0.017880487280622578 -0.017880487280622578 0.031125231177278802 
+8.9 -0.008254295686447791 -0.01475568700457419 -0.01475568700457419 0.03126427837746977 
+9 -0.009574082945542645 -0.011647880609109618 -0.011647880609109618 0.030796046500194907 
+9.1 -0.010586360396131861 -0.008614501788656286 -0.008614501788656286 0.02978722258092001 
+9.200000000000001 -0.011301157361942264 -0.005706104513891897 -0.005706104513891897 0.028308419237776425 
+9.3 -0.011733201124085641 -0.0029660571570232948 -0.0029660571570232948 0.026432459405194578 
+9.4 -0.011901190020858194 -0.0004304022571706756 -0.0004304022571706756 0.024232782298887066 
+9.5 -0.011827060267889327 0.0018721311723099623 0.0018721311723099623 0.021781989363468694 
+9.600000000000001 -0.011535261027247333 0.003919977544700067 0.003919977544700067 0.019150544509794597 
+9.700000000000001 -0.011052050714792816 0.005698462773618122 0.005698462773618122 0.01640563865596751 
+9.8 -0.01040482589543206 0.007199427279757178 0.007199427279757178 0.013610224511106942 
+9.9 -0.009621492416663387 0.008420761092285567 0.008420761092285567 0.010822223741041206 
+10 -0.008729886709891084 0.009365868243665134 0.009365868243665134 0.008093905176117034 
+10.100000000000001 -0.007757253481163257 0.010043077363446585 0.010043077363446585 0.00547142959887993 
+10.200000000000001 -0.006729784349475743 0.01046501479008861 0.01046501479008861 0.002994553908862876 
+10.3 -0.005672220398965791 0.010647955679073078 0.010647955679073078 0.0006964851188585048 
+10.4 -0.004607520114619735 0.01061116753072474 0.01061116753072474 -0.0013961273014852695 
+10.5 -0.003556592788925151 0.01037625933260701 0.01037625933260701 -0.0032630737547567085 
+10.600000000000001 -0.0025380962345761177 0.00996654814902562 0.00996654814902562 -0.004890355679873385 
+10.700000000000001 -0.0015682965273264165 0.00940645353264849 0.00940645353264849 -0.006269860477995657 
+10.8 -0.0006609865410427007 0.008720928617294297 0.008720928617294297 -0.007398955535208895 
+10.9 0.0001725407719589533 0.007934935210919702 0.007934935210919702 -0.008280016754837609 
+11 0.0009234690573000589 0.007072968675297425 0.007072968675297425 -0.008919906789897543 
+11.100000000000001 0.0015853903970655054 0.006158636882231729 0.006158636882231729 -0.00932941767636274 
+11.200000000000001 0.0021541978724787907 0.005214296100426404 0.005214296100426404 -0.009522691845383985 
+11.3 0.002627944629715531 0.00426074531378616 0.00426074531378616 -0.009516634573217223 
+11.4 0.00300667531436847 0.003316979218868884 0.003316979218868884 -0.009330329847605824 
+11.5 0.00329223570691817 0.0023999990106806954 0.0023999990106806954 -0.008984470424517036 
+11.600000000000001 0.0034880662507405023 0.0015246790527513282 0.0015246790527513282 -0.008500811554232332 
+11.700000000000001 0.0035989849286162793 0.0007036866467755733 0.0007036866467755733 -0.007901656504008132 
+11.8 0.003630964626891856 -5.254862682824588e-05 -5.254862682824588e-05 -0.007209380626955466 
+11.9 0.003590909742124007 -0.0007358201321846337 -0.0007358201321846337 -0.00644599935206338 
+12 0.0034864363469535925 -0.001340088569645939 -0.001340088569645939 -0.0056327841242612454 
+12.100000000000001 0.003325659753531721 -0.0018613904781384287 -0.0018613904781384287 -0.0047899290289250135 
+12.200000000000001 0.0031169928069977094 -0.0022977160020221992 -0.0022977160020221992 -0.0039362696119732195 
+12.3 0.002868957720499136 -0.0026488611698632794 -0.0026488611698632794 -0.003089054271134993 
+12.4 0.0025900137383951466 -0.0029162599174549176 -0.0029162599174549176 -0.0022637675593353756 
+12.5 0.002288402395933029 -0.0031028009743782605 -0.0031028009743782605 -0.0014740038174877977 
+12.600000000000001 0.001972011641065553 -0.003212634533832957 -0.003212634533832957 -0.0007313887482981495 
+12.700000000000001 0.0016482596052362281 -0.0032509733514202166 -0.0032509733514202166 -4.5545859052239634e-05 
+12.8 0.0013239983617365596 -0.003223892582320224 -0.003223892582320224 0.0005758958588471049 
+12.9 0.00100543759823668 -0.0031381322801796725 -0.0031381322801796725 0.0011272570837063127 
+13 0.0006980877586898452 -0.003000906057097725 -0.003000906057097725 0.0016047305397180343 
+13.100000000000001 0.00040672188220546897 -0.0028197189540218807 -0.0028197189540218807 0.002006275189610943 
+13.200000000000001 0.0001353550847316665 -0.002602197105679553 -0.002602197105679553 0.00233148693621622 
+13.3 -0.00011275960552309318 -0.0023559313141531847 -0.0023559313141531847 0.002581450525199371 
+13.4 -0.0003351205360628552 -0.0020883361797471335 -0.0020883361797471335 0.0027585772518728437 
+13.5 -0.0005299534607050738 -0.0018065259853035818 -0.0018065259853035818 0.0028664329067137296 
+13.600000000000001 -0.0006961760298535654 -0.0015172080979660252 -0.0015172080979660252 0.002909560157673156 
+13.700000000000001 -0.0008333525133649218 -0.0012265942468389075 -0.0012265942468389075 0.002893299273568751 
+13.8 -0.0009416405458027641 -0.0009403296612243728 -0.0009403296612243728 0.002823610752829901 
+13.9 -0.0010217316651339476 -0.000663439716209241 -0.000663439716209241 0.0027069030464771365 
+14 -0.0010747873653124548 -0.00040029343333526396 -0.00040029343333526396 0.0025498681639601734 
+14.100000000000001 -0.0011023723047144734 -0.0001545829258966826 -0.0001545829258966826 0.0023593275353256293 
+14.200000000000001 -0.0011063862101758349 7.068233791057151e-05 7.068233791057151e-05 0.0021420900824410984 
+14.3 -0.001088995894640924 0.000273167755088173 0.000273167755088173 0.001904824034193675 
+14.4 -0.0010525686693320469 0.00045119372529667576 0.00045119372529667576 0.001653943613367418 
+14.5 -0.000999608282956602 0.0006037052328983545 0.0006037052328983545 0.0013955113330148495 
+14.600000000000001 -0.0009326943646803335 0.000730232456028675 0.000730232456028675 0.001135156273331992 
+14.700000000000001 -0.0008544261880725206 0.0008308440050475329 0.0008308440050475329 0.0008780083710975083 
+14.8 -0.0007673714133595248 0.0009060943802853779 0.0009060943802853779 0.0006286484464336716 
+14.9 -0.0006740203081773314 0.0009569671975370544 0.0009569671975370544 0.00039107341881760844 
+15 -0.0005767457953183053 0.000984815662817698 0.000984815662817698 0.00016867592781891249 
+15.100000000000001 -0.00047776953208599664 0.0009913016891765786 0.0009913016891765786 -3.576262500458536e-05 
+15.200000000000001 -0.00037913409179474503 0.000978334941624739 0.000978334941624739 -0.00022006675803524904 
+15.3 -0.0002826811952925971 0.0009480129752137839 0.0009480129752137839 -0.0003826505846285897 
+15.4 -0.00019003583039065808 0.0009025634996644423 0.0009025634996644423 -0.0005224918388831261 
+15.5 -0.00010259600063439375 0.0008442896651964464 0.0008442896651964464 -0.000639097663927659 
+15.600000000000001 -2.152776249593998e-05 0.0007755191216686158 0.0007755191216686158 -0.0007324635966767358 
+15.700000000000001 5.2234857974952e-05 0.0006985574598694131 0.0006985574598694131 -0.0008030271758193171 
+15.8 0.000117985531799516 0.0006156465025949689 0.0006156465025949689 -0.0008516175661940008 
+15.9 0.00017523737965997405 0.0005289277765000775 0.0005289277765000775 -0.0008794025358200256 
+16 0.00022371133961043293 0.0004404113657712868 0.0004404113657712868 -0.0008878340449921527 
+16.1 0.00026332169304556344 0.0003519502272798091 0.0003519502272798091 -0.000878593613370936 
+16.2 0.00029415929437308033 0.0002652199355189387 0.0002652199355189387 -0.0008535385242650994 
+16.3 0.0003164730415615067 0.00018170372547650154 0.00018170372547650154 -0.0008146498085995149 
+16.400000000000002 0.00033065010704089344 0.00010268261347487664 0.00010268261347487664 -0.0007639828275566636 
+16.5 0.0003371954225536442 2.9230300458246624e-05 2.9230300458246624e-05 -0.000703621145565535 
+16.6 0.0003367108787561766 -3.7787500541219606e-05 -3.7787500541219606e-05 -0.0006356342569711335 
+16.7 0.00032987466194113143 -9.770972099584485e-05 -9.770972099584485e-05 -0.000562039602886418 
+16.8 0.0003174211074506468 -0.00015007302322842068 -0.00015007302322842068 -0.00048476919167287296 
+16.900000000000002 0.0003001214034127494 -0.00019460178763066748 -0.00019460178763066748 -0.00040564101919483137 
+17 0.0002787654305267877 -0.00023119548592623267 -0.00023119548592623267 -0.0003263353751273428 
+17.1 0.0002541449748475524 -0.0002599139291473599 -0.0002599139291473599 -0.00024837602054774484 
+17.2 0.00022703850188088184 -0.0002809608728735317 -0.0002809608728735317 -0.00017311613088823196 
+17.3 0.00019819763271702092 -0.0002946664475732189 -0.0002946664475732189 -0.00010172881786082294 
+17.400000000000002 0.00016833541719600708 -0.000301468859692908 -0.000301468859692908 -3.520197469910615e-05 
+17.5 0.00013811645591437326 -0.0003018957805853256 -0.0003018957805853256 2.566286875657907e-05 
+17.6 0.0001081488828226466 -0.0002965458066085515 -0.0002965458066085515 8.024804096325828e-05 
+17.7 7.89781836922557e-05 -0.00028607033589656824 -0.00028607033589656824 0.00012811396851205683 
+17.8 5.1082793199983726e-05 -0.000271156166505975 -0.000271156166505975 0.00016899058010600755 
+17.900000000000002 2.4871385031143944e-05 -0.00025250907794036336 -0.00025250907794036336 0.00020276630787807547 
+18 6.817453797115667e-07 -0.00023083861443612828 -0.00023083861443612828 0.00022947512367670514 
+18.1 -2.12188994303069e-05 -0.00020684424477707247 -0.00020684424477707247 0.00024928204363768627 
+18.2 -4.063224580527345e-05 -0.00018120303061866703 -0.00018120303061866703 0.0002624675222292139 
+18.3 -5.742612194822151e-05 -0.00015455889408026981 -0.00015455889408026981 0.00026941113797671285 
+18.400000000000002 -7.153070595912151e-05 -0.00012751353633940426 -0.00012751353633940426 0.0002705749482576473 
+18.5 -8.293391913119905e-05 -0.00010061902266865116 -0.00010061902266865116 0.00026648686093104924 
+18.6 -9.167616046493564e-05 -7.437201622256742e-05 -7.437201622256742e-05 0.0002577243371524387 
+18.7 -9.784454513973986e-05 -4.920961323810908e-05 -4.920961323810908e-05 0.0002448987035175888 
+18.8 -0.00010156680362173178 -2.5506706388530842e-05 -2.5506706388530842e-05 0.0002286403136319944 
+18.900000000000002 -0.00010300498962435125 -3.574780966174648e-06 -3.574780966174648e-06 0.00020958476021487715 
+19 -0.00010234913466576871 1.633796958403268e-05 1.633796958403268e-05 0.00018836029974750475 
+19.1 -9.98109748737098e-05 3.404533652842402e-05 3.404533652842402e-05 0.0001655766132189956 
+19.200000000000003 -9.561786235830769e-05 4.9420735338425605e-05 4.9420735338425605e-05 0.00014181498937818976 
+19.3 -9.000695927793369e-05 6.239393690734695e-05 6.239393690734695e-05 0.00011761998164852043 
+19.400000000000002 -8.32197980137337e-05 7.294703903368361e-05 7.294703903368361e-05 9.349255699378378e-05 
+19.5 -7.54972759741759e-05 8.110982701426104e-05 8.110982701426104e-05 6.988472493409075e-05 
+19.6 -6.707513877192325e-05 8.695466963364845e-05 8.695466963364845e-05 4.719560791019805e-05 
+19.700000000000003 -5.817999112161169e-05 9.059109174312921e-05 9.059109174312921e-05 2.576889050009417e-05 
+19.8 -4.902586103547081e-05 9.216015733126123e-05 9.216015733126123e-05 5.891564739680382e-06 
+19.900000000000002 -3.981132994664347e-05 9.182878784641331e-05 9.182878784641331e-05 -1.2206127953126377e-05 
+0 1.9009664754423135 -0.8410927532522561 -0.8410927532522561 -2.960840197632371 
+0.1 1.8028312955380061 -1.1137918701332663 -1.1137918701332663 -2.491870720942746 
+0.2 1.6797796362715958 -1.3393772739599086 -1.3393772739599086 -2.0201819985832827 
+0.30000000000000004 1.5365206968191107 -1.5180461192154482 -1.5180461192154482 -1.5549952744227733 
+0.4 1.3776994917373382 -1.6508682518294806 -1.6508682518294806 -1.1045307316451958 
+0.5 1.2078146998433537 -1.7396828336546883 -1.7396828336546883 -0.675946566032019 
+0.6000000000000001 1.0311471113061113 -1.7869901867904179 -1.7869901867904179 -0.2753040358218046 
+0.7000000000000001 0.8516990239609511 -1.7958413676915366 -1.7958413676915366 0.09244331976963438 
+0.8 0.6731446984105326 -1.7697277852847704 -1.7697277852847704 0.4234383884637052 
+0.9 0.49879176092333555 -1.7124729562511656 -1.7124729562511656 0.7148894344044945 
+1 0.3315532456856969 -1.6281282508350419 -1.6281282508350419 0.9650217594636481 
+1.1 0.17392979515922363 -1.5208742303459477 -1.5208742303459477 1.1730146400275006 
+1.2000000000000002 0.028001390079887275 -1.3949289189022371 -1.3949289189022371 1.3389261387424627 
+1.3 -0.1045721406296933 -1.2544640924482002 -1.2544640924482002 1.463608373707587 
+1.4000000000000001 -0.2225426752427399 -1.1035304126777736 -1.1035304126777736 1.5486157631632533 
+1.5 -0.32505833544728446 -0.9459919866776766 -0.9459919866776766 1.5961086575722456 
+1.6 -0.41164196518789237 -0.7854706987347367 -0.7854706987347367 1.6087546291105215 
+1.7000000000000002 -0.4821645374854955 -0.6253004421044578 -0.6253004421044578 1.5896295170754486 
+1.8 -0.5368144771943135 -0.46849117826896375 -0.46849117826896375 1.5421201326575908 
+1.9000000000000001 -0.5760638712386686 -0.3177025713787049 -0.3177025713787049 1.4698303138560422 
+2 -0.6006325045584193 -0.17522678764599195 -0.17522678764599195 1.3764917967628305 
+2.1 -0.6114506120207891 -0.042979914355542104 -0.042979914355542104 1.2658811383971202 
+2.2 -0.6096211762348367 0.07749865872712093 0.07749865872712093 1.1417436937425525 
+2.3000000000000003 -0.5963825308541586 0.18503964151800104 0.18503964151800104 1.007725420190316 
+2.4000000000000004 -0.5730719508647625 0.27883084144607806 0.27883084144607806 0.8673130602834469 
+2.5 -0.5410908277485105 0.3583986134584223 0.3583986134584223 0.7237830420385987 
+2.6 -0.5018719404018017 0.4235846426096024 0.4235846426096024 0.5801592381940011 
+2.7 -0.4568492442514599 0.4745189445486022 0.4745189445486022 0.4391795439543176 
+2.8000000000000003 -0.4074305129613086 0.5115899568308229 0.5115899568308229 0.30327106909179435 
+2.9000000000000004 -0.3549730810996028 0.5354125661894249 0.5354125661894249 0.1745335960097807 
+3 -0.3007628535811306 0.5467948756935704 0.5467948756935704 0.05473083146869073 
+3.1 -0.24599666984712762 0.5467044631604928 0.5467044631604928 -0.05471112346623758 
+3.2 -0.19176803863256922 0.5362348203528948 0.5362348203528948 -0.15269874308775638 
+3.3000000000000003 -0.13905619361819 0.5165725934405155 0.5165725934405155 -0.23846020620413555 
+3.4000000000000004 -0.08871836189487332 0.488966170933652 0.488966170933652 -0.3115294471439054 
+3.5 -0.04148508640642225 0.45469608771481007 0.45469608771481007 -0.3717259149019656 
+3.6 0.0020415993763242147 0.4150476346916217 0.4150476346916217 -0.41913083344427016 
+3.7 0.04138738129303593 0.3712859846175228 0.3712859846175228 -0.4540607472035947 
+3.8000000000000003 0.07620252275345712 0.3246340672650232 0.3246340672650232 -0.47703911277193745 
+3.9000000000000004 0.10625665489687511 0.27625335270597107 0.27625335270597107 -0.4887666624997213 
+4 0.13143179444848926 0.22722763108931276 0.22722763108931276 -0.4900912199862913 
+4.1000000000000005 0.1517138905960873 0.17854981195946318 0.17854981195946318 -0.4819775931516378 
+4.2 0.16718320148554525 0.13111170659180835 0.13111170659180835 -0.4654781095628988 
+4.3 0.17800379456614127 0.08569670361775233 0.08569670361775233 -0.44170429275003487 
+4.4 0.18441245366446668 0.04297520177554838 0.04297520177554838 -0.4118001091044817 
+4.5 0.1867072600188857 0.003502624193195664 0.003502624193195664 -0.3769171442309671 
+4.6000000000000005 0.18523609527103133 -0.03228019373159857 -0.03228019373159857 -0.3381919968104641 
+4.7 0.18038529229371858 -0.0640444761020543 -0.0640444761020543 -0.29672610848538283 
+4.800000000000001 0.17256863543037873 -0.09156908966316293 -0.09156908966316293 -0.2535681811975945 
+4.9 0.16221688589960273 -0.11473450196358191 -0.11473450196358191 -0.20969926983562354 
+5 0.14976798141465103 -0.13351538397566198 -0.13351538397566198 -0.16602057885364008 
+5.1000000000000005 0.13565803207317634 -0.14797212672677126 -0.14797212672677126 -0.12334393741958141 
+5.2 0.12031320782752676 -0.15824153647838227 -0.15824153647838227 -0.08238487917667126 
+5.300000000000001 0.10414258683525174 -0.16452696342368345 -0.16452696342368345 -0.04375821024682003 
+5.4 0.08753200913718807 -0.1670881053744141 -0.1670881053744141 -0.007975912899962051 
+5.5 0.07083895677872666 -0.16623071110411813 -0.16623071110411813 0.024552797546664812 
+5.6000000000000005 0.0543884599771809 -0.16229638854171274 -0.16229638854171274 0.053519468587350946 
+5.7 0.03847000948024508 -0.15565270149024432 -0.15565270149024432 0.07871268252975416 
+5.800000000000001 0.02333543803152854 -0.14668371558451396 -0.14668371558451396 0.10001283952145687 
+5.9 0.009197718974330004 -0.1357811303714174 -0.1357811303714174 0.11738569242275738 
+6 -0.0037693824560750527 -0.12333611023381112 -0.12333611023381112 0.13087487514596122 
+6.1000000000000005 -0.015430879669941002 -0.1097319028733116 -0.1097319028733116 0.1405936622131936 
+6.2 -0.025689439303179247 -0.09533731066078041 -0.09533731066078041 0.14671618926713892 
+6.300000000000001 -0.03448364745310957 -0.08050105774311093 -0.08050105774311093 0.14946835264933006 
+6.4 -0.04178575840440287 -0.0655470746934353 -0.0655470746934353 0.14911859150224105 
+6.5 -0.04759901786884257 -0.05077070298447301 -0.05077070298447301 0.14596873872215815 
+6.6000000000000005 -0.05195465208991265 -0.036435803870401656 -0.036435803870401656 0.14034510805022696 
+6.7 -0.054908611818865985 -0.022772740545021094 -0.022772740545021094 0.13258996418275307 
+6.800000000000001 -0.056538156356466765 -0.009977188813637362 -0.009977188813637362 0.1230535015265709 
+6.9 -0.05693835778618489 0.0017902799666263541 0.0017902799666263541 0.11208643560574343 
+7 -0.05621859941607272 0.012403909244672382 0.012403909244672382 0.10003328958747305 
+7.1000000000000005 -0.0544991355129231 0.021771833686436138 0.021771833686436138 0.08722643733941006 
+7.2 -0.05190777186385592 0.029834599516026267 0.029834599516026267 0.07398094421168558 
+7.300000000000001 -0.0485767187391412 0.03656320981877615 0.03656320981877615 0.060590227659506245 
+7.4 -0.044639659646405094 0.041956777152906535 0.041956777152906535 0.04732254213990365 
+7.5 -0.04022907103703314 0.04603986544163346 0.04603986544163346 0.03441827663243282 
+7.6000000000000005 -0.03547382001129303 0.04885960122083151 0.04885960122083151 0.022088038801754556 
+7.7 -0.030497059212678323 0.05048263107964189 0.05048263107964189 0.010511487345714755 
+7.800000000000001 -0.0254144306289172 0.0509919977418312 0.0509919977418312 -0.00016313648399679526 
+7.9 -0.02033258303254782 0.05048400188727894 0.05048400188727894 -0.009818835822183299 
+8 -0.015348001384156831 0.04906511069857116 0.04906511069857116 -0.0183691079302575 
+8.1 -0.010546140753409337 0.046848967426374226 0.046848967426374226 -0.02575668591955555 
+8.200000000000001 -0.006000852235383294 0.04395354918109661 0.04395354918109661 -0.031951844710330024 
+8.3 -0.0017740839832427878 0.040498512849537156 0.040498512849537156 -0.03695034488305158 
+8.4 0.0020841631429059527 0.0366027616638254 0.0366027616638254 -0.04077108794963731 
+8.5 0.0055356486982009925 0.03238225766200265 0.03238225766200265 -0.04345355505840463 
+8.6 0.00855350010143022 0.027948098205607213 0.027948098205607213 -0.04505509840846765 
+8.700000000000001 0.011121641440906955 0.023404867973664607 0.023404867973664607 -0.04564815085547852 
+8.8 0.013234071491057868 0.018849271531505413 0.018849271531505413 -0.045317414513621146 
+8.9 0.014894019007499391 0.01436904575650228 0.01436904575650228 -0.044157083771501064 
+9 0.01611300302918642 0.010042146153623926 0.010042146153623926 -0.04226815221199677 
+9.1 0.01690982508243706 0.005936196457417401 0.005936196457417401 -0.03975584662229152 
+9.200000000000001 0.017309518916178646 0.00210818692341698 0.00210818692341698 -0.03672722475577427 
+9.3 0.01734228176574724 -0.0013955966242369807 -0.0013955966242369807 -0.0332889669072575 
+9.4 0.017042409209897438 -0.004539433600172411 -0.004539433600172411 -0.029545384819622465 
+9.5 0.016447253517675564 -0.007297841962868805 -0.007297841962868805 -0.025596665072482323 
+9.600000000000001 0.015596223042065909 -0.0096550880681235 -0.0096550880681235 -0.02153735801600832 
+9.700000000000001 0.01452983776663613 -0.011604557938565869 -0.011604557938565869 -0.01745511759470639 
+9.8 0.013288853606907616 -0.01314801508362209 -0.01314801508362209 -0.013429692130193143 
+9.9 0.011913465562567328 -0.014294769768113273 -0.014294769768113273 -0.009532161357021382 
+10 0.010442597357634425 -0.01506078394084309 -0.01506078394084309 -0.00582441077442576 
+10.100000000000001 0.008913282835585321 -0.015467734953028903 -0.015467734953028903 -0.0023588307181417398 
+10.200000000000001 0.0073601421317994985 -0.015542059778360737 -0.015542059778360737 0.0008217755147617405 
+10.3 0.005814953557225638 -0.015313999750807989 -0.015313999750807989 0.003684092636356712 
+10.4 0.004306320219703452 -0.014816663921670505 -0.014816663921670505 0.0062040234822636 
+10.5 0.0028594287019302 -0.014085127061004584 -0.014085127061004584 0.008366269657144185 
+10.600000000000001 0.0014958956210409167 -0.013155576145290023 -0.013155576145290023 0.010163784903208191 
+10.700000000000001 0.00023369662225515244 -0.012064516934698238 -0.012064516934698238 0.011597123690187934 
+10.8 -0.0009128286888692694 -0.01084804999736386 -0.01084804999736386 0.0126737073751024 
+10.9 -0.0019329031958062824 -0.00954122332803766 -0.00954122332803766 0.013407029719650224 
+11 -0.002819178029479657 -0.00817746657297935 -0.00817746657297935 0.013815822631938663 
+11.100000000000001 -0.0035675459619372105 -0.006788109845421892 -0.006788109845421892 0.013923201769296314 
+11.200000000000001 -0.004176910964118948 -0.005401988224651818 -0.005401988224651818 0.013755810152889713 
+11.3 -0.004648922477848945 -0.004045131299673954 -0.004045131299673954 0.013342976255371845 
+11.4 -0.004987682808987129 -0.0027405355633239254 -0.0027405355633239254 0.012715901181298183 
+11.5 -0.005199435759371382 -0.0015080160972648353 -0.0015080160972648353 0.0119068876160076 
+11.600000000000001 -0.005292244199164177 -0.000364132820449823 -0.000364132820449823 0.010948621218778177 
+11.700000000000001 -0.005275663758453813 0.0006778143932615701 0.0006778143932615701 0.009873513123646056 
+11.8 -0.005160419207539809 0.0016077281893304312 0.0016077281893304312 0.008713110225749188 
+11.9 -0.004958089419080886 0.0024186008305671775 0.0024186008305671775 0.007497578007594595 
+12 -0.004680806081411489 0.003106353333934191 0.003106353333934191 0.006255258828888786 
+12.100000000000001 -0.004340970579124771 0.0036696342667694695 0.0036696342667694695 0.005012306891480073 
+12.200000000000001 -0.003950992691579681 0.004109585864382061 0.004109585864382061 0.0037923995187773007 
+12.3 -0.0035230539980253203 0.004429585022759853 0.004429585022759853 0.002616522973290788 
+12.4 -0.0030688981336941803 0.0046349664786903295 0.0046349664786903295 0.0015028297886980312 
+12.5 -0.0025996493269633677 0.004732735132191318 0.004732735132191318 0.0004665635217354176 
+12.600000000000001 -0.0021256599742274546 0.004731274010607797 0.004731274010607797 -0.0004799540621528882 
+12.700000000000001 -0.0016563873853820503 0.004640053838047295 0.004640053838047295 -0.001327279067283194 
+12.8 -0.0012002992659045845 0.00446934957580513 0.00446934957580513 -0.002068751043995961 
+12.9 -0.0007648069968016647 0.004229968656367062 0.004229968656367062 -0.0027003546627637326 
+13 -0.0003562253348421025 0.0039329949619756055 0.0039329949619756055 -0.0032205442922914004 
+13.100000000000001 2.0243215418295692e-05 0.0035895519140844013 0.0035895519140844013 -0.0036300383449209927 
+13.200000000000001 0.0003605014087072036 0.0032105873565542644 0.0032105873565542644 -0.0039315901739686715 
+13.3 0.0006615299307366229 0.002806682246038289 0.002806682246038289 -0.004129742107511535 
+13.4 0.0009213421889605273 0.00238788451904235 0.00238788451904235 -0.004230568896963405 
+13.5 0.0011389237829987893 0.00196356889644598 0.00196356889644598 -0.004241416462443559 
+13.600000000000001 0.0013141592627616005 0.0015423228210372556 0.0015423228210372556 -0.004170641346560457 
+13.700000000000001 0.001447748775665462 0.0011318582084572054 0.0011318582084572054 -0.00402735575978813 
+13.8 0.001541117148892309 0.0007389482318876284 0.0007389482318876284 -0.0038211825296722464 
+13.9 0.0015963178541044 0.00036938795934956116 0.00036938795934956116 -0.003562023667558361 
+14 0.001615934166363045 2.7977321660062104e-05 2.7977321660062104e-05 -0.003259845654386152 
+14.100000000000001 0.001602979662319775 -0.00028147539037296686 -0.00028147539037296686 -0.0029244839342665832 
+14.200000000000001 0.0015608000111726325 -0.0005561315195827939 -0.0005561315195827939 -0.002565468502762471 
+14.3 0.0014929778014172834 -0.0007940837064224905 -0.0007940837064224905 -0.0021918718964120763 
+14.4 0.0014032419228688269 -0.000994303505678082 -0.000994303505678082 -0.0018121803400595717 
+14.5 0.0012953827922790495 -0.0011565772880157686 -0.0011565772880157686 -0.0014341882965423305 
+14.600000000000001 0.001173174477240456 -0.001281432759131946 -0.001281432759131946 -0.0010649161953489661 
+14.700000000000001 0.001040304541628116 -0.0013700583855557132 -0.0013700583855557132 -0.0007105506977005188 
+14.8 0.0009003122107721351 -0.001424217933101113 -0.001424217933101113 -0.00037640648844315715 
+14.9 0.0007565352395462421 -0.001446162206901625 -0.001446162206901625 -6.690827219085933e-05 
+15 0.0006120656647305156 -0.0014385399363434624 -0.0014385399363434624 0.00021440860688243116 
+15.100000000000001 0.00046971443693926654 -0.001404309579530824 -0.001404309579530824 0.0004648807056522909 
+15.200000000000001 0.00033198475913416526 -0.001346653635565719 -0.001346653635565719 0.0006826841172973884 
+15.3 0.00020105380977165216 -0.0012688968541293695 -0.0012688968541293695 0.0008667892345860652 
+15.4 7.876239995640368e-05 -0.0011744295255730944 -0.0011744295255730944 0.001016904725660287 
+15.5 -3.33879938970595e-05 -0.0010666368255955936 -0.0010666368255955936 0.0011334128133897127 
+15.600000000000001 -0.00013423146738515248 -0.0009488349808457719 -0.0009488349808457719 0.0012172979156160769 
+15.700000000000001 -0.00022292790735824351 -0.0008242148192602288 -0.0008242148192602288 0.0012700706339767158 
+15.8 -0.00029894795162414915 -0.0006957930749749851 -0.0006957930749749851 0.0012936889782232835 
+15.9 -0.00036205347540146286 -0.0005663716351128412 -0.0005663716351128412 0.001290478585915767 
+16 -0.0004122744009821794 -0.00043850474702151717 -0.00043850474702151717 0.001263053548985876 
+16.1 -0.0004498826211319815 -0.00031447405151495 -0.00031447405151495 0.0012142392937789129 
+16.2 -0.00047536380636919437 -0.0001962711717640241 -0.0001962711717640241 0.0011469987845024129 
+16.3 -0.0004893878331828501 -8.558746964701043e-05 -8.558746964701043e-05 0.0010643631360127105 
+16.400000000000002 -0.0004927785263130193 1.6189517876494303e-05 1.6189517876494303e-05 0.0009693675347495444 
+16.5 -0.0004864833552905615 0.00010797353034458748 0.00010797353034458748 0.0008649931802365355 
+16.6 -0.00047154366537973644 0.00018897155338530946 0.00018897155338530946 0.0007541157773741634 
+16.7 -0.0004490659577050825 0.00025867098058648486 0.00025867098058648486 0.0006394609348236802 
+16.8 -0.000420194664420671 0.00031682266909345856 0.00031682266909345856 0.0005235666597478835 
+16.900000000000002 -0.0003860867939438072 0.00036342060165575255 0.00036342060165575255 0.0004087529862318618 
+17 -0.0003478887500544173 0.00039867886449955605 0.00039867886449955605 0.00029709863560927857 
+17.1 -0.00030671555844561423 0.00042300663389833884 0.00042300663389833884 0.00019042448299288963 
+17.2 -0.00026363266633878723 0.00043698183621473404 0.00043698183621473404 9.028349646284043e-05 
+17.3 -0.00021964041612602421 0.00044132410813044296 0.00044132410813044296 -2.0432758783945314e-06 
+17.400000000000002 -0.00017566123358607854 0.0004368676374379351 0.0004368676374379351 -8.554517026577801e-05 
+17.5 -0.00013252951578247973 0.00042453441180727097 0.00042453441180727097 -0.0001594753802423115 
+17.6 -9.098415387331275e-05 0.0004053083449995018 0.0004053083449995018 -0.00022334003725287628 
+17.7 -5.166358216042876e-05 0.0003802106886497754 0.0003802106886497754 -0.00027688352432891786 
+17.8 -1.5103207046754586e-05 0.00035027707447834826 0.00035027707447834826 -0.0003200706603848391 
+17.900000000000002 1.82649617245735e-05 0.00031653646799063487 0.00031653646799063487 -0.00035306639143978187 
+18 4.8110680117316996e-05 0.00027999225166106114 0.00027999225166106114 -0.00037621361189569516 
+18.1 7.420206042285299e-05 0.00024160559438859923 0.00024160559438859923 -0.00039000971523430524 
+18.2 9.640061747515826e-05 0.00020228120565048428 0.00020228120565048428 -0.0003950824406008008 
+18.3 0.00011465501155568377 0.0001628555181028628 0.0001628555181028628 -0.0003921655412142303 
+18.400000000000002 0.00012899373090442508 0.0001240872920713966 0.0001240872920713966 -0.0003820747538802468 
+18.5 0.00013951695378530528 8.665058998092066e-05 8.665058998092066e-05 -0.00036568449755153124 
+18.6 0.00014638782280904653 5.113002868624702e-05 5.113002868624702e-05 -0.0003439056743043401 
+18.7 0.0001498233532396633 1.8018183142777927e-05 1.8018183142777927e-05 -0.0003176648896221045 
+18.8 0.00015008518286529005 -1.2285013977730586e-05 -1.2285013977730586e-05 -0.0002878853517528495 
+18.900000000000002 0.00014747035427054782 -3.9471055257754165e-05 -3.9471055257754165e-05 -0.00025546965328334146 
+19 0.00014230230157143714 -6.332002016526953e-05 -6.332002016526953e-05 -0.00022128458297760475 
+19.1 0.00013492219341502113 -8.369632356788186e-05 -8.369632356788186e-05 -0.0001861480632621604 
+19.200000000000003 0.00012568076283094278 -0.00010054326638741212 -0.00010054326638741212 -0.00015081825927447346 
+19.3 0.00011493073284423579 -0.00011387660592839278 -0.00011387660592839278 -0.0001159848597600788 
+19.400000000000002 0.00010301992507482837 -0.00012377736133987747 -0.00012377736133987747 -8.226248880977927e-05 
+19.5 9.028511727123692e-05 -0.0001303840636993923 -0.0001303840636993923 -5.018617084308154e-05 
+19.6 7.704669521984665e-05 -0.0001338846508249944 -0.0001338846508249944 -2.0208739614698906e-05 
+19.700000000000003 6.360412505306721e-05 -0.00013450819462926215 -0.00013450819462926215 7.299944523127733e-06 
+19.8 5.023225391495454e-05 -0.00013251663413803202 -0.00013251663413803202 3.2052126308122934e-05 
+19.900000000000002 3.717843044605793e-05 -0.00012819667071424992 -0.00012819667071424992 5.383980982213406e-05 
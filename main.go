@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bufio"
 	"flag"
 	"fmt"
 	log "log"
@@ -10,23 +9,38 @@ import (
 	rt "runtime"
 	pprof "runtime/pprof"
 	"strings"
+	"time"
 
-	probs "github.com/verdverm/go-pge/problems"
+	pge "github.com/verdverm/go-pge/pge"
 	expr "github.com/verdverm/go-symexpr"
-	tmplt "text/template"
 )
 
 var debug = false
 var numProcs = 12
 
+// the seed math/rand was given, recorded in the run manifest
+var rngSeed int64
+
 var cpuprofile = flag.String("prof", "", "write cpu profile to file")
 
 var arg_cfg = flag.String("cfg", "config/main/main_default.cfg", cfg_help_str)
 var arg_pcfg = flag.String("pcfg", "", pcfg_help_str)
 var arg_scfg = flag.String("scfg", "", scfg_help_str)
 var arg_gen = flag.String("gen", "", gen_help_str)
+var arg_noise = flag.String("noise", "", "noise on the training data of -gen bench, none, a level or add=,mul=,tail=,outliers=,input= (see problems/noise.go)")
 var arg_tmp = flag.Bool("tmp", false, "run tmp code and exit")
 var arg_post = flag.Bool("post", false, "run output processing code and exit")
+var arg_report = flag.String("report", "", "write report.html for a finished run dir and exit")
+var arg_success = flag.Float64("success", 0, "test error under which -post counts a run successful, 0 uses the problem's HitRatio")
+var arg_bench = flag.String("bench", "", "run benchmarks [all,list,family,probname,...] -runs times each and score recovery, -scfg may list configs to compare")
+var arg_bench_runs = flag.Int("runs", 10, "runs of each benchmark and config for -bench")
+var arg_bench_tol = flag.Float64("benchtol", 1e-4, "relative tolerance of -bench when snapping coefficients and comparing with the true function")
+var arg_evalbench = flag.String("evalbench", "", "time compiled vs tree evaluation [all,probname] and exit")
+var arg_worker = flag.String("worker", "", "serve remote evaluations of the -pcfg problem on [host]:port")
+var arg_serve = flag.String("serve", "", "run the HTTP job server on [host]:port")
+var arg_verbose = flag.Int("v", 1, "verbosity: 0 quiet, 1 setup and summaries, 2 every iteration, 3 every expression")
+var arg_tui = flag.Bool("tui", false, "watch the search in a full-screen terminal dashboard")
+var arg_seed = flag.Int64("seed", 0, "seed for the random number generator, 0 picks one from the clock")
 
 var arg_pge_iter = flag.Int("iter", -1, "iterations for PGE")
 var arg_pge_peel = flag.Int("peel", -1, "peel count for PGE")
@@ -37,19 +51,21 @@ var arg_pge_evals = flag.Int("evals", 1, "number of evaluater routines")
 var cfg_help_str = "A main config file"
 var pcfg_help_str = "A Problem config file"
 var scfg_help_str = "A Search config file"
-var gen_help_str = "Generate Data [bench,diffeq]:[list,all,probname]"
+var gen_help_str = "Generate Data and problem configs bench:[list,all,probname,file.cfg] or diffeq:[list,all,probname]"
 
 func main() {
 
 	flag.Parse()
+	pge.Verbosity = *arg_verbose
 
 	initGo()
 
-	expr.DumpExprTypes()
+	if pge.Verbosity >= pge.VIter {
+		expr.DumpExprTypes()
+	}
 
 	if *arg_tmp {
 		printBenchLatex()
-		// tmp()
 		return
 	}
 
@@ -62,10 +78,24 @@ func main() {
 		defer pprof.StopCPUProfile()
 	}
 
+	if *arg_evalbench != "" {
+		evalBench(*arg_evalbench)
+		return
+	}
+
+	if *arg_report != "" {
+		if err := writeRunReport(*arg_report); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	// if arg_gen = something, then generate data and exit
 	if *arg_gen != "" {
 		if strings.HasPrefix(strings.ToLower(*arg_gen), "bench") {
-			genBenchmark(*arg_gen)
+			genBenchmark(*arg_gen, *arg_noise)
+		} else if strings.HasPrefix(strings.ToLower(*arg_gen), "diffeq") {
+			genDiffeq(*arg_gen)
 		} else {
 			fmt.Printf("NOT generating %s data, mwahahaha\n", *arg_gen)
 		}
@@ -92,6 +122,25 @@ func main() {
 		return
 	}
 
+	if *arg_bench != "" {
+		runBench(&DS, *arg_bench, *arg_bench_runs, *arg_bench_tol)
+		return
+	}
+
+	if *arg_worker != "" {
+		runWorker(&DS, *arg_worker)
+		return
+	}
+
+	if *arg_serve != "" {
+		runServer(&DS, *arg_serve)
+		return
+	}
+
+	if *arg_tui {
+		DS.dash = newDashboard()
+	}
+
 	initDone := make(chan int)
 
 	DS.Init(initDone, nil)
@@ -102,38 +151,16 @@ func main() {
 }
 
 func initGo() {
-	fmt.Printf("Initializing Go System (%d threads)\n", numProcs)
+	pge.Vprintf(pge.VInfo, "Initializing Go System (%d threads)\n", numProcs)
 	if debug {
 		rt.GOMAXPROCS(2)
-		rand.Seed(0)
+		rngSeed = 0
 	} else {
 		rt.GOMAXPROCS(numProcs)
-		rand.Seed(rand.Int63())
+		rngSeed = time.Now().UnixNano()
 	}
-}
-
-func tmp() {
-
-	T, err := tmplt.ParseFiles("config/prob/prob_template.txt")
-	if err != nil {
-		log.Fatal("Error reading template file: ", err)
-	}
-
-	fmt.Println("T.name: ", T.Name())
-
-	for _, B := range probs.BenchmarkList {
-
-		ftotal, err2 := os.Create("config/prob/bench/" + B.Name + ".cfg")
-		if err2 != nil {
-			fmt.Printf("Error creating config file: %s  %v\n", B.Name, err)
-			return
-		}
-		file := bufio.NewWriter(ftotal)
-
-		err = T.Execute(os.Stdout, B)
-		err = T.Execute(file, B)
-
-		file.Flush()
-		ftotal.Close()
+	if *arg_seed != 0 {
+		rngSeed = *arg_seed
 	}
+	rand.Seed(rngSeed)
 }
@@ -0,0 +1,499 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pge "github.com/verdverm/go-pge/pge"
+	probs "github.com/verdverm/go-pge/problems"
+	results "github.com/verdverm/go-pge/results"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+/* Benchmark suite runner  (pge -bench Nguyen,Koza_1 -runs 10 -scfg a.cfg,b.cfg)
+ *
+ * Runs each selected benchmark -runs times with each search config, as
+ * ordinary runs with their own run dirs. Run r of every benchmark and
+ * config is seeded with -seed + r, so the configs see the same seeds.
+ * Benchmarks are picked by name, by family (Nguyen is every Nguyen_xx)
+ * or with all, and need a problem config in ConfigDir/prob/bench/.
+ *
+ * A run recovered the benchmark when some expression it reported is the
+ * true function. Each reported expression has its coefficients snapped
+ * to nearby simple values, integers and small multiples of the true
+ * function's constants, and is then
+ *
+ *   exact    equal to the true function once both are simplified,
+ *            and agreeing with it on fresh points
+ *   numeric  not equal after simplifying, but agreeing on fresh points
+ *
+ * Agreeing means within -benchtol of the true function's scale at every
+ * one of benchPoints points drawn from the test ranges, none of which are
+ * in the data. An exact match that doesn't agree numerically is not
+ * counted, it means a coefficient snapped to the wrong value.
+ * Only exact matches are recoveries, runs with no more than a numeric
+ * match are counted apart. Benchmarks without an expression, sums up to
+ * a variable, can only match numerically.
+ *
+ * Time and evaluations to solution run up to the end of the iteration
+ * that found the first exact match, from the pge logs.
+ * The per-run and summary tables go to PostDir/bench-<time>/.
+ */
+
+// fresh points for the numerical identity check
+const benchPoints = 1000
+
+const (
+	benchExact   = "exact"
+	benchNumeric = "numeric"
+)
+
+// the scoring of one run
+type benchRun struct {
+	bench  string
+	config string
+	run    int
+	seed   int64
+	dir    string
+
+	method   string // exact, numeric, or empty when neither
+	expr     string // the first exact match, else the first numeric one
+	hitIter  int
+	hitTime  time.Duration
+	hitEvals int
+
+	iters     int
+	time      time.Duration
+	evals     int
+	bestError float64 // of the final reports
+}
+
+func (br *benchRun) recovered() bool { return br.method == benchExact }
+
+// the true function of a benchmark, and the fresh points to check against
+type benchTruth struct {
+	eqn    expr.Expr // as parsed, nil when it has none
+	simp   expr.Expr // simplified, to compare with
+	snaps  []float64 // values coefficients snap to
+	xs     [][]float64
+	ys     []float64
+	scale  float64 // RMS of ys
+	tol    float64
+	nindep int
+}
+
+func runBench(DS *MainSearch, which string, runs int, tol float64) {
+	if which == "list" {
+		printBenchNames()
+		return
+	}
+	benches := selectBenchmarks(which)
+	if len(benches) == 0 {
+		fmt.Printf("no benchmarks match %q\n", which)
+		printBenchNames()
+		return
+	}
+
+	// each -scfg entry is a config to compare, otherwise the main config's searches
+	arms := [][]string{DS.cnfg.srchCfg}
+	if *arg_scfg != "" {
+		arms = nil
+		for _, cfg := range strings.Split(*arg_scfg, ",") {
+			arms = append(arms, []string{strings.TrimSpace(cfg)})
+		}
+	}
+
+	outDir := DS.cnfg.postDir
+	if outDir == "" {
+		outDir = "out/post/"
+	}
+	if !strings.HasSuffix(outDir, "/") {
+		outDir += "/"
+	}
+	stamp, err := makeRunDir(outDir, "bench")
+	if err != nil {
+		log.Fatal(err)
+	}
+	outDir += stamp + "/"
+
+	baseSeed := rngSeed
+	var all []*benchRun
+	for _, B := range benches {
+		probCfg := "prob/bench/" + B.Name + ".cfg"
+		if _, err := os.Stat(DS.cnfg.cfgDir + probCfg); err != nil {
+			fmt.Printf("skipping %s: %v\n", B.Name, err)
+			continue
+		}
+		T, err := newBenchTruth(B, tol, baseSeed)
+		if err != nil {
+			fmt.Printf("skipping %s: %v\n", B.Name, err)
+			continue
+		}
+		for _, arm := range arms {
+			for r := 0; r < runs; r++ {
+				rngSeed = baseSeed + int64(r)
+				rand.Seed(rngSeed)
+
+				RS := new(MainSearch)
+				RS.cnfg = DS.cnfg
+				RS.cfgFile = DS.cfgFile
+				RS.cnfg.probCfg = probCfg
+				RS.cnfg.srchCfg = arm
+				RS.cnfg.eventAddr = "" // can't serve every run on one address
+
+				pge.Vprintf(pge.VInfo, "Bench %s  %s  run %d/%d  seed %d\n", B.Name, armName(arm), r+1, runs, rngSeed)
+				if err := RS.setup(); err != nil {
+					log.Printf("skipping %s  %s  run %d: %v\n", B.Name, armName(arm), r, err)
+					if RS.events != nil {
+						RS.events.close()
+					}
+					continue
+				}
+				RS.Run()
+
+				br, err := scoreBenchRun(RS.logDir, T)
+				if err != nil {
+					log.Printf("scoring %s: %v\n", RS.logDir, err)
+					continue
+				}
+				br.bench, br.config, br.run, br.seed = B.Name, armName(arm), r, rngSeed
+				all = append(all, br)
+				fmt.Printf("%s  %s  run %d: %s\n", br.bench, br.config, r, benchVerdict(br))
+			}
+		}
+	}
+	rngSeed = baseSeed
+
+	rows := benchSummary(all)
+	printBenchTable(rows)
+	if err := writeBenchRuns(all, outDir+"runs.csv"); err != nil {
+		log.Println(err)
+	}
+	if err := writeCSV(outDir+"summary.csv", rows); err != nil {
+		log.Println(err)
+	}
+	fmt.Printf("\nbench tables in %s\n", outDir)
+}
+
+// selectBenchmarks picks from the list by name or family, or all of them
+func selectBenchmarks(which string) []probs.Benchmark {
+	var sel []probs.Benchmark
+	for _, D := range probs.BuiltinDefs() {
+		B := D.Benchmark
+		for _, w := range strings.Split(which, ",") {
+			w = strings.TrimSpace(w)
+			if w == "all" || B.Name == w || strings.HasPrefix(B.Name, w+"_") {
+				sel = append(sel, B)
+				break
+			}
+		}
+	}
+	return sel
+}
+
+func armName(arm []string) string {
+	names := make([]string, len(arm))
+	for i, cfg := range arm {
+		names[i] = strings.TrimSuffix(filepath.Base(cfg), ".cfg")
+	}
+	return strings.Join(names, "+")
+}
+
+func benchVerdict(br *benchRun) string {
+	if br.method == "" {
+		return fmt.Sprintf("not recovered  best error %s", fmtFloat(br.bestError))
+	}
+	return fmt.Sprintf("%s at iter %d  %v  %d evals  %s", br.method, br.hitIter,
+		br.hitTime.Truncate(time.Millisecond), br.hitEvals, br.expr)
+}
+
+func newBenchTruth(B probs.Benchmark, tol float64, seed int64) (*benchTruth, error) {
+	T := &benchTruth{tol: tol, nindep: len(B.TrainVars)}
+	f := B.Evaluator()
+
+	// benchmarks with sums up to a variable have no expression,
+	// those can only be matched numerically
+	if T.eqn = B.Func(); T.eqn != nil {
+		T.simp = T.eqn.Clone().Simplify(expr.DefaultRules())
+		T.simp.Sort()
+		code, err := pge.EncodeExpr(T.eqn)
+		if err != nil {
+			return nil, err
+		}
+		T.snaps = benchSnaps(code.Vals)
+	} else {
+		T.snaps = benchSnaps(nil)
+	}
+
+	// the test ranges, sampled uniformly even when the data is a grid
+	vars := B.TestVars
+	if len(vars) == 0 {
+		vars = B.TrainVars
+	}
+	rng := rand.New(rand.NewSource(seed))
+	sumsq := 0.0
+	for tries := 0; len(T.ys) < benchPoints && tries < 100*benchPoints; tries++ {
+		x := make([]float64, len(vars))
+		for j, v := range vars {
+			x[j] = v.L + rng.Float64()*(v.H-v.L)
+		}
+		y := f(x)
+		if !isFinite(y) || math.Abs(y) > 100000.0 {
+			continue
+		}
+		T.xs = append(T.xs, x)
+		T.ys = append(T.ys, y)
+		sumsq += y * y
+	}
+	if len(T.ys) == 0 {
+		return nil, fmt.Errorf("no finite points in the test ranges")
+	}
+	T.scale = math.Sqrt(sumsq / float64(len(T.ys)))
+	if T.scale < 1 {
+		T.scale = 1
+	}
+	return T, nil
+}
+
+// benchSnaps lists the simple values near which a coefficient is taken
+// to be that value: halves from -10 to 10, and each constant of the
+// true function times and over 1 to 10, with both signs
+func benchSnaps(consts []float64) []float64 {
+	var snaps []float64
+	for h := -20; h <= 20; h++ {
+		snaps = append(snaps, float64(h)/2)
+	}
+	for _, v := range consts {
+		if v == 0 || !isFinite(v) {
+			continue
+		}
+		for k := 1.0; k <= 10; k++ {
+			snaps = append(snaps, k*v, -k*v, v/k, -v/k)
+		}
+	}
+	sort.Float64s(snaps)
+	return snaps
+}
+
+func (T *benchTruth) snap(c float64) float64 {
+	best, dist := c, math.Inf(1)
+	for _, s := range T.snaps {
+		if d := math.Abs(c - s); d < dist {
+			best, dist = s, d
+		}
+	}
+	if dist <= T.tol*math.Max(1, math.Abs(best)) {
+		return best
+	}
+	return c
+}
+
+// check says how e with coeff matches the true function: exact, numeric or not at all
+func (T *benchTruth) check(e expr.Expr, coeff []float64) string {
+	snapped := make([]float64, len(coeff))
+	for i, c := range coeff {
+		snapped[i] = T.snap(c)
+	}
+
+	for i, x := range T.xs {
+		if len(x) < T.nindep {
+			return ""
+		}
+		y := e.Eval(0, x, snapped, nil)
+		if !isFinite(y) || math.Abs(y-T.ys[i]) > T.tol*T.scale {
+			return ""
+		}
+	}
+
+	if T.simp == nil {
+		return benchNumeric
+	}
+	cand := e.Clone().ConvertToConstantFs(snapped)
+	if cand != nil {
+		cand = cand.Simplify(expr.DefaultRules())
+		cand.Sort()
+		if cand.AmIEqual(T.simp) {
+			return benchExact
+		}
+	}
+	return benchNumeric
+}
+
+// scoreBenchRun checks every expression a finished run reported
+func scoreBenchRun(dir string, T *benchTruth) (*benchRun, error) {
+	if !strings.HasSuffix(dir, "/") {
+		dir += "/"
+	}
+	EL, err := results.Load(dir + "pge/pge:eqns.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	IL, err := results.Load(dir + "pge/pge:iters.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	started := EL.Run.Started
+	if data, err := ioutil.ReadFile(dir + manifestName); err == nil {
+		var M runManifest
+		if json.Unmarshal(data, &M) == nil {
+			started = M.Started
+		}
+	}
+
+	br := &benchRun{dir: dir, hitIter: -1, bestError: math.Inf(1)}
+	for _, it := range IL.Iters {
+		br.iters = it.Iter
+		br.evals += it.Evals
+		br.time = it.Time.Sub(started)
+	}
+	for _, R := range EL.Final() {
+		if v := float64(R.TestError); v < br.bestError {
+			br.bestError = v
+		}
+	}
+
+	// each distinct expression once, in the order they were found
+	reps := make([]*results.Report, 0, len(EL.Reports))
+	seen := make(map[string]bool)
+	for _, R := range EL.Reports {
+		key := R.Expr + fmt.Sprint(R.Coeff)
+		if !seen[key] {
+			seen[key] = true
+			reps = append(reps, R)
+		}
+	}
+	sort.SliceStable(reps, func(a, b int) bool { return reps[a].IterID < reps[b].IterID })
+
+	for _, R := range reps {
+		if br.recovered() && R.IterID > br.hitIter {
+			break
+		}
+		e, err := pge.DecodeResults(R)
+		if err != nil {
+			continue
+		}
+		method := T.check(e, R.Coefficients())
+		// the first exact match, failing that the first numeric one
+		if method == benchExact && !br.recovered() || method == benchNumeric && br.method == "" {
+			br.method, br.hitIter, br.expr = method, R.IterID, R.Pretty
+			if br.expr == "" {
+				br.expr = R.Expr
+			}
+		}
+	}
+
+	if br.method != "" {
+		for _, it := range IL.Iters {
+			if it.Iter > br.hitIter {
+				break
+			}
+			br.hitEvals += it.Evals
+			br.hitTime = it.Time.Sub(started)
+		}
+	}
+	return br, nil
+}
+
+// benchSummary tabulates the runs per benchmark and config, header first
+func benchSummary(all []*benchRun) [][]string {
+	rows := [][]string{{"bench", "config", "runs", "recovered", "rate", "numeric_only",
+		"iters_med", "time_med", "evals_med", "run_evals_med", "run_time_med"}}
+
+	type key struct{ bench, config string }
+	var order []key
+	groups := make(map[key][]*benchRun)
+	for _, br := range all {
+		k := key{br.bench, br.config}
+		if groups[k] == nil {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], br)
+	}
+
+	for _, k := range order {
+		G := groups[k]
+		var hitIters, hitTimes, hitEvals, evals, times []float64
+		hits, numeric := 0, 0
+		for _, br := range G {
+			evals = append(evals, float64(br.evals))
+			times = append(times, br.time.Seconds())
+			if br.method == benchNumeric {
+				numeric++
+			}
+			if !br.recovered() {
+				continue
+			}
+			hits++
+			hitIters = append(hitIters, float64(br.hitIter))
+			hitTimes = append(hitTimes, br.hitTime.Seconds())
+			hitEvals = append(hitEvals, float64(br.hitEvals))
+		}
+		rows = append(rows, []string{
+			k.bench, k.config,
+			strconv.Itoa(len(G)),
+			strconv.Itoa(hits),
+			fmtFloat(float64(hits) / float64(len(G))),
+			strconv.Itoa(numeric),
+			fmtFloat(summarize(hitIters).median),
+			fmtFloat(summarize(hitTimes).median),
+			fmtFloat(summarize(hitEvals).median),
+			fmtFloat(summarize(evals).median),
+			fmtFloat(summarize(times).median),
+		})
+	}
+	return rows
+}
+
+func printBenchTable(rows [][]string) {
+	if len(rows) < 2 {
+		fmt.Println("\nno runs scored")
+		return
+	}
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			if len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+	fmt.Println()
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			if i < 2 {
+				cells[i] = fmt.Sprintf("%-*s", widths[i], cell)
+			} else {
+				cells[i] = fmt.Sprintf("%*s", widths[i], cell)
+			}
+		}
+		fmt.Println(strings.Join(cells, "  "))
+	}
+}
+
+func writeBenchRuns(all []*benchRun, filename string) error {
+	rows := [][]string{{"bench", "config", "run", "seed", "match", "hit_iter", "hit_time",
+		"hit_evals", "iters", "time", "evals", "best_error", "expr", "dir"}}
+	for _, br := range all {
+		row := []string{br.bench, br.config, strconv.Itoa(br.run), strconv.FormatInt(br.seed, 10), br.method}
+		if br.method != "" {
+			row = append(row, strconv.Itoa(br.hitIter), fmtFloat(br.hitTime.Seconds()), strconv.Itoa(br.hitEvals))
+		} else {
+			row = append(row, "", "", "")
+		}
+		row = append(row, strconv.Itoa(br.iters), fmtFloat(br.time.Seconds()), strconv.Itoa(br.evals),
+			fmtFloat(br.bestError), br.expr, br.dir)
+		rows = append(rows, row)
+	}
+	return writeCSV(filename, rows)
+}
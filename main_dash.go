@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pge "github.com/verdverm/go-pge/pge"
+	probs "github.com/verdverm/go-pge/problems"
+)
+
+/* Terminal dashboard  (pge -tui)
+ *
+ * Redrawn from MainSearch.checkMessages with what arrives on the
+ * ExprProblemComm channels: the IterStats of every search and the best
+ * reports. While it is up, the prints of pge.Vprintf and the log package
+ * go to main:stdout.log in the run's log dir so they don't tear the
+ * screen, os.Stdout itself is left alone.
+ * The first Ctrl-C stops the search as if it reached MaxIter, the
+ * second gives the terminal back and exits.
+ */
+
+// time between redraws
+const dashRefresh = 250 * time.Millisecond
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+type dashboard struct {
+	tty     *os.File // the real stdout
+	logOut  *os.File
+	prevOut io.Writer // pge's output before open
+
+	start    time.Time
+	drawn    time.Time
+	problem  string
+	maxIter  int
+	stats    map[int]*probs.IterStats // last of each search
+	minErrs  []float64                // min error of search 0 by iteration
+	front    []frontEntry
+	stopping bool
+
+	sigs chan os.Signal
+	done chan struct{}
+	once sync.Once
+}
+
+func newDashboard() *dashboard {
+	return &dashboard{
+		tty:   os.Stdout,
+		stats: make(map[int]*probs.IterStats),
+	}
+}
+
+// open takes over the terminal, called when Init is done
+func (D *dashboard) open(DS *MainSearch) {
+	D.start = time.Now()
+	D.problem = DS.prob.Name
+	D.maxIter = DS.prob.MaxIter
+
+	f, err := os.Create(DS.logDir + "main:stdout.log")
+	if err == nil {
+		D.logOut = f
+		D.prevOut = pge.SetOutput(f)
+		log.SetOutput(f)
+	}
+
+	// alternate screen, hidden cursor
+	fmt.Fprint(D.tty, "\x1b[?1049h\x1b[?25l")
+
+	D.sigs = make(chan os.Signal, 2)
+	D.done = make(chan struct{})
+	signal.Notify(D.sigs, os.Interrupt)
+	go func() {
+		select {
+		case <-D.sigs:
+		case <-D.done:
+			return
+		}
+		DS.Stop()
+		select {
+		case <-D.sigs:
+			D.close()
+			os.Exit(1)
+		case <-D.done:
+		}
+	}()
+	D.draw(true)
+}
+
+// close gives the terminal back
+func (D *dashboard) close() {
+	D.once.Do(func() {
+		close(D.done)
+		signal.Stop(D.sigs)
+		fmt.Fprint(D.tty, "\x1b[?25h\x1b[?1049l")
+		if D.logOut != nil {
+			pge.SetOutput(D.prevOut)
+			log.SetOutput(os.Stderr)
+			D.logOut.Close()
+		}
+	})
+}
+
+func (D *dashboard) iter(st *probs.IterStats) {
+	D.stats[st.ProcID] = st
+	if st.ProcID == 0 {
+		D.minErrs = append(D.minErrs, st.MinError)
+	}
+}
+
+func (D *dashboard) setFront(P *probs.ExprProblem, eqns probs.ExprReportArray) {
+	D.front = frontEntries(P, eqns)
+}
+
+// draw redraws the screen, at most every dashRefresh unless forced
+func (D *dashboard) draw(force bool) {
+	now := time.Now()
+	if !force && now.Sub(D.drawn) < dashRefresh {
+		return
+	}
+	D.drawn = now
+	W, H := terminalSize(D.tty)
+
+	var b bytes.Buffer
+	line := func(format string, a ...interface{}) {
+		s := fmt.Sprintf(format, a...)
+		if r := []rune(s); len(r) > W {
+			s = string(r[:W])
+		}
+		b.WriteString(s)
+		b.WriteString("\x1b[K\r\n")
+	}
+
+	// totals over the searches
+	iter, evals, discards, queue, neqns, memo, vst := 0, 0, 0, 0, 0, 0, 0
+	var secs float64
+	minErr := math.Inf(1)
+	for _, st := range D.stats {
+		if st.Iter > iter {
+			iter = st.Iter
+		}
+		evals += st.Evals
+		discards += st.Discards
+		queue += st.Queue
+		neqns += st.Neqns
+		memo += st.MemoCnt
+		vst += st.MemoVst
+		secs += st.Elapsed.Seconds()
+		minErr = math.Min(minErr, st.MinError)
+	}
+	rate := 0.0
+	if secs > 0 {
+		rate = float64(evals) / secs * float64(len(D.stats))
+	}
+
+	b.WriteString("\x1b[H")
+	state := "running"
+	if D.stopping {
+		state = "stopping"
+	}
+	line("PGE  %s   iter %d / %d   %s   %s", D.problem, iter, D.maxIter,
+		time.Since(D.start).Truncate(time.Second), state)
+	line("evals/s %9.1f   evals %6d   discarded %6d   searches %d", rate, evals, discards, len(D.stats))
+	line("queue %9d   memo %9d   memo visits %9d   reports %9d", queue, memo, vst, neqns)
+	line("min error %-12.6g %s", minErr, sparkline(D.minErrs, W-23))
+	line("%s", strings.Repeat("─", W))
+	line("%5s  %-12s %-12s %s", "size", "test err", "train err", "equation")
+
+	rows := H - 7
+	for i, F := range D.front {
+		if i >= rows {
+			break
+		}
+		line("%5d  %-12s %-12s %s", F.Size, fmtErr(F.TestError), fmtErr(F.TrainError), F.Pretty)
+	}
+	b.WriteString("\x1b[J")
+	D.tty.Write(b.Bytes())
+}
+
+func fmtErr(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return strconv.FormatFloat(*v, 'g', 6, 64)
+}
+
+// sparkline of the last width values on a log scale,
+// non-finite or non-positive values are left blank
+func sparkline(vals []float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if len(vals) > width {
+		vals = vals[len(vals)-width:]
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	logs := make([]float64, len(vals))
+	for i, v := range vals {
+		logs[i] = math.NaN()
+		if v > 0 && !math.IsInf(v, 0) {
+			logs[i] = math.Log10(v)
+			lo = math.Min(lo, logs[i])
+			hi = math.Max(hi, logs[i])
+		}
+	}
+	out := make([]rune, len(vals))
+	for i, l := range logs {
+		switch {
+		case math.IsNaN(l):
+			out[i] = ' '
+		case hi == lo:
+			out[i] = sparkBlocks[len(sparkBlocks)/2]
+		default:
+			k := int((l - lo) / (hi - lo) * float64(len(sparkBlocks)-1))
+			out[i] = sparkBlocks[k]
+		}
+	}
+	return string(out)
+}
+
+// terminalSize asks the terminal, then $COLUMNS and $LINES, then guesses
+func terminalSize(f *os.File) (w, h int) {
+	if w, h, ok := ttySize(f); ok && w > 0 && h > 0 {
+		return w, h
+	}
+	w, _ = strconv.Atoi(os.Getenv("COLUMNS"))
+	h, _ = strconv.Atoi(os.Getenv("LINES"))
+	if w <= 0 {
+		w = 100
+	}
+	if h <= 0 {
+		h = 30
+	}
+	return w, h
+}
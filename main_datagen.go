@@ -1,54 +1,238 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
 	"strings"
+	"text/template"
 
 	probs "github.com/verdverm/go-pge/problems"
 	expr "github.com/verdverm/go-symexpr"
 )
 
-func genBenchmark(probname string) {
+// where -gen bench writes
+const (
+	benchDataDir = "data/benchmark/"
+	benchCfgDir  = "config/prob/bench/"
+	benchCfgTmpl = "config/prob/prob_template.txt"
+)
+
+// the first line of the problem configs -gen writes,
+// configs without it were written by hand and are kept
+const genCfgMark = "# generated by -gen"
+
+// genBenchmark writes the data and problem config of the benchmarks
+// named by probname: one built in, all of them, or those
+// defined in a file ending in .cfg (see problems/benchfile.go).
+// A noise spec, when given, replaces that of every benchmark.
+func genBenchmark(probname, noise string) {
 	fmt.Printf("Generating files for %s\n", probname)
 	bname := probname[strings.Index(probname, ":")+1:]
 	fmt.Printf("bname:  '%s'\n", bname)
-	benches := probs.BenchmarkList
-	switch bname {
-	case "all":
-		for i := 0; i < len(benches); i++ {
-			fmt.Printf("bm: %v\n", benches[i])
-			bname = benches[i].Name
-			symprob := probs.GenBenchmark(benches[i])
-			symprob.Train[0].WritePointSet("data/benchmark/" + bname + ".trn")
-			symprob.Test[0].WritePointSet("data/benchmark/" + bname + ".tst")
-			fmt.Println()
-		}
-	case "list":
+
+	var defs []*probs.BenchmarkDef
+	source := "the built-in list"
+	switch {
+	case bname == "list":
 		printBenchNames()
+		return
+	case strings.HasSuffix(bname, ".cfg"):
+		data, err := ioutil.ReadFile(bname)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if defs, err = probs.ReadBenchmarkDefs(data); err != nil {
+			log.Fatalf("%s: %v\n", bname, err)
+		}
+		source = bname
 	default:
-		i := 0
-		for ; i < len(benches); i++ {
-			if benches[i].Name == bname {
-				fmt.Printf("bm: %v\n", benches[i])
-				symprob := probs.GenBenchmark(benches[i])
-				symprob.Train[0].WritePointSet("data/benchmark/" + bname + ".trn")
-				symprob.Test[0].WritePointSet("data/benchmark/" + bname + ".tst")
-				fmt.Println()
-				return
+		for _, D := range probs.BuiltinDefs() {
+			if bname == "all" || D.Name == bname {
+				defs = append(defs, D)
 			}
 		}
-		if i == len(benches) {
+		if len(defs) == 0 {
 			fmt.Printf("benchmark problem not found:  %s\n", probname)
 			printBenchNames()
+			return
+		}
+	}
+
+	if noise != "" {
+		N, err := probs.ParseNoise(noise)
+		if err != nil {
+			log.Fatalf("-noise: %v\n", err)
+		}
+		for _, D := range defs {
+			D.Noise = N
+		}
+	}
+	for _, D := range defs {
+		if D.Seed == 0 {
+			D.Seed = rngSeed
+		}
+	}
+
+	T, err := template.ParseFiles(benchCfgTmpl)
+	if err != nil {
+		log.Fatal("Error reading template file: ", err)
+	}
+	for _, D := range defs {
+		fmt.Printf("bm: %v\n", D.Benchmark)
+		symprob := D.Generate()
+		if err := writeTrainTest(symprob.Train[0], symprob.Test[0], benchDataDir+D.Name); err != nil {
+			fmt.Printf("Error writing data for %s: %v\n", D.Name, err)
+			continue
+		}
+		if err := writeBenchConfig(T, D, source); err != nil {
+			fmt.Printf("Error writing config for %s: %v\n", D.Name, err)
+		}
+		fmt.Println()
+	}
+}
+
+// writeTrainTest writes the .trn and .tst files of base
+func writeTrainTest(train, test *probs.PointSet, base string) error {
+	if err := train.WritePointSet(base + ".trn"); err != nil {
+		return err
+	}
+	return test.WritePointSet(base + ".tst")
+}
+
+// writeBenchConfig fills the problem config template for D
+func writeBenchConfig(T *template.Template, D *probs.BenchmarkDef, source string) error {
+	header := fmt.Sprintf("%s from %s\n", genCfgMark, source)
+	if !D.Noise.IsZero() {
+		header += fmt.Sprintf("# training data noise: %v\n", D.Noise)
+	}
+	if D.Unit != "" {
+		var units []string
+		for _, v := range D.TrainVars {
+			units = append(units, v.Name+": "+v.Unit)
+		}
+		header += fmt.Sprintf("# units: %s -> %s\n", strings.Join(units, "; "), D.Unit)
+	}
+	return writeGenConfig(T, D, benchCfgDir+D.Name+".cfg", header)
+}
+
+// writeGenConfig fills the template T with data, after header which must
+// start with genCfgMark, unless filename is a hand-written config already
+func writeGenConfig(T *template.Template, data interface{}, filename, header string) error {
+	if old, err := ioutil.ReadFile(filename); err == nil && !bytes.HasPrefix(old, []byte(genCfgMark)) {
+		fmt.Printf("Keeping hand-written config: %s\n", filename)
+		return nil
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	if err := T.Execute(&b, data); err != nil {
+		return err
+	}
+	fmt.Printf("Writing file: %s\n", filename)
+	return ioutil.WriteFile(filename, b.Bytes(), 0644)
+}
+
+// where -gen diffeq writes, the data directory holds the diffeq directory
+const (
+	genDataDir    = "data/"
+	diffeqDataDir = "diffeq/"
+	diffeqCfgDir  = "config/prob/diffeq/"
+	diffeqCfgTmpl = "config/prob/diffeq_template.txt"
+)
+
+// the fields of the diffeq config template, a config for each state
+type diffeqCfg struct {
+	Name, Var          string
+	SearchVar          int
+	TrainFns, TestFns  []string
+	UsableVars         []int
+	Functions, NonTrig []string
+}
+
+// genDiffeq integrates the systems named by probname, one from
+// DiffeqList or all of them, writing a training and testing file for
+// each parameter set and a problem config for each state
+func genDiffeq(probname string) {
+	fmt.Printf("Generating files for %s\n", probname)
+	sname := probname[strings.Index(probname, ":")+1:]
+
+	var systems []*probs.DiffeqSystem
+	if sname == "list" {
+		printDiffeqNames()
+		return
+	}
+	for i := range probs.DiffeqList {
+		if sname == "all" || probs.DiffeqList[i].Name == sname {
+			systems = append(systems, &probs.DiffeqList[i])
+		}
+	}
+	if len(systems) == 0 {
+		fmt.Printf("diffeq problem not found:  %s\n", probname)
+		printDiffeqNames()
+		return
+	}
+
+	T, err := template.ParseFiles(diffeqCfgTmpl)
+	if err != nil {
+		log.Fatal("Error reading template file: ", err)
+	}
+	for _, dir := range []string{genDataDir + diffeqDataDir, diffeqCfgDir} {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			log.Fatal(err)
 		}
 	}
 
+	for _, S := range systems {
+		train, test, err := S.Generate(rngSeed)
+		if err != nil {
+			fmt.Printf("Error generating %s: %v\n", S.Name, err)
+			continue
+		}
+
+		cfg := diffeqCfg{Functions: S.Functions, NonTrig: S.NonTrig}
+		for j := range S.Vars {
+			cfg.UsableVars = append(cfg.UsableVars, j)
+		}
+		for k := range train {
+			fn := fmt.Sprintf("%s%s_%d", diffeqDataDir, S.Name, k)
+			if err = writeTrainTest(train[k], test[k], genDataDir+fn); err != nil {
+				break
+			}
+			cfg.TrainFns = append(cfg.TrainFns, fn+".trn")
+			cfg.TestFns = append(cfg.TestFns, fn+".tst")
+		}
+		if err != nil {
+			fmt.Printf("Error writing data for %s: %v\n", S.Name, err)
+			continue
+		}
+
+		for j, v := range S.Vars {
+			cfg.Name, cfg.Var, cfg.SearchVar = S.Name+"_"+v, v, j
+			header := fmt.Sprintf("%s from DiffeqList\n# d(%s) = %s\n", genCfgMark, v, S.Funcs[j])
+			if err := writeGenConfig(T, cfg, diffeqCfgDir+cfg.Name+".cfg", header); err != nil {
+				fmt.Printf("Error writing config for %s: %v\n", cfg.Name, err)
+			}
+		}
+		fmt.Println()
+	}
+}
+
+func printDiffeqNames() {
+	systems := probs.DiffeqList
+	fmt.Printf("Available Diffeqs:  |%d|\n[ %s", len(systems), systems[0].Name)
+	for i := 1; i < len(systems); i++ {
+		fmt.Printf(", %s", systems[i].Name)
+	}
+	fmt.Println(" ]")
 }
 
 func printBenchNames() {
-	benches := probs.BenchmarkList
+	benches := probs.BuiltinDefs()
 	fmt.Printf("Available Benchmarks:  |%d|\n[ %s", len(benches), benches[0].Name)
-	for i := 0; i < len(benches); i++ {
+	for i := 1; i < len(benches); i++ {
 		fmt.Printf(", %s", benches[i].Name)
 	}
 	fmt.Println(" ]")
@@ -56,14 +240,15 @@ func printBenchNames() {
 
 func printBenchLatex() {
 
-	for _, B := range probs.BenchmarkList {
+	for _, D := range probs.BuiltinDefs() {
+		B := D.Benchmark
 
-		varNames := make([]string, 0)
-		for _, v := range B.TrainVars {
-			// fmt.Printf("  %v\n", v)
-			varNames = append(varNames, v.Name)
+		varNames := B.VarNames()
+		eqn := B.Func()
+		if eqn == nil {
+			fmt.Println(B.Name, " \t&\t $", B.FuncText, "$ \\\\")
+			continue
 		}
-		eqn := expr.ParseFunc(B.FuncText, varNames)
 		sort := eqn.Clone()
 		rules := expr.DefaultRules()
 		rules.GroupAddTerms = false
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/verdverm/go-pge/pge"
+	probs "github.com/verdverm/go-pge/problems"
+)
+
+// evaluations per measurement, spread over the repetitions
+const evalBenchPoints = 2000000
+
+// evalBench times tree evaluation against the compiled programs
+// on the training data of the benchmark problems
+func evalBench(bname string) {
+	fmt.Printf("%-16s %8s %12s %12s %8s %10s\n", "problem", "points", "tree ns/pt", "prog ns/pt", "speedup", "max diff")
+
+	var treeTot, progTot time.Duration
+	for _, D := range probs.BuiltinDefs() {
+		B := D.Benchmark
+		if bname != "all" && B.Name != bname {
+			continue
+		}
+		P := probs.GenBenchmark(B)
+		PS := P.Train[0]
+		e := P.FuncTree
+		N := PS.NumPoints()
+		if N == 0 || e == nil {
+			continue
+		}
+		prog, err := pge.Compile(e)
+		if err != nil {
+			fmt.Printf("%-16s compile error: %v\n", B.Name, err)
+			continue
+		}
+		reps := evalBenchPoints/N + 1
+
+		treeOut := make([]float64, N)
+		x := make([]float64, PS.NumIndep())
+		start := time.Now()
+		for r := 0; r < reps; r++ {
+			for p := 0; p < N; p++ {
+				x = PS.Row(p, x)
+				treeOut[p] = e.Eval(0, x, nil, PS.SysVals())
+			}
+		}
+		treeT := time.Since(start)
+
+		progOut := make([]float64, N)
+		start = time.Now()
+		for r := 0; r < reps; r++ {
+			prog.EvalColumns(nil, PS.IndepCols(), nil, PS.SysVals(), progOut)
+		}
+		progT := time.Since(start)
+
+		maxDiff := 0.0
+		for p := range treeOut {
+			if d := math.Abs(treeOut[p] - progOut[p]); d > maxDiff {
+				maxDiff = d
+			}
+		}
+
+		evals := float64(reps * N)
+		fmt.Printf("%-16s %8d %12.1f %12.1f %7.1fx %10.3g\n", B.Name, N,
+			float64(treeT.Nanoseconds())/evals, float64(progT.Nanoseconds())/evals,
+			treeT.Seconds()/progT.Seconds(), maxDiff)
+		treeTot += treeT
+		progTot += progT
+	}
+	if progTot > 0 {
+		fmt.Printf("\ntotal: tree %v  prog %v  speedup %.1fx\n", treeTot, progTot, treeTot.Seconds()/progTot.Seconds())
+	}
+}
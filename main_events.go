@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	probs "github.com/verdverm/go-pge/problems"
+)
+
+/* Progress events
+ *
+ * While a MainSearch runs it emits one JSON object per line:
+ *
+ *   start  problem and max_iter
+ *   iter   one per search iteration: timing, evaluations, queue size,
+ *          memo counts and min error, from ExprProblemComm.Stats
+ *   front  the best reports that entered and left the front
+ *   done   the search finished, stopped tells if it was asked to
+ *
+ * Every event has v (the format version), type and time. The stream is
+ * written to EventLog in the run's log dir as newline-delimited JSON
+ * and, with EventAddr set, served at /events as Server-Sent Events.
+ * SSE clients that connect late get the events so far replayed first.
+ */
+
+const eventVersion = 1
+
+// keep this many past events for SSE clients that connect late
+const eventHistory = 10000
+
+type searchEvent struct {
+	V    int       `json:"v"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+	Iter int       `json:"iter"`
+
+	Problem string `json:"problem,omitempty"`
+	MaxIter int    `json:"max_iter,omitempty"`
+	Stopped bool   `json:"stopped,omitempty"`
+
+	*iterEvent
+	*frontEvent
+}
+
+type iterEvent struct {
+	Search      int      `json:"search"`
+	ElapsedMs   float64  `json:"elapsed_ms"`
+	Evals       int      `json:"evals"`
+	EvalsPerSec float64  `json:"evals_per_sec"`
+	Discards    int      `json:"discards"`
+	Queue       int      `json:"queue"`
+	Neqns       int      `json:"neqns"`
+	MemoCnt     int      `json:"memo"`
+	MemoVst     int      `json:"memo_visits"`
+	MinError    *float64 `json:"min_error"`
+}
+
+type frontEvent struct {
+	Added     []frontEntry `json:"added"`
+	Removed   []frontEntry `json:"removed"`
+	FrontSize int          `json:"front_size"`
+}
+
+type eventStream struct {
+	sync.Mutex
+	file    *os.File
+	buf     *bufio.Writer
+	history [][]byte
+	subs    map[chan []byte]bool
+	closed  bool
+}
+
+func newEventStream() *eventStream {
+	return &eventStream{subs: make(map[chan []byte]bool)}
+}
+
+// openFile starts writing the events, including those so far, to filename
+func (es *eventStream) openFile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	es.Lock()
+	defer es.Unlock()
+	es.file = f
+	es.buf = bufio.NewWriter(f)
+	for _, line := range es.history {
+		es.buf.Write(line)
+		es.buf.WriteByte('\n')
+	}
+	return es.buf.Flush()
+}
+
+func (es *eventStream) emit(ev *searchEvent) {
+	ev.V = eventVersion
+	ev.Time = time.Now()
+	line, err := json.Marshal(ev)
+	if err != nil {
+		log.Printf("event %s: %v\n", ev.Type, err)
+		return
+	}
+
+	es.Lock()
+	defer es.Unlock()
+	if es.closed {
+		return
+	}
+	if len(es.history) == eventHistory {
+		es.history = es.history[1:]
+	}
+	es.history = append(es.history, line)
+	if es.buf != nil {
+		es.buf.Write(line)
+		es.buf.WriteByte('\n')
+		// flushed per event so the file can be followed
+		es.buf.Flush()
+	}
+	for ch := range es.subs {
+		select {
+		case ch <- line:
+		default:
+			// too slow, let it reconnect
+			delete(es.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// close ends the stream for the file and all SSE clients
+func (es *eventStream) close() {
+	es.Lock()
+	defer es.Unlock()
+	if es.closed {
+		return
+	}
+	es.closed = true
+	for ch := range es.subs {
+		delete(es.subs, ch)
+		close(ch)
+	}
+	if es.file != nil {
+		es.buf.Flush()
+		es.file.Close()
+	}
+}
+
+// subscribe returns the events so far and a channel for the rest,
+// which is closed when the stream ends or the subscriber falls behind
+func (es *eventStream) subscribe() ([][]byte, chan []byte) {
+	es.Lock()
+	defer es.Unlock()
+	past := append([][]byte(nil), es.history...)
+	ch := make(chan []byte, 256)
+	if es.closed {
+		close(ch)
+	} else {
+		es.subs[ch] = true
+	}
+	return past, ch
+}
+
+func (es *eventStream) unsubscribe(ch chan []byte) {
+	es.Lock()
+	defer es.Unlock()
+	if es.subs[ch] {
+		delete(es.subs, ch)
+		close(ch)
+	}
+}
+
+// ServeHTTP streams the events as Server-Sent Events
+func (es *eventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	past, ch := es.subscribe()
+	defer es.unsubscribe(ch)
+	for _, line := range past {
+		fmt.Fprintf(w, "data: %s\n\n", line)
+	}
+	flusher.Flush()
+
+	for {
+		select {
+		case line, ok := <-ch:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", line)
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// serveEvents serves the stream at addr/events until the process exits
+func serveEvents(es *eventStream, addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/events", es)
+	fmt.Printf("Serving events on %s/events\n", addr)
+	go func() {
+		log.Println(http.ListenAndServe(addr, mux))
+	}()
+}
+
+func (DS *MainSearch) iterEvent(st *probs.IterStats) {
+	secs := st.Elapsed.Seconds()
+	rate := 0.0
+	if secs > 0 {
+		rate = float64(st.Evals) / secs
+	}
+	DS.events.emit(&searchEvent{
+		Type: "iter",
+		Iter: st.Iter,
+		iterEvent: &iterEvent{
+			Search:      st.ProcID,
+			ElapsedMs:   secs * 1000,
+			Evals:       st.Evals,
+			EvalsPerSec: rate,
+			Discards:    st.Discards,
+			Queue:       st.Queue,
+			Neqns:       st.Neqns,
+			MemoCnt:     st.MemoCnt,
+			MemoVst:     st.MemoVst,
+			MinError:    finite(st.MinError),
+		},
+	})
+}
+
+// frontEvent compares the best reports with those of the last call
+// and emits the differences, keyed on the expression's string form
+func (DS *MainSearch) frontEvent() {
+	cur := frontEntries(DS.prob, DS.eqns)
+	seen := make(map[string]frontEntry, len(cur))
+	for _, F := range cur {
+		seen[F.Expr] = F
+	}
+
+	fe := &frontEvent{FrontSize: len(cur)}
+	for _, F := range cur {
+		if _, ok := DS.lastFront[F.Expr]; !ok {
+			fe.Added = append(fe.Added, F)
+		}
+	}
+	for key, F := range DS.lastFront {
+		if _, ok := seen[key]; !ok {
+			fe.Removed = append(fe.Removed, F)
+		}
+	}
+	sort.Slice(fe.Removed, func(i, j int) bool { return fe.Removed[i].Size < fe.Removed[j].Size })
+	DS.lastFront = seen
+	if len(fe.Added) == 0 && len(fe.Removed) == 0 {
+		return
+	}
+	DS.events.emit(&searchEvent{Type: "front", Iter: DS.iter[0], frontEvent: fe})
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	config "github.com/verdverm/go-pge/config"
+)
+
+/* Run manifest
+ *
+ * Every run gets its own directory  LogDir/<Name>/<search>-<start time>/
+ * holding manifest.json, which records what produced the run: the main,
+ * problem and search configs as resolved after the command line
+ * overrides, the seed, the hashes of the data files and of the binary,
+ * and the Go runtime. It is written when the searches are set up and
+ * again when the run ends, so a manifest without Finished is a run that
+ * crashed or is still going.
+ */
+
+const manifestVersion = 1
+
+const manifestName = "manifest.json"
+
+type runManifest struct {
+	V        int        `json:"v"`
+	RunID    string     `json:"run_id"`
+	LogDir   string     `json:"log_dir"`
+	Started  time.Time  `json:"started"`
+	Finished *time.Time `json:"finished,omitempty"`
+	Iter     int        `json:"iter"`
+	Stopped  bool       `json:"stopped,omitempty"`
+
+	Args      []string          `json:"args"`
+	Overrides map[string]string `json:"overrides,omitempty"` // flags given on the command line
+	Seed      int64             `json:"seed"`
+
+	Main    manifestConfig   `json:"main"`
+	Problem manifestConfig   `json:"problem"`
+	Search  []manifestConfig `json:"search"`
+
+	Data        []manifestFile `json:"data"`
+	Fingerprint string         `json:"fingerprint"` // of the data as loaded, see problems.ExprProblem.Fingerprint
+
+	Binary     manifestFile `json:"binary"`
+	GoVersion  string       `json:"go_version"`
+	GOOS       string       `json:"goos"`
+	GOARCH     string       `json:"goarch"`
+	GOMAXPROCS int          `json:"gomaxprocs"`
+	NumCPU     int          `json:"num_cpu"`
+	Hostname   string       `json:"hostname"`
+}
+
+type manifestConfig struct {
+	File    string         `json:"file,omitempty"`
+	Entries []config.Entry `json:"entries"`
+}
+
+type manifestFile struct {
+	Role   string `json:"role,omitempty"` // train, test
+	File   string `json:"file"`
+	Bytes  int64  `json:"bytes"`
+	SHA256 string `json:"sha256"`
+}
+
+func newRunManifest(runID, logDir string) *runManifest {
+	M := &runManifest{
+		V:          manifestVersion,
+		RunID:      runID,
+		LogDir:     logDir,
+		Started:    time.Now(),
+		Args:       os.Args,
+		Seed:       rngSeed,
+		GoVersion:  runtime.Version(),
+		GOOS:       runtime.GOOS,
+		GOARCH:     runtime.GOARCH,
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		NumCPU:     runtime.NumCPU(),
+	}
+	M.Hostname, _ = os.Hostname()
+	flag.Visit(func(f *flag.Flag) {
+		if M.Overrides == nil {
+			M.Overrides = make(map[string]string)
+		}
+		M.Overrides[f.Name] = f.Value.String()
+	})
+	if exe, err := os.Executable(); err == nil {
+		M.Binary, _ = hashFile("", exe)
+	}
+	return M
+}
+
+func (M *runManifest) write() error {
+	data, err := json.MarshalIndent(M, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(M.LogDir+manifestName, data)
+}
+
+// makeRunDir creates a new directory under dir for a run of search,
+// named for the time with a counter added until the name is unused
+func makeRunDir(dir, search string) (runID string, err error) {
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", err
+	}
+	base := search + "-" + time.Now().Format("20060102-150405")
+	runID = base
+	for n := 2; ; n++ {
+		err = os.Mkdir(dir+runID, os.ModePerm)
+		if !os.IsExist(err) {
+			return runID, err
+		}
+		runID = fmt.Sprintf("%s-%d", base, n)
+	}
+}
+
+// readEntries reads the entries of a config file for the manifest
+func readEntries(filename string) manifestConfig {
+	MC := manifestConfig{File: filename}
+	data, err := ioutil.ReadFile(filename)
+	if err == nil {
+		MC.Entries, err = config.ReadEntries(data)
+	}
+	if err != nil {
+		log.Printf("manifest: %v\n", err)
+	}
+	return MC
+}
+
+// entries lists the main config as resolved
+func (DC mainConfig) entries() []config.Entry {
+	return []config.Entry{
+		{Key: "DataDir", Value: DC.dataDir},
+		{Key: "ConfigDir", Value: DC.cfgDir},
+		{Key: "LogDir", Value: DC.logDir},
+		{Key: "JobDir", Value: DC.jobDir},
+		{Key: "PostDir", Value: DC.postDir},
+		{Key: "ProblemCfg", Value: DC.probCfg},
+		{Key: "SearchCfg", Value: strings.Join(DC.srchCfg, " ")},
+		{Key: "EventLog", Value: DC.eventLog},
+		{Key: "EventAddr", Value: DC.eventAddr},
+	}
+}
+
+// addData records the hash of a data file, logging the error if it is unreadable
+func (M *runManifest) addData(role, filename string) {
+	MF, err := hashFile(role, filename)
+	if err != nil {
+		log.Printf("manifest: %v\n", err)
+	}
+	M.Data = append(M.Data, MF)
+}
+
+func hashFile(role, filename string) (manifestFile, error) {
+	MF := manifestFile{Role: role, File: filename}
+	f, err := os.Open(filename)
+	if err != nil {
+		return MF, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	MF.Bytes, err = io.Copy(h, f)
+	MF.SHA256 = hex.EncodeToString(h.Sum(nil))
+	return MF, err
+}
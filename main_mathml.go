@@ -0,0 +1,284 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+)
+
+/* LaTeX to MathML
+ *
+ * Enough of LaTeX for the equations go-symexpr writes with Expr.Latex,
+ * turned into MathML so report.html shows them typeset without fetching
+ * a renderer: groups, ^ and _, \frac, \sqrt, \left and \right, \cdot
+ * and \times, function names, Greek letters, \mathrm and \text.
+ * Anything else is an error and the caller shows the LaTeX source.
+ */
+
+var latexFuncs = map[string]bool{
+	"sin": true, "cos": true, "tan": true, "exp": true, "log": true, "ln": true,
+	"sinh": true, "cosh": true, "tanh": true, "arcsin": true, "arccos": true, "arctan": true,
+	"abs": true, "max": true, "min": true,
+}
+
+var latexSymbols = map[string]string{
+	"alpha": "α", "beta": "β", "gamma": "γ", "delta": "δ", "epsilon": "ε", "varepsilon": "ε",
+	"zeta": "ζ", "eta": "η", "theta": "θ", "kappa": "κ", "lambda": "λ", "mu": "μ", "nu": "ν",
+	"xi": "ξ", "pi": "π", "rho": "ρ", "sigma": "σ", "tau": "τ", "phi": "φ", "varphi": "φ",
+	"chi": "χ", "psi": "ψ", "omega": "ω",
+	"Gamma": "Γ", "Delta": "Δ", "Theta": "Θ", "Lambda": "Λ", "Pi": "Π", "Sigma": "Σ",
+	"Phi": "Φ", "Psi": "Ψ", "Omega": "Ω",
+	"infty": "∞", "partial": "∂",
+}
+
+var latexOps = map[string]string{
+	"cdot": "·", "times": "×", "div": "÷", "pm": "±", "mp": "∓",
+	"leq": "≤", "geq": "≥", "neq": "≠", "approx": "≈",
+}
+
+// latexMathML converts src to a display math element
+func latexMathML(src string) (string, error) {
+	p := &latexParser{src: src}
+	body, err := p.seq()
+	if err != nil {
+		return "", err
+	}
+	if p.pos < len(p.src) {
+		return "", fmt.Errorf("unexpected %q at %d", p.src[p.pos:], p.pos)
+	}
+	return `<math display="block"><mrow>` + body + `</mrow></math>`, nil
+}
+
+type latexParser struct {
+	src string
+	pos int
+}
+
+func (p *latexParser) skipSpace() {
+	for p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *latexParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// seq parses items up to "}", "\right" or the end, which are left unread
+func (p *latexParser) seq() (string, error) {
+	var b strings.Builder
+	for {
+		c := p.peek()
+		if c == 0 || c == '}' || strings.HasPrefix(p.src[p.pos:], `\right`) {
+			return b.String(), nil
+		}
+		item, err := p.item()
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(item)
+	}
+}
+
+// item is an atom with its sub and superscripts
+func (p *latexParser) item() (string, error) {
+	base, err := p.atom()
+	if err != nil {
+		return "", err
+	}
+	var sub, sup string
+	for {
+		c := p.peek()
+		if c != '^' && c != '_' {
+			break
+		}
+		p.pos++
+		arg, err := p.atom()
+		if err != nil {
+			return "", err
+		}
+		if c == '^' {
+			sup = arg
+		} else {
+			sub = arg
+		}
+	}
+	switch {
+	case sub != "" && sup != "":
+		return "<msubsup>" + base + sub + sup + "</msubsup>", nil
+	case sub != "":
+		return "<msub>" + base + sub + "</msub>", nil
+	case sup != "":
+		return "<msup>" + base + sup + "</msup>", nil
+	}
+	return base, nil
+}
+
+// group parses a {...} argument into an mrow
+func (p *latexParser) group() (string, error) {
+	if p.peek() != '{' {
+		return "", fmt.Errorf("expected { at %d", p.pos)
+	}
+	p.pos++
+	body, err := p.seq()
+	if err != nil {
+		return "", err
+	}
+	if p.peek() != '}' {
+		return "", fmt.Errorf("unclosed { at %d", p.pos)
+	}
+	p.pos++
+	return "<mrow>" + body + "</mrow>", nil
+}
+
+func (p *latexParser) atom() (string, error) {
+	c := p.peek()
+	switch {
+	case c == 0:
+		return "", fmt.Errorf("unexpected end")
+	case c == '{':
+		return p.group()
+	case c == '\\':
+		return p.command()
+	case c >= '0' && c <= '9' || c == '.':
+		start := p.pos
+		for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		// exponent of a number printed with %g
+		if p.pos+1 < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+			q := p.pos + 1
+			if q < len(p.src) && (p.src[q] == '+' || p.src[q] == '-') {
+				q++
+			}
+			if q < len(p.src) && isDigit(p.src[q]) {
+				for q < len(p.src) && isDigit(p.src[q]) {
+					q++
+				}
+				mant, exp := p.src[start:p.pos], strings.TrimPrefix(p.src[p.pos+1:q], "+")
+				p.pos = q
+				return "<mrow><mn>" + mant + "</mn><mo>×</mo><msup><mn>10</mn><mn>" + exp + "</mn></msup></mrow>", nil
+			}
+		}
+		return "<mn>" + p.src[start:p.pos] + "</mn>", nil
+	case isLetter(c):
+		start := p.pos
+		for p.pos < len(p.src) && (isLetter(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		return "<mi>" + html.EscapeString(p.src[start:p.pos]) + "</mi>", nil
+	case strings.IndexByte("+-=*/()[]|,<>!'", c) >= 0:
+		p.pos++
+		op := string(c)
+		if c == '-' {
+			op = "−"
+		}
+		return "<mo>" + html.EscapeString(op) + "</mo>", nil
+	}
+	return "", fmt.Errorf("unexpected %q at %d", c, p.pos)
+}
+
+func (p *latexParser) command() (string, error) {
+	p.pos++ // the backslash
+	if p.pos >= len(p.src) {
+		return "", fmt.Errorf("lone backslash")
+	}
+	// spacing and escaped characters
+	if c := p.src[p.pos]; !isLetter(c) {
+		p.pos++
+		switch c {
+		case ',', ';', ':', ' ', '!':
+			return "", nil
+		case '{', '}', '%', '&', '#', '_':
+			return "<mo>" + html.EscapeString(string(c)) + "</mo>", nil
+		}
+		return "", fmt.Errorf(`unknown command \%c`, c)
+	}
+	start := p.pos
+	for p.pos < len(p.src) && isLetter(p.src[p.pos]) {
+		p.pos++
+	}
+	name := p.src[start:p.pos]
+
+	switch {
+	case name == "frac":
+		num, err := p.group()
+		if err != nil {
+			return "", err
+		}
+		den, err := p.group()
+		if err != nil {
+			return "", err
+		}
+		return "<mfrac>" + num + den + "</mfrac>", nil
+	case name == "sqrt":
+		arg, err := p.group()
+		if err != nil {
+			return "", err
+		}
+		return "<msqrt>" + arg + "</msqrt>", nil
+	case name == "left":
+		open, err := p.delim()
+		if err != nil {
+			return "", err
+		}
+		body, err := p.seq()
+		if err != nil {
+			return "", err
+		}
+		if !strings.HasPrefix(p.src[p.pos:], `\right`) {
+			return "", fmt.Errorf(`\left without \right`)
+		}
+		p.pos += len(`\right`)
+		shut, err := p.delim()
+		if err != nil {
+			return "", err
+		}
+		return "<mrow>" + open + body + shut + "</mrow>", nil
+	case name == "mathrm" || name == "text" || name == "operatorname":
+		if p.peek() != '{' {
+			return "", fmt.Errorf(`expected { after \%s`, name)
+		}
+		end := strings.IndexByte(p.src[p.pos:], '}')
+		if end < 0 {
+			return "", fmt.Errorf(`unclosed \%s`, name)
+		}
+		text := p.src[p.pos+1 : p.pos+end]
+		p.pos += end + 1
+		return "<mi mathvariant=\"normal\">" + html.EscapeString(text) + "</mi>", nil
+	case latexFuncs[name]:
+		return "<mi>" + name + "</mi><mo>&#x2061;</mo>", nil
+	case latexSymbols[name] != "":
+		return "<mi>" + latexSymbols[name] + "</mi>", nil
+	case latexOps[name] != "":
+		return "<mo>" + latexOps[name] + "</mo>", nil
+	}
+	return "", fmt.Errorf(`unknown command \%s`, name)
+}
+
+// delim reads the delimiter after \left or \right
+func (p *latexParser) delim() (string, error) {
+	c := p.peek()
+	switch c {
+	case '(', ')', '[', ']', '|':
+		p.pos++
+		return "<mo>" + string(c) + "</mo>", nil
+	case '.':
+		p.pos++
+		return "", nil
+	case '\\':
+		if strings.HasPrefix(p.src[p.pos:], `\{`) || strings.HasPrefix(p.src[p.pos:], `\}`) {
+			p.pos += 2
+			return "<mo>" + string(p.src[p.pos-1]) + "</mo>", nil
+		}
+	}
+	return "", fmt.Errorf("bad delimiter at %d", p.pos)
+}
+
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isLetter(c byte) bool { return c < 0x80 && unicode.IsLetter(rune(c)) }
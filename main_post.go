@@ -1,217 +1,616 @@
 package main
 
 import (
-	"bufio"
-	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
-	exec "os/exec"
 	"sort"
-	"text/template"
+	"strconv"
+	"strings"
+
+	config "github.com/verdverm/go-pge/config"
+	pge "github.com/verdverm/go-pge/pge"
+	plot "github.com/verdverm/go-pge/plot"
+	probs "github.com/verdverm/go-pge/problems"
+	results "github.com/verdverm/go-pge/results"
 )
 
+/* Post processing  (pge -post)
+ *
+ * Reads the run dirs under LogDir/<Name>/ and writes, for each problem,
+ * to PostDir/<Name>/<group>/:
+ *
+ *   iters.csv   per iteration, over the runs that got that far: mean,
+ *               quartiles and median of the best and the average test
+ *               error of the reported equations, and the success rate
+ *   eqns.csv    the distinct final equations, with how many runs ended
+ *               with them on the front and how many had them best
+ *   config.txt  the resolved problem and search configs of the group
+ *   *.svg       plots: error and success rate by iteration, the sizes
+ *               and errors of the final equations, and the predictions
+ *               and residuals of the best one on the testing data
+ *
+ * Runs are grouped by their resolved problem and search configs from the
+ * manifest, so runs with different settings are never pooled. A run
+ * succeeds once its best test error is below the success threshold,
+ * the problem's HitRatio unless -success is given. PostDir/summary.csv
+ * has a line for every group.
+ */
+
+// success threshold when neither -success nor the manifest has one
+const defaultSuccess = 0.01
+
+type postRun struct {
+	dir       string
+	manifest  *runManifest // nil for runs from before manifests
+	iters     []runIter
+	final     []*results.Report
+	threshold float64
+}
+
+// a run's reports at one iteration
+type runIter struct {
+	iter int
+	best float64 // min test error
+	ave  float64 // mean test error
+}
+
+type postGroup struct {
+	prob string
+	key  string
+	runs []*postRun
+}
+
+type quartiles struct {
+	n                    int // finite values
+	mean, q1, median, q3 float64
+	min, max             float64
+}
+
+type iterStat struct {
+	iter    int
+	runs    int
+	best    quartiles
+	ave     quartiles
+	success float64
+}
+
+type eqnFreq struct {
+	expr     string
+	pretty   string // from the run with the lowest test error
+	size     int
+	runs     int     // on the final front
+	bestIn   int     // the best of the final front
+	minErr   float64 // for picking pretty
+	testErrs []float64
+}
+
 func post(DS *MainSearch) {
 
 	fmt.Printf("\n\nMain - Post Processing\n======================\n\n")
 
 	DC := DS.cnfg
-	// setup log dir and open main log files
-	basedir, _ := os.Open(DC.logDir)
-	subdirs, _ := basedir.Readdirnames(0)
-	sort.Strings(subdirs)
+	probdirs, err := ioutil.ReadDir(DC.logDir)
+	if err != nil {
+		fmt.Println("post: ", err)
+		return
+	}
 
-	for _, sdir := range subdirs {
-		processProblemDir(sdir, DC.logDir+sdir)
+	var groups []*postGroup
+	for _, pdir := range probdirs {
+		if !pdir.IsDir() {
+			continue
+		}
+		groups = append(groups, processProblemDir(pdir.Name(), DC.logDir+pdir.Name()+"/")...)
 	}
 
+	for _, G := range groups {
+		outdir := DC.postDir + G.prob + "/" + G.key + "/"
+		if err := writeGroup(G, outdir); err != nil {
+			fmt.Printf("post %s/%s: %v\n", G.prob, G.key, err)
+		}
+	}
+	if len(groups) > 0 {
+		if err := writeSummary(groups, DC.postDir+"summary.csv"); err != nil {
+			fmt.Println("post: ", err)
+		}
+	}
 }
 
-func processProblemDir(probstr, dirstr string) {
-	outdir := "out/bench/" + probstr
-
-	pdir, _ := os.Open(dirstr)
-	rundirs, _ := pdir.Readdirnames(0)
-	sort.Strings(rundirs)
+// processProblemDir loads the runs of a problem and groups them by config
+func processProblemDir(probstr, dirstr string) []*postGroup {
 	fmt.Printf("ProbDir %s\n", probstr)
 
-	var results []runResults
-	for r, rdir := range rundirs {
-		ret := processRunDir(r, dirstr+"/"+rdir)
-		results = append(results, ret)
+	rundirs, _ := ioutil.ReadDir(dirstr)
+	byKey := make(map[string]*postGroup)
+	var keys []string
+	for _, rdir := range rundirs {
+		if !rdir.IsDir() {
+			continue
+		}
+		R, err := processRunDir(dirstr + rdir.Name() + "/")
+		if err != nil {
+			fmt.Printf("  skipping %s: %v\n", rdir.Name(), err)
+			continue
+		}
+		key := configKey(R.manifest)
+		G, ok := byKey[key]
+		if !ok {
+			G = &postGroup{prob: probstr, key: key}
+			byKey[key] = G
+			keys = append(keys, key)
+		}
+		G.runs = append(G.runs, R)
 	}
+	sort.Strings(keys)
 
-	var genErr []aveErr
-	for j := 1; j < len(results[0].genErr); j++ {
-		ave, best := 0.0, 0.0
-		for i := 0; i < len(results); i++ {
-			if results[i].genErr[j].gen < 1 {
-				continue
+	groups := make([]*postGroup, len(keys))
+	for i, key := range keys {
+		groups[i] = byKey[key]
+		fmt.Printf("  group %s: %d runs\n", key, len(groups[i].runs))
+	}
+	return groups
+}
+
+// processRunDir reads the manifest and results logs of one run
+func processRunDir(dirstr string) (*postRun, error) {
+	L, err := results.Load(dirstr + "pge/pge:eqns.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	R := &postRun{dir: dirstr, threshold: *arg_success}
+
+	if data, err := ioutil.ReadFile(dirstr + manifestName); err == nil {
+		M := new(runManifest)
+		if err = json.Unmarshal(data, M); err != nil {
+			return nil, fmt.Errorf("%s: %v", manifestName, err)
+		}
+		R.manifest = M
+	}
+	if R.threshold <= 0 {
+		R.threshold = defaultSuccess
+		if R.manifest != nil {
+			if v, ok := lookupEntry(R.manifest.Problem.Entries, "HitRatio"); ok {
+				if f, err := strconv.ParseFloat(v, 64); err == nil {
+					R.threshold = f
+				}
 			}
-			// fmt.Printf("%d %d %d\n", i, j, results[i].genErr[j].gen)
-			ave += results[i].genErr[j].err
-			best += results[i].genErr[j].best
 		}
-		gen := j * 10
-		ave /= float64(len(results))
-		best /= float64(len(results))
-		tmp := aveErr{gen, ave, best}
-		fmt.Printf("Gen: %v\n", tmp)
-
-		genErr = append(genErr, tmp)
 	}
 
-	makeGraph(probstr, outdir, genErr)
+	for _, iter := range L.ReportIters() {
+		var errs []float64
+		for _, rpt := range L.ReportsAt(iter) {
+			if e := float64(rpt.TestError); isFinite(e) {
+				errs = append(errs, e)
+			}
+		}
+		it := runIter{iter: iter, best: math.NaN(), ave: math.NaN()}
+		if len(errs) > 0 {
+			Q := summarize(errs)
+			it.best, it.ave = Q.min, Q.mean
+		}
+		R.iters = append(R.iters, it)
+	}
 
-	fmt.Println("\n\n\n")
+	// the main search's merged front when there is one
+	R.final = L.Final()
+	if M, err := results.Load(dirstr + "main:eqns.jsonl"); err == nil {
+		if fin := M.Final(); len(fin) > 0 {
+			R.final = fin
+		}
+	}
+	return R, nil
 }
 
-type aveErr struct {
-	gen  int
-	err  float64
-	best float64
+// configKey names a group of runs with the same problem and search configs
+func configKey(M *runManifest) string {
+	if M == nil {
+		return "unknown"
+	}
+	h := sha256.New()
+	writeEntries := func(entries []config.Entry) {
+		for _, e := range entries {
+			fmt.Fprintf(h, "%s=%s\n", strings.ToUpper(e.Key), e.Value)
+		}
+		h.Write([]byte{0})
+	}
+	writeEntries(M.Problem.Entries)
+	for _, S := range M.Search {
+		writeEntries(S.Entries)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
 }
 
-type bestEqn struct {
-	pos     int
-	eqn_str string
-	latex   string
-	size    int
-	err     float64
+func lookupEntry(entries []config.Entry, key string) (value string, ok bool) {
+	for _, e := range entries {
+		if strings.EqualFold(e.Key, key) {
+			value, ok = e.Value, true
+		}
+	}
+	return
 }
 
-type runResults struct {
-	genErr []aveErr
-	eqns   []bestEqn
+// iterStats pools the runs of G by iteration
+func iterStats(G *postGroup) []iterStat {
+	type runAt struct {
+		R  *postRun
+		it runIter
+	}
+	iters := make(map[int][]runAt)
+	for _, R := range G.runs {
+		for _, it := range R.iters {
+			iters[it.iter] = append(iters[it.iter], runAt{R, it})
+		}
+	}
+	order := make([]int, 0, len(iters))
+	for iter := range iters {
+		order = append(order, iter)
+	}
+	sort.Ints(order)
+
+	// success is sticky, a run that reached it stays counted
+	succeeded := make(map[*postRun]bool)
+	stats := make([]iterStat, 0, len(order))
+	for _, iter := range order {
+		var bests, aves []float64
+		succ := 0
+		for _, ra := range iters[iter] {
+			bests = append(bests, ra.it.best)
+			aves = append(aves, ra.it.ave)
+			if ra.it.best < ra.R.threshold {
+				succeeded[ra.R] = true
+			}
+			if succeeded[ra.R] {
+				succ++
+			}
+		}
+		stats = append(stats, iterStat{
+			iter:    iter,
+			runs:    len(iters[iter]),
+			best:    summarize(bests),
+			ave:     summarize(aves),
+			success: float64(succ) / float64(len(iters[iter])),
+		})
+	}
+	return stats
 }
 
-func processRunDir(run int, dirstr string) (ret runResults) {
-
-	fmt.Println("  run ", run, " ", dirstr)
-
-	ret.genErr = make([]aveErr, 101)
-
-	gpsr_eqn_data, _ := ioutil.ReadFile(dirstr + "/gpsr/gpsr:eqns.log")
-	lines := bytes.Split(gpsr_eqn_data, []byte("\n"))
-
-	var (
-		str, tmp         string
-		gen, cnt         int
-		pos, size        int
-		terr, tsum, tmin float64
-		latex, eqn_str   string
-	)
-
-	for l := 0; l < len(lines); l++ {
-		_, err := fmt.Sscanf(string(lines[l]), "%s %d %d", &str, &gen, &cnt)
-		if err != nil {
-			// fmt.Println("Err on GEN line: ", err)
-			break
-		}
-		// fmt.Println("    Gen: ", gen)
-		tsum = 0.0
-		tmin = 1000000.0
-		for i := 0; i < cnt; i++ {
-			l++
-			fmt.Sscanf(string(lines[l]), "%d", &pos)
-			latex = string(bytes.TrimSpace(lines[l][2:]))
-			l++
-			eqn_str = string(lines[l])
-			l++
-			fmt.Sscanf(string(lines[l]), "%s %d", &str, &size)
-			l += 5
-			fmt.Sscanf(string(lines[l]), "%s %s %f", &str, &tmp, &terr)
-			if terr < tmin {
-				tmin = terr
+// finalEqns counts the distinct equations on the runs' final fronts,
+// keyed on the expression with its coefficients left out
+func finalEqns(G *postGroup) []*eqnFreq {
+	byExpr := make(map[string]*eqnFreq)
+	for _, R := range G.runs {
+		seen := make(map[string]bool)
+		bestExpr, bestErr := "", math.Inf(1)
+		for _, rpt := range R.final {
+			terr := float64(rpt.TestError)
+			F, ok := byExpr[rpt.Expr]
+			if !ok {
+				F = &eqnFreq{expr: rpt.Expr, size: rpt.Size, minErr: math.Inf(1)}
+				byExpr[rpt.Expr] = F
 			}
-			tsum += terr
-			l += 2
-			_ = latex
-			_ = eqn_str
-
-			if gen == 1000 {
-				var eqn bestEqn
-				eqn.pos = i
-				eqn.eqn_str = eqn_str
-				eqn.latex = latex
-				eqn.err = terr
-				eqn.size = size
-				ret.eqns = append(ret.eqns, eqn)
-				// if i < 12 {
-				// 	fmt.Printf("    %d %s  %d %f\n", i, latex, size, terr)
-				// }
+			if !seen[rpt.Expr] {
+				seen[rpt.Expr] = true
+				F.runs++
 			}
+			F.testErrs = append(F.testErrs, terr)
+			if terr < F.minErr || F.pretty == "" {
+				F.pretty = rpt.Pretty
+			}
+			if terr < F.minErr {
+				F.minErr = terr
+			}
+			if terr < bestErr {
+				bestExpr, bestErr = rpt.Expr, terr
+			}
+		}
+		if bestExpr != "" {
+			byExpr[bestExpr].bestIn++
 		}
-		var gerr aveErr
-		gerr.gen = gen
-		gerr.err = terr
-		gerr.best = tmin
-		ret.genErr[gen/10] = gerr
-		// fmt.Printf("Gen: %d  %f  %f\n", gen, tsum/float64(cnt), tmin)
+	}
 
+	eqns := make([]*eqnFreq, 0, len(byExpr))
+	for _, F := range byExpr {
+		eqns = append(eqns, F)
 	}
-	return
+	sort.Slice(eqns, func(i, j int) bool {
+		if eqns[i].runs != eqns[j].runs {
+			return eqns[i].runs > eqns[j].runs
+		}
+		if eqns[i].size != eqns[j].size {
+			return eqns[i].size < eqns[j].size
+		}
+		return eqns[i].expr < eqns[j].expr
+	})
+	return eqns
 }
 
-func makeGraph(prob, dir string, genErr []aveErr) {
+// summarize the finite values of vs
+func summarize(vs []float64) quartiles {
+	fin := make([]float64, 0, len(vs))
+	for _, v := range vs {
+		if isFinite(v) {
+			fin = append(fin, v)
+		}
+	}
+	Q := quartiles{n: len(fin)}
+	if Q.n == 0 {
+		nan := math.NaN()
+		Q.mean, Q.q1, Q.median, Q.q3, Q.min, Q.max = nan, nan, nan, nan, nan, nan
+		return Q
+	}
+	sort.Float64s(fin)
+	sum := 0.0
+	for _, v := range fin {
+		sum += v
+	}
+	Q.mean = sum / float64(Q.n)
+	Q.q1 = quantile(fin, 0.25)
+	Q.median = quantile(fin, 0.5)
+	Q.q3 = quantile(fin, 0.75)
+	Q.min, Q.max = fin[0], fin[Q.n-1]
+	return Q
+}
 
-	dataFN := dir + "/errData.txt"
-	gnuFN := dir + "/plotData.gnu"
-	plotFN := dir + "/" + prob
+// quantile of sorted values, interpolating between the closest ranks
+func quantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	frac := pos - float64(lo)
+	return sorted[lo]*(1-frac) + sorted[hi]*frac
+}
 
-	dataFile, _ := os.Create(dataFN)
-	out := bufio.NewWriter(dataFile)
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
 
-	for _, pnt := range genErr {
-		fmt.Fprintln(out, pnt.gen, pnt.err, pnt.best)
+// fmtFloat leaves the cell empty for NaN and Inf
+func fmtFloat(v float64) string {
+	if !isFinite(v) {
+		return ""
 	}
+	return strconv.FormatFloat(v, 'g', 8, 64)
+}
 
-	out.Flush()
-	dataFile.Close()
+func writeGroup(G *postGroup, outdir string) error {
+	if err := os.MkdirAll(outdir, os.ModePerm); err != nil {
+		return err
+	}
+	stats := iterStats(G)
+	eqns := finalEqns(G)
+
+	rows := [][]string{{"iter", "runs",
+		"best_mean", "best_q1", "best_median", "best_q3",
+		"ave_mean", "ave_q1", "ave_median", "ave_q3", "success_rate"}}
+	for _, S := range stats {
+		rows = append(rows, []string{
+			strconv.Itoa(S.iter), strconv.Itoa(S.runs),
+			fmtFloat(S.best.mean), fmtFloat(S.best.q1), fmtFloat(S.best.median), fmtFloat(S.best.q3),
+			fmtFloat(S.ave.mean), fmtFloat(S.ave.q1), fmtFloat(S.ave.median), fmtFloat(S.ave.q3),
+			fmtFloat(S.success),
+		})
+	}
+	if err := writeCSV(outdir+"iters.csv", rows); err != nil {
+		return err
+	}
 
-	pInfo := plotInfo{plotFN, dataFN, prob}
+	rows = [][]string{{"expr", "runs", "frac", "best_in", "size", "min_test_error", "median_test_error", "pretty"}}
+	for _, F := range eqns {
+		Q := summarize(F.testErrs)
+		rows = append(rows, []string{
+			F.expr, strconv.Itoa(F.runs), fmtFloat(float64(F.runs) / float64(len(G.runs))),
+			strconv.Itoa(F.bestIn), strconv.Itoa(F.size),
+			fmtFloat(Q.min), fmtFloat(Q.median), F.pretty,
+		})
+	}
+	if err := writeCSV(outdir+"eqns.csv", rows); err != nil {
+		return err
+	}
 
-	gnuFile, _ := os.Create(gnuFN)
-	gout := bufio.NewWriter(gnuFile)
+	if err := writeGroupConfig(G, outdir+"config.txt"); err != nil {
+		return err
+	}
 
-	tmpl, err := template.New("gnu").Parse(plot_file)
-	if err != nil {
-		panic(err)
+	fmt.Printf("%s/%s: %d runs, %d iterations, %d distinct final equations\n",
+		G.prob, G.key, len(G.runs), len(stats), len(eqns))
+	return makeGraphs(G, stats, eqns, outdir)
+}
+
+// writeGroupConfig lists the configs shared by the runs and the runs
+func writeGroupConfig(G *postGroup, filename string) error {
+	var b strings.Builder
+	if M := G.runs[0].manifest; M != nil {
+		fmt.Fprintf(&b, "# Problem  %s\n", M.Problem.File)
+		for _, e := range M.Problem.Entries {
+			fmt.Fprintf(&b, "%s = %s\n", e.Key, e.Value)
+		}
+		for _, S := range M.Search {
+			fmt.Fprintf(&b, "\n# Search  %s\n", S.File)
+			for _, e := range S.Entries {
+				fmt.Fprintf(&b, "%s = %s\n", e.Key, e.Value)
+			}
+		}
+	} else {
+		b.WriteString("# runs without a manifest\n")
 	}
-	err = tmpl.Execute(gout, pInfo)
-	if err != nil {
-		panic(err)
+	b.WriteString("\n# Runs\n")
+	for _, R := range G.runs {
+		seed := ""
+		if R.manifest != nil {
+			seed = fmt.Sprintf("  seed %d", R.manifest.Seed)
+		}
+		fmt.Fprintf(&b, "# %s%s\n", R.dir, seed)
 	}
+	return ioutil.WriteFile(filename, []byte(b.String()), 0644)
+}
 
-	gout.Flush()
-	gnuFile.Close()
+func writeSummary(groups []*postGroup, filename string) error {
+	rows := [][]string{{"problem", "group", "runs", "last_iter",
+		"final_best_median", "final_best_q1", "final_best_q3", "success_rate", "top_expr", "top_expr_best_in"}}
+	for _, G := range groups {
+		var finals []float64
+		succ := 0
+		maxIter := 0
+		for _, R := range G.runs {
+			if len(R.iters) == 0 {
+				continue
+			}
+			last := R.iters[len(R.iters)-1]
+			finals = append(finals, last.best)
+			for _, it := range R.iters {
+				if it.best < R.threshold {
+					succ++
+					break
+				}
+			}
+			if last.iter > maxIter {
+				maxIter = last.iter
+			}
+		}
+		Q := summarize(finals)
+		// the equation most often best
+		top, topBest := "", ""
+		var topF *eqnFreq
+		for _, F := range finalEqns(G) {
+			if topF == nil || F.bestIn > topF.bestIn {
+				topF = F
+			}
+		}
+		if topF != nil {
+			top, topBest = topF.expr, strconv.Itoa(topF.bestIn)
+		}
+		rows = append(rows, []string{
+			G.prob, G.key, strconv.Itoa(len(G.runs)), strconv.Itoa(maxIter),
+			fmtFloat(Q.median), fmtFloat(Q.q1), fmtFloat(Q.q3),
+			fmtFloat(float64(succ) / float64(len(G.runs))), top, topBest,
+		})
+	}
+	return writeCSV(filename, rows)
+}
 
-	cmd := exec.Command("gnuplot", gnuFN)
-	err = cmd.Run()
+func writeCSV(filename string, rows [][]string) error {
+	f, err := os.Create(filename)
 	if err != nil {
-		panic(err)
+		return err
 	}
+	w := csv.NewWriter(f)
+	w.WriteAll(rows)
+	if err = w.Error(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
 }
 
-type plotInfo struct {
-	Outfn string
-	Infn  string
-	Prob  string
-}
+// makeGraphs draws the group's plots as SVG
+func makeGraphs(G *postGroup, stats []iterStat, eqns []*eqnFreq, dir string) error {
+	n := len(stats)
+	iters := make([]float64, n)
+	best := plot.Curve{Name: "best", Median: make([]float64, n), Q1: make([]float64, n), Q3: make([]float64, n)}
+	ave := plot.Curve{Name: "average", Median: make([]float64, n), Q1: make([]float64, n), Q3: make([]float64, n)}
+	succ := make([]float64, n)
+	for i, S := range stats {
+		iters[i] = float64(S.iter)
+		best.Median[i], best.Q1[i], best.Q3[i] = S.best.median, S.best.q1, S.best.q3
+		ave.Median[i], ave.Q1[i], ave.Q3[i] = S.ave.median, S.ave.q1, S.ave.q3
+		succ[i] = S.success
+	}
+	title := fmt.Sprintf("%s  (%d runs)", G.prob, len(G.runs))
+	if err := plot.Convergence(title, "test error", iters, best, ave).WriteFile(dir + "error.svg"); err != nil {
+		return err
+	}
+	P := plot.New(title, "iteration", "success rate")
+	P.Line("", iters, succ, "")
+	if err := P.WriteFile(dir + "success.svg"); err != nil {
+		return err
+	}
+
+	sizes := make([]float64, len(eqns))
+	errs := make([]float64, len(eqns))
+	for i, F := range eqns {
+		sizes[i] = float64(F.size)
+		errs[i] = summarize(F.testErrs).min
+	}
+	if err := plot.ParetoFront(G.prob+"  final equations", sizes, errs).WriteFile(dir + "pareto.svg"); err != nil {
+		return err
+	}
 
-var plot_file = `
-set term postscript enhanced
-set output '{{.Outfn}}.png'
+	return makeFitGraphs(G, dir)
+}
 
-set style line 1 lt 1 lc rgb "blue" lw 1
-set style line 2 lt 1 lc rgb "black" lw 1
-set style line 3 lt 1 lc rgb "red" lw 1
-set autoscale
-plot '{{.Infn}}' using 1:2 with lines ls 2, '' using 1:3 with lines ls 1
+// makeFitGraphs plots the predictions of the best final equation of the
+// group on the test data of the run that found it
+func makeFitGraphs(G *postGroup, dir string) error {
+	var bestR *postRun
+	var bestRpt *results.Report
+	for _, R := range G.runs {
+		for _, rpt := range R.final {
+			terr := float64(rpt.TestError)
+			if isFinite(terr) && (bestRpt == nil || terr < float64(bestRpt.TestError)) {
+				bestR, bestRpt = R, rpt
+			}
+		}
+	}
+	if bestRpt == nil || bestR.manifest == nil {
+		return nil
+	}
+	e, err := pge.DecodeResults(bestRpt)
+	if err != nil {
+		fmt.Printf("  no prediction plots: %v\n", err)
+		return nil
+	}
+	eprob, err := loadRunProblem(bestR.manifest)
+	if err != nil {
+		fmt.Printf("  no prediction plots: %v\n", err)
+		return nil
+	}
 
-`
+	var actual, pred []float64
+	coeff := bestRpt.Coefficients()
+	for _, PS := range eprob.Test {
+		actual = append(actual, PS.DepndCol(eprob.SearchVar)...)
+		pred = append(pred, pge.Predict(e, eprob, PS, coeff)...)
+	}
+	title := bestRpt.Pretty
+	if title == "" {
+		title = bestRpt.Expr
+	}
+	if err = plot.PredActual(title, actual, pred).WriteFile(dir + "pred.svg"); err != nil {
+		return err
+	}
+	return plot.Residuals(title, actual, pred).WriteFile(dir + "resid.svg")
+}
 
-var eqn_tex_file = `
-\subsection*\{ {{}} \}
+// loadRunProblem rebuilds a run's problem from its manifest and reads
+// its testing data
+func loadRunProblem(M *runManifest) (*probs.ExprProblem, error) {
+	var text strings.Builder
+	for _, e := range M.Problem.Entries {
+		fmt.Fprintf(&text, "%s = %s\n", e.Key, e.Value)
+	}
+	eprob := new(probs.ExprProblem)
+	if err := config.ParseConfig([]byte(text.String()), probs.ProbConfigParser, eprob); err != nil {
+		return nil, err
+	}
 
-`
+	DS := new(MainSearch)
+	DS.cnfg.dataDir, _ = lookupEntry(M.Main.Entries, "DataDir")
+	for _, fn := range eprob.TestFns {
+		sets, err := DS.readDataFile(eprob, fn)
+		if err != nil {
+			return nil, err
+		}
+		eprob.Test = append(eprob.Test, sets...)
+	}
+	if len(eprob.Test) == 0 {
+		return nil, fmt.Errorf("problem %s has no testing data", eprob.Name)
+	}
+	return eprob, nil
+}
@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	pge "github.com/verdverm/go-pge/pge"
+	plot "github.com/verdverm/go-pge/plot"
+	probs "github.com/verdverm/go-pge/problems"
+	results "github.com/verdverm/go-pge/results"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+/* Run report  (report.html)
+ *
+ * Written to the run dir when a MainSearch finishes, or for an existing
+ * run dir with  pge -report <dir>. It is built from what the run left
+ * on disk: the manifest, the final front in main:eqns.jsonl, the
+ * iteration stats in pge/pge:iters.jsonl and the testing data named in
+ * the manifest. Plots are inline SVG and equations inline MathML, so the
+ * page has no outside assets and can be mailed or attached as is.
+ */
+
+const reportName = "report.html"
+
+// points in each model's plots, more are thinned evenly
+const reportPoints = 1000
+
+type reportData struct {
+	Title     string
+	Generated time.Time
+	Summary   []reportKV
+	Problem   manifestConfig
+	Search    []manifestConfig
+	FrontSVG  template.HTML
+	ConvSVG   template.HTML
+	Models    []*reportModel
+	Warnings  []string
+}
+
+type reportKV struct {
+	Key, Value string
+}
+
+type reportModel struct {
+	Rank       int
+	Math       template.HTML // MathML, or the LaTeX source when it doesn't convert
+	Latex      string
+	Expr       string
+	Size       int
+	TrainError string
+	TestError  string
+	PredError  string
+	TestScore  int
+	Coeffs     []reportKV
+	PredSVG    template.HTML
+	ResidSVG   template.HTML
+}
+
+// writeRunReport writes report.html into the run dir
+func writeRunReport(dir string) error {
+	if !strings.HasSuffix(dir, "/") {
+		dir += "/"
+	}
+	R, err := buildReport(dir)
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	if err = reportTmpl.Execute(&b, R); err != nil {
+		return err
+	}
+	return writeFileAtomic(dir+reportName, b.Bytes())
+}
+
+func buildReport(dir string) (*reportData, error) {
+	L, err := results.Load(dir + "main:eqns.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	front := L.Final()
+	run := L.Run
+
+	R := &reportData{Title: run.Problem, Generated: time.Now()}
+	warn := func(format string, a ...interface{}) {
+		R.Warnings = append(R.Warnings, fmt.Sprintf(format, a...))
+	}
+
+	var M *runManifest
+	if data, err := ioutil.ReadFile(dir + manifestName); err == nil {
+		M = new(runManifest)
+		if err = json.Unmarshal(data, M); err != nil {
+			return nil, fmt.Errorf("%s: %v", manifestName, err)
+		}
+	} else {
+		warn("no %s, the run's settings are unknown", manifestName)
+	}
+	R.Summary = reportSummary(run, M)
+	if M != nil {
+		R.Problem = M.Problem
+		R.Search = M.Search
+	}
+
+	// the testing data, for the model plots
+	var eprob *probs.ExprProblem
+	if M != nil {
+		if eprob, err = loadRunProblem(M); err != nil {
+			warn("no prediction plots: %v", err)
+			eprob = nil
+		} else {
+			npts := 0
+			for _, PS := range eprob.Test {
+				npts += len(PS.DepndCol(eprob.SearchVar))
+			}
+			R.Summary = append(R.Summary, reportKV{"Testing points", strconv.Itoa(npts)})
+		}
+	}
+
+	sizes := make([]float64, len(front))
+	errs := make([]float64, len(front))
+	for i, rpt := range front {
+		sizes[i], errs[i] = float64(rpt.Size), float64(rpt.TestError)
+		R.Models = append(R.Models, reportModelFor(i, rpt, run, eprob, warn))
+	}
+	R.FrontSVG = template.HTML(plot.ParetoFront("Final front", sizes, errs).SVG())
+
+	if IL, err := results.Load(dir + "pge/pge:iters.jsonl"); err == nil {
+		n := len(IL.Iters)
+		iters, mins, aves := make([]float64, n), make([]float64, n), make([]float64, n)
+		for i, it := range IL.Iters {
+			iters[i], mins[i], aves[i] = float64(it.Iter), float64(it.MinError), float64(it.AveError)
+		}
+		R.ConvSVG = template.HTML(plot.Convergence("Convergence", "error", iters,
+			plot.Curve{Name: "min error", Median: mins},
+			plot.Curve{Name: "average reported", Median: aves}).SVG())
+	} else {
+		warn("no convergence history: %v", err)
+	}
+	return R, nil
+}
+
+func reportSummary(run *results.Run, M *runManifest) []reportKV {
+	S := []reportKV{{"Problem", run.Problem}}
+	if len(run.DepndNames) > run.SearchVar {
+		S = append(S, reportKV{"Target", run.DepndNames[run.SearchVar]})
+	}
+	S = append(S, reportKV{"Inputs", strings.Join(run.IndepNames, ", ")})
+	if M == nil {
+		return S
+	}
+	S = append(S,
+		reportKV{"Run", M.RunID},
+		reportKV{"Started", M.Started.Format(time.RFC1123)},
+	)
+	if M.Finished != nil {
+		state := "finished"
+		if M.Stopped {
+			state = "stopped"
+		}
+		S = append(S,
+			reportKV{"Finished", M.Finished.Format(time.RFC1123) + "  (" + state + ")"},
+			reportKV{"Duration", M.Finished.Sub(M.Started).Truncate(time.Second).String()},
+		)
+	}
+	S = append(S,
+		reportKV{"Iterations", strconv.Itoa(M.Iter)},
+		reportKV{"Seed", strconv.FormatInt(M.Seed, 10)},
+	)
+	for _, D := range M.Data {
+		S = append(S, reportKV{"Data (" + D.Role + ")",
+			fmt.Sprintf("%s  %d bytes  sha256 %.16s", D.File, D.Bytes, D.SHA256)})
+	}
+	S = append(S,
+		reportKV{"Go", fmt.Sprintf("%s %s/%s  GOMAXPROCS %d", M.GoVersion, M.GOOS, M.GOARCH, M.GOMAXPROCS)},
+		reportKV{"Host", M.Hostname},
+	)
+	return S
+}
+
+func reportModelFor(rank int, rpt *results.Report, run *results.Run, eprob *probs.ExprProblem,
+	warn func(string, ...interface{})) *reportModel {
+
+	m := &reportModel{
+		Rank:       rank,
+		Expr:       rpt.Expr,
+		Size:       rpt.Size,
+		TrainError: fmtFloat(float64(rpt.TrainError)),
+		TestError:  fmtFloat(float64(rpt.TestError)),
+		PredError:  fmtFloat(float64(rpt.PredError)),
+		TestScore:  rpt.TestScore,
+	}
+	coeff := rpt.Coefficients()
+	for i, c := range coeff {
+		m.Coeffs = append(m.Coeffs, reportKV{"C" + strconv.Itoa(i), strconv.FormatFloat(c, 'g', -1, 64)})
+	}
+
+	e, err := pge.DecodeResults(rpt)
+	if err != nil {
+		warn("model %d: %v", rank, err)
+		src := rpt.Pretty
+		if src == "" {
+			src = rpt.Expr
+		}
+		m.Math = template.HTML("<code>" + template.HTMLEscapeString(src) + "</code>")
+		return m
+	}
+	m.Latex = exprLatex(e, run, coeff)
+	if mml, err := latexMathML(m.Latex); err == nil && m.Latex != "" {
+		m.Math = template.HTML(mml)
+	} else {
+		src := m.Latex
+		if src == "" {
+			src = rpt.Pretty
+		}
+		m.Math = template.HTML("<code>" + template.HTMLEscapeString(src) + "</code>")
+	}
+
+	if eprob != nil {
+		var actual, pred []float64
+		for _, PS := range eprob.Test {
+			actual = append(actual, PS.DepndCol(eprob.SearchVar)...)
+			pred = append(pred, pge.Predict(e, eprob, PS, coeff)...)
+		}
+		if len(actual) > reportPoints {
+			actual, pred = thinEvenly(actual, reportPoints), thinEvenly(pred, reportPoints)
+		}
+		P := plot.PredActual("Predicted against actual", actual, pred)
+		P.Width, P.Height = 440, 320
+		m.PredSVG = template.HTML(P.SVG())
+		P = plot.Residuals("Residuals", actual, pred)
+		P.Width, P.Height = 440, 320
+		m.ResidSVG = template.HTML(P.SVG())
+	}
+	return m
+}
+
+// exprLatex is e.Latex, empty if it panics on a node it can't print
+func exprLatex(e expr.Expr, run *results.Run, coeff []float64) (latex string) {
+	defer func() {
+		if r := recover(); r != nil {
+			latex = ""
+		}
+	}()
+	return e.Latex(run.IndepNames, run.SysNames, coeff)
+}
+
+func thinEvenly(vs []float64, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = vs[i*len(vs)/n]
+	}
+	return out
+}
+
+var reportTmpl = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>PGE report: {{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em auto; max-width: 1000px; color: #222; }
+h1 { font-size: 1.6em; margin-bottom: 0.2em; }
+h2 { border-bottom: 1px solid #ccc; padding-bottom: 0.2em; margin-top: 2em; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+td, th { padding: 0.25em 0.7em; border-bottom: 1px solid #eee; text-align: left; vertical-align: middle; }
+th { background: #f4f4f4; }
+td.num { text-align: right; font-family: monospace; }
+math[display="block"] { margin: 0; text-align: left; }
+.model { border: 1px solid #ddd; border-radius: 4px; padding: 0.5em 1em; margin: 1em 0; }
+.plots { display: flex; flex-wrap: wrap; gap: 1em; }
+.warn { color: #a60; }
+code { font-size: 0.9em; }
+details { margin: 0.5em 0; }
+.small { color: #777; font-size: 0.85em; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="small">generated {{.Generated.Format "2006-01-02 15:04:05 MST"}}</div>
+{{range .Warnings}}<p class="warn">{{.}}</p>
+{{end}}
+<h2>Problem</h2>
+<table>
+{{range .Summary}}<tr><th>{{.Key}}</th><td>{{.Value}}</td></tr>
+{{end}}</table>
+{{if .Problem.Entries}}<details><summary>Problem config {{.Problem.File}}</summary>
+<table>{{range .Problem.Entries}}<tr><td>{{.Key}}</td><td>{{.Value}}</td></tr>{{end}}</table>
+</details>{{end}}
+{{range .Search}}<details><summary>Search config {{.File}}</summary>
+<table>{{range .Entries}}<tr><td>{{.Key}}</td><td>{{.Value}}</td></tr>{{end}}</table>
+</details>
+{{end}}
+<h2>Final front</h2>
+{{.FrontSVG}}
+<table>
+<tr><th>#</th><th>size</th><th>equation</th><th>train error</th><th>test error</th><th>pred error</th><th>test hits</th></tr>
+{{range .Models}}<tr><td><a href="#model{{.Rank}}">{{.Rank}}</a></td><td class="num">{{.Size}}</td><td>{{.Math}}</td>
+<td class="num">{{.TrainError}}</td><td class="num">{{.TestError}}</td><td class="num">{{.PredError}}</td><td class="num">{{.TestScore}}</td></tr>
+{{end}}</table>
+
+<h2>Convergence</h2>
+{{if .ConvSVG}}{{.ConvSVG}}{{else}}<p>No iteration history.</p>{{end}}
+
+<h2>Models</h2>
+{{range .Models}}<div class="model" id="model{{.Rank}}">
+<h3>#{{.Rank}}  size {{.Size}}  test error {{.TestError}}</h3>
+{{.Math}}
+<p><code>{{.Expr}}</code></p>
+{{if .Latex}}<details><summary>LaTeX</summary><pre>{{.Latex}}</pre></details>{{end}}
+{{if .Coeffs}}<table><tr><th>coefficient</th><th>value</th></tr>
+{{range .Coeffs}}<tr><td>{{.Key}}</td><td class="num">{{.Value}}</td></tr>{{end}}
+</table>{{end}}
+{{if .PredSVG}}<div class="plots">{{.PredSVG}}{{.ResidSVG}}</div>{{end}}
+</div>
+{{end}}
+</body>
+</html>
+`))
@@ -7,12 +7,15 @@ import (
 	"log"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	config "github.com/verdverm/go-pge/config"
 	pge "github.com/verdverm/go-pge/pge"
 	probs "github.com/verdverm/go-pge/problems"
+	results "github.com/verdverm/go-pge/results"
 )
 
 // defines the interface to a search type [GP,PE]
@@ -24,7 +27,7 @@ type Search interface {
 	// initialize the search, sending signal on chan when done
 	// the input will be something for the search to connect to
 	// in order to provide updates, be monitored, and receive control signals
-	Init(done chan int, prob *probs.ExprProblem, logdir string, input interface{})
+	Init(done chan int, prob *probs.ExprProblem, logdir string, input interface{}) error
 
 	// start the actual search procedure (Init is required before a new call to Run)
 	Run()
@@ -39,6 +42,11 @@ type mainConfig struct {
 	dataDir string
 	cfgDir  string
 	logDir  string
+	jobDir  string // for -serve
+	postDir string // for -post
+
+	eventLog  string // progress events file in the log dir
+	eventAddr string // serve the events as SSE here
 
 	probCfg string
 	srchCfg []string
@@ -55,6 +63,14 @@ func mainConfigParser(field, value string, config interface{}) (err error) {
 		DC.dataDir = value
 	case "LOGDIR":
 		DC.logDir = value
+	case "JOBDIR":
+		DC.jobDir = value
+	case "POSTDIR":
+		DC.postDir = value
+	case "EVENTLOG":
+		DC.eventLog = value
+	case "EVENTADDR":
+		DC.eventAddr = value
 
 	case "PROBLEMCFG":
 		DC.probCfg = value
@@ -85,14 +101,28 @@ type MainSearch struct {
 	logDir     string
 	mainLog    *log.Logger
 	mainLogBuf *bufio.Writer
-	eqnsLog    *log.Logger
-	eqnsLogBuf *bufio.Writer
 	errLog     *log.Logger
 	errLogBuf  *bufio.Writer
+	eqnsOut    *results.Writer // final reports, see package results
+
+	// called from Run after messages arrive, nil unless someone is watching
+	watch func(iter []int, eqns probs.ExprReportArray)
+	halt  int32 // set by Stop, atomic
+
+	// progress events, nil when not wanted
+	events    *eventStream
+	lastFront map[string]frontEntry
+
+	// -tui, nil otherwise
+	dash *dashboard
+
+	cfgFile  string // the main config file
+	manifest *runManifest
 }
 
 func (DS *MainSearch) ParseConfig(filename string) {
-	fmt.Printf("Parsing Main Config: %s\n", filename)
+	pge.Vprintf(pge.VInfo, "Parsing Main Config: %s\n", filename)
+	DS.cfgFile = filename
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
 		log.Fatal(err)
@@ -102,83 +132,93 @@ func (DS *MainSearch) ParseConfig(filename string) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	fmt.Printf("%v\n", DS.cnfg)
+	pge.Vprintf(pge.VIter, "%v\n", DS.cnfg)
 }
 
 func (DS *MainSearch) Init(done chan int, input interface{}) {
-	fmt.Printf("Init'n PGE1\n----------\n")
+	if err := DS.setup(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// setup is Init returning its errors, for the job server which must
+// not exit on a bad job
+func (DS *MainSearch) setup() error {
+	pge.Vprintf(pge.VInfo, "Init'n PGE1\n----------\n")
 
 	DC := DS.cnfg
 
 	// read and setup problem
-	eprob := new(probs.ExprProblem)
-
-	fmt.Printf("Parsing Problem Config: %s\n", DC.probCfg)
-	data, err := ioutil.ReadFile(DC.cfgDir + DC.probCfg)
+	eprob, err := DS.loadProblem()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
-	err = config.ParseConfig(data, probs.ProbConfigParser, eprob)
-	if err != nil {
-		log.Fatal(err)
-	}
-	fmt.Printf("Prob: %v\n", eprob)
-	fmt.Printf("TCfg: %v\n\n", eprob.TreeCfg)
 
-	// setup log dir and open main log files
+	// setup a new run dir and open main log files
 	DC.logDir += eprob.Name + "/"
+	search := "run"
 	if DC.srchCfg[0][:4] == "pge1" {
-		DC.logDir += "pge1/"
+		search = "pge1"
 	} else if DC.srchCfg[0][:3] == "pge" {
-		DC.logDir += "pge/"
+		search = "pge"
 	}
-
-	os.MkdirAll(DC.logDir, os.ModePerm)
+	runID, err := makeRunDir(DC.logDir, search)
+	if err != nil {
+		return fmt.Errorf("couldn't create run dir: %v", err)
+	}
+	DC.logDir += runID + "/"
 
 	now := time.Now()
-	fmt.Println("LogDir: ", DC.logDir)
-	os.MkdirAll(DC.logDir, os.ModePerm)
-	DS.initLogs(DC.logDir)
+	pge.Vprintln(pge.VInfo, "LogDir: ", DC.logDir)
+	if err = DS.initLogs(DC.logDir); err != nil {
+		return err
+	}
+
+	DS.manifest = newRunManifest(runID, DC.logDir)
+	DS.manifest.Main = manifestConfig{File: DS.cfgFile, Entries: DS.cnfg.entries()}
+	DS.manifest.Problem = readEntries(DC.cfgDir + DC.probCfg)
 
 	DS.mainLog.Println(DC.logDir, now)
 
-	// // setup data
-	fmt.Printf("Setting up problem: %s\n", eprob.Name)
-
-	eprob.Train = make([]*probs.PointSet, len(eprob.TrainFns))
-	for i, fn := range eprob.TrainFns {
-		fmt.Printf("Reading Trainging File: %s\n", fn)
-		eprob.Train[i] = new(probs.PointSet)
-		if strings.HasSuffix(fn, ".dataF2") || strings.HasSuffix(fn, ".mat") {
-			eprob.Train[i].ReadLakeFile(DC.dataDir + fn)
-		} else {
-			eprob.Train[i].ReadPointSet(DC.dataDir + fn)
-		}
+	if DS.events == nil && (DC.eventLog != "" || DC.eventAddr != "") {
+		DS.events = newEventStream()
 	}
-	eprob.Test = make([]*probs.PointSet, len(eprob.TestFns))
-	for i, fn := range eprob.TestFns {
-		fmt.Printf("Reading Testing File: %s\n", fn)
-		eprob.Test[i] = new(probs.PointSet)
-		if strings.HasSuffix(fn, ".dataF2") || strings.HasSuffix(fn, ".mat") {
-			eprob.Test[i].ReadLakeFile(DC.dataDir + fn)
-		} else {
-			eprob.Test[i].ReadPointSet(DC.dataDir + fn)
+	if DC.eventLog != "" {
+		if err := DS.events.openFile(DC.logDir + DC.eventLog); err != nil {
+			return fmt.Errorf("couldn't create event log: %v", err)
 		}
 	}
+	if DC.eventAddr != "" {
+		serveEvents(DS.events, DC.eventAddr)
+	}
+
+	// // setup data
+	if err = DS.loadData(eprob); err != nil {
+		return err
+	}
 
 	DS.prob = eprob
-	fmt.Println()
+	pge.Vprintln(pge.VInfo)
+
+	DS.eqnsOut, err = results.Create(DC.logDir+"main:eqns.jsonl", results.NewRun(eprob, "main", 0))
+	if err != nil {
+		return fmt.Errorf("couldn't create eqns log: %v", err)
+	}
 
 	// read search configs
 	for _, cfg := range DC.srchCfg {
-		if cfg[:4] == "pge1" {
-			GS := new(pge.PgeSearch)
-			GS.ParseConfig(DC.cfgDir + cfg)
-			DS.srch = append(DS.srch, GS)
-		} else if cfg[:3] == "pge" {
+		// pge1 configs are PgeSearch configs too
+		if strings.HasPrefix(cfg, "pge") {
+			data, err := ioutil.ReadFile(DC.cfgDir + cfg)
+			if err != nil {
+				return err
+			}
 			PS := new(pge.PgeSearch)
-			PS.ParseConfig(DC.cfgDir + cfg)
+			if err = PS.ParseConfigData(data); err != nil {
+				return fmt.Errorf("%s: %v", cfg, err)
+			}
 			DS.srch = append(DS.srch, PS)
+			MC := readEntries(DC.cfgDir + cfg)
 
 			/************/
 			// temporary hack
@@ -187,20 +227,26 @@ func (DS *MainSearch) Init(done chan int, input interface{}) {
 			if *arg_pge_iter >= 0 {
 				DS.prob.MaxIter = *arg_pge_iter
 				PS.SetMaxIter(*arg_pge_iter)
+				MC.Entries = config.SetEntry(MC.Entries, "MaxGen", strconv.Itoa(*arg_pge_iter))
 			}
 			if *arg_pge_peel >= 0 {
 				PS.SetPeelCount(*arg_pge_peel)
+				MC.Entries = config.SetEntry(MC.Entries, "PeelCount", strconv.Itoa(*arg_pge_peel))
 			}
 			if *arg_pge_init != "" {
 				PS.SetInitMethod(*arg_pge_init)
+				MC.Entries = config.SetEntry(MC.Entries, "InitMethod", *arg_pge_init)
 			}
 			if *arg_pge_grow != "" {
 				PS.SetGrowMethod(*arg_pge_grow)
+				MC.Entries = config.SetEntry(MC.Entries, "GrowMethod", *arg_pge_grow)
 			}
 			PS.SetEvalrCount(*arg_pge_evals)
+			MC.Entries = config.SetEntry(MC.Entries, "EvalrCount", strconv.Itoa(*arg_pge_evals))
+			DS.manifest.Search = append(DS.manifest.Search, MC)
 
 		} else {
-			log.Fatalf("unknown config type: %v  from  %v\n", cfg[:4], cfg)
+			return fmt.Errorf("unknown config type: %v", cfg)
 		}
 	}
 
@@ -215,24 +261,49 @@ func (DS *MainSearch) Init(done chan int, input interface{}) {
 		DS.comm[i].Cmds = make(chan int)
 		DS.comm[i].Rpts = make(chan *probs.ExprReportArray, 64)
 		DS.comm[i].Gen = make(chan [2]int, 64)
+		if DS.events != nil || DS.dash != nil {
+			DS.comm[i].Stats = make(chan *probs.IterStats, 64)
+		}
 	}
 
 	DS.iter = make([]int, len(DS.srch))
 
-	fmt.Println("\n******************************************************\n")
+	pge.Vprintf(pge.VInfo, "\n******************************************************\n\n")
 
 	// initialize searches
 	sdone := make(chan int)
 	for i, _ := range DS.srch {
-		DS.srch[i].Init(sdone, eprob, DC.logDir, DS.comm[i])
+		if err = DS.srch[i].Init(sdone, eprob, DC.logDir, DS.comm[i]); err != nil {
+			return err
+		}
+	}
+	pge.Vprintf(pge.VInfo, "\n******************************************************\n\n")
+
+	// the problem as run, MaxIter comes from the search
+	DS.manifest.Problem.Entries = config.SetEntry(DS.manifest.Problem.Entries, "MaxIter", strconv.Itoa(DS.prob.MaxIter))
+	for _, fn := range eprob.TrainFns {
+		DS.manifest.addData("train", DC.dataDir+fn)
+	}
+	for _, fn := range eprob.TestFns {
+		DS.manifest.addData("test", DC.dataDir+fn)
+	}
+	DS.manifest.Fingerprint = eprob.Fingerprint()
+	if err := DS.manifest.write(); err != nil {
+		log.Println("couldn't write manifest ", err)
 	}
-	fmt.Println("\n******************************************************\n")
 
+	if DS.events != nil {
+		DS.events.emit(&searchEvent{Type: "start", Problem: eprob.Name, MaxIter: DS.prob.MaxIter})
+	}
+	if DS.dash != nil {
+		DS.dash.open(DS)
+	}
+	return nil
 }
 
 func (DS *MainSearch) Run() {
-	fmt.Printf("Running Main\n")
-	fmt.Println("numSrch = ", len(DS.srch))
+	pge.Vprintf(pge.VInfo, "Running Main\n")
+	pge.Vprintln(pge.VIter, "numSrch = ", len(DS.srch))
 	for i := 0; i < len(DS.srch); i++ {
 		go DS.srch[i].Run()
 	}
@@ -245,10 +316,17 @@ func (DS *MainSearch) Run() {
 		counter++
 
 		if DS.checkStop() {
+			if DS.dash != nil {
+				DS.dash.stopping = true
+				DS.dash.draw(true)
+			}
 			DS.doStop()
 			break
 		}
 	}
+	if DS.dash != nil {
+		DS.dash.close()
+	}
 
 	for i, R := range DS.eqns {
 		if R == nil || R.Expr() == nil {
@@ -258,19 +336,41 @@ func (DS *MainSearch) Run() {
 		f_x := "df(" + trn.GetIndepNames()[DS.prob.SearchVar] + ")"
 		str := R.Expr().PrettyPrint(trn.GetIndepNames(), trn.GetSysNames(), R.Coeff())
 		fmt.Printf("%d: %s = %s\n%v\n\n", i, f_x, str, R)
+
+		rpt := results.FromExprReport(R, nil, DS.iter[0], i)
+		rpt.Pretty = str
+		rpt.Final = true
+		rpt.Code = pge.ResultsCode(R.Expr())
+		DS.eqnsOut.WriteReport(rpt)
 	}
 
 	DS.Clean()
 
-	fmt.Println("DS leaving Run()")
+	now := time.Now()
+	DS.manifest.Finished = &now
+	DS.manifest.Iter = DS.iter[0]
+	DS.manifest.Stopped = atomic.LoadInt32(&DS.halt) != 0
+	if err := DS.manifest.write(); err != nil {
+		log.Println("couldn't write manifest ", err)
+	}
+	if err := writeRunReport(DS.logDir); err != nil {
+		log.Println("couldn't write report ", err)
+	}
+
+	if DS.events != nil {
+		DS.events.emit(&searchEvent{Type: "done", Iter: DS.iter[0], Stopped: atomic.LoadInt32(&DS.halt) != 0})
+		DS.events.close()
+	}
+
+	pge.Vprintln(pge.VIter, "DS leaving Run()")
 }
 
 func (DS *MainSearch) Clean() {
-	fmt.Printf("Cleaning Main\n")
+	pge.Vprintf(pge.VIter, "Cleaning Main\n")
 
 	DS.errLogBuf.Flush()
 	DS.mainLogBuf.Flush()
-	DS.eqnsLogBuf.Flush()
+	DS.eqnsOut.Close()
 
 }
 
@@ -278,9 +378,18 @@ func (DS *MainSearch) checkStop() bool {
 	if DS.iter[0] > DS.prob.MaxIter {
 		return true
 	}
+	if atomic.LoadInt32(&DS.halt) != 0 {
+		return true
+	}
 	return false
 }
 
+// Stop asks a running search to wrap up as if it reached MaxIter,
+// safe to call from any goroutine
+func (DS *MainSearch) Stop() {
+	atomic.StoreInt32(&DS.halt, 1)
+}
+
 func (DS *MainSearch) doStop() {
 	done := make(chan int)
 
@@ -290,7 +399,7 @@ func (DS *MainSearch) doStop() {
 			C := DS.comm[i]
 			go func() {
 				C.Cmds <- -1
-				fmt.Printf("DS sent -1 to Srch %d\n", c)
+				pge.Vprintf(pge.VIter, "DS sent -1 to Srch %d\n", c)
 				<-C.Cmds
 				done <- 1
 			}()
@@ -303,36 +412,48 @@ func (DS *MainSearch) doStop() {
 		_, ok := <-done
 		if ok {
 			cnt++
-			fmt.Println("DS done = ", cnt, len(DS.comm))
+			pge.Vprintln(pge.VIter, "DS done = ", cnt, len(DS.comm))
 		}
 
 	}
 
-	fmt.Println("DAMD checking last messages")
+	pge.Vprintln(pge.VIter, "DAMD checking last messages")
 	DS.checkMessages()
 
-	fmt.Println("DS done stopping")
+	pge.Vprintln(pge.VIter, "DS done stopping")
 }
 
 func (DS *MainSearch) checkMessages() {
-	msg := false
+	msg, rptMsg := false, false
 	for i := 0; i < len(DS.comm); i++ {
 		select {
 		case gen, ok := <-DS.comm[i].Gen:
 			if ok {
 				DS.iter[i] = gen[1]
 				if gen[0] == 0 {
-					fmt.Println("Gen: ", gen[1])
+					pge.Vprintln(pge.VInfo, "Gen: ", gen[1])
 				}
 				i--
 				msg = true
 			}
 		case rpt, ok := <-DS.comm[i].Rpts:
 			if ok {
-				msg = true
+				msg, rptMsg = true, true
 				DS.per_eqns[i] = rpt
 				i--
 			}
+		case st, ok := <-DS.comm[i].Stats:
+			// a nil Stats channel is never ready
+			if ok {
+				if DS.events != nil {
+					DS.iterEvent(st)
+				}
+				if DS.dash != nil {
+					DS.dash.iter(st)
+				}
+				i--
+				msg = true
+			}
 		default:
 			continue
 		}
@@ -341,6 +462,18 @@ func (DS *MainSearch) checkMessages() {
 		time.Sleep(time.Millisecond)
 	}
 	DS.accumExprs()
+	if rptMsg && DS.events != nil {
+		DS.frontEvent()
+	}
+	if DS.dash != nil {
+		if rptMsg {
+			DS.dash.setFront(DS.prob, DS.eqns)
+		}
+		DS.dash.draw(false)
+	}
+	if msg && DS.watch != nil {
+		DS.watch(DS.iter, DS.eqns)
+	}
 }
 
 func (DS *MainSearch) accumExprs() {
@@ -381,14 +514,103 @@ func (DS *MainSearch) accumExprs() {
 
 }
 
-func (DS *MainSearch) initLogs(logdir string) {
+// loadProblem parses the problem config, without reading its data
+func (DS *MainSearch) loadProblem() (*probs.ExprProblem, error) {
+	DC := DS.cnfg
+	eprob := new(probs.ExprProblem)
+
+	pge.Vprintf(pge.VInfo, "Parsing Problem Config: %s\n", DC.probCfg)
+	data, err := ioutil.ReadFile(DC.cfgDir + DC.probCfg)
+	if err != nil {
+		return nil, err
+	}
+	err = config.ParseConfig(data, probs.ProbConfigParser, eprob)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", DC.probCfg, err)
+	}
+	pge.Vprintf(pge.VIter, "Prob: %v\n", eprob)
+	pge.Vprintf(pge.VIter, "TCfg: %v\n\n", eprob.TreeCfg)
+	return eprob, nil
+}
+
+// loadData reads the training and testing sets of eprob
+func (DS *MainSearch) loadData(eprob *probs.ExprProblem) (err error) {
+	pge.Vprintf(pge.VInfo, "Setting up problem: %s\n", eprob.Name)
+
+	pge.Vprintf(pge.VInfo, "Reading Training Files: %v\n", eprob.TrainFns)
+	if eprob.Train, err = DS.readDataFiles(eprob, eprob.TrainFns); err != nil {
+		return err
+	}
+	pge.Vprintf(pge.VInfo, "Reading Testing Files: %v\n", eprob.TestFns)
+	eprob.Test, err = DS.readDataFiles(eprob, eprob.TestFns)
+	return err
+}
+
+// read data files according to the problem's DataFormat
+func (DS *MainSearch) readDataFiles(eprob *probs.ExprProblem, fns []string) (sets []*probs.PointSet, err error) {
+	for _, fn := range fns {
+		fsets, err := DS.readDataFile(eprob, fn)
+		if err != nil {
+			return nil, fmt.Errorf("error reading data: %v", err)
+		}
+		sets = append(sets, fsets...)
+	}
+	return sets, nil
+}
+
+func (DS *MainSearch) readDataFile(eprob *probs.ExprProblem, fn string) ([]*probs.PointSet, error) {
+	switch eprob.DataFormat {
+	case "timeseries":
+		segs, err := probs.ReadTimeSeries(DS.cnfg.dataDir+fn, eprob.TimeSeriesCfg)
+		if err != nil {
+			return nil, err
+		}
+		for _, seg := range segs {
+			DS.logDerivDiags(seg.FN(), seg.CalcDerivs(eprob.DerivCfg))
+		}
+		return segs, nil
+	case "csv", "tsv":
+		if eprob.DataFormat == "tsv" && eprob.CsvCfg.Delim == 0 {
+			eprob.CsvCfg.Delim = '\t'
+		}
+		ps, err := probs.ReadCSV(DS.cnfg.dataDir+fn, eprob.CsvCfg)
+		if err != nil {
+			return nil, err
+		}
+		return []*probs.PointSet{ps}, nil
+	}
+	ps, err := probs.LoadPointSet(DS.cnfg.dataDir+fn, !eprob.NoDataCache)
+	if err != nil {
+		return nil, err
+	}
+	return []*probs.PointSet{ps}, nil
+}
+
+func (DS *MainSearch) logDerivDiags(fn string, diags []probs.DerivDiag) {
+	if len(diags) == 0 {
+		return
+	}
+	// no main log in a worker process
+	pge.Vprintf(pge.VIter, "Derivatives for %s:\n", fn)
+	if DS.mainLog != nil {
+		DS.mainLog.Printf("Derivatives for %s:\n", fn)
+	}
+	for _, dd := range diags {
+		pge.Vprintf(pge.VIter, "  %v\n", dd)
+		if DS.mainLog != nil {
+			DS.mainLog.Printf("  %v\n", dd)
+		}
+	}
+}
+
+func (DS *MainSearch) initLogs(logdir string) error {
 
 	// open logs
 	DS.logDir = logdir
 	os.Mkdir(DS.logDir, os.ModePerm)
 	tmpF0, err5 := os.Create(DS.logDir + "main:err.log")
 	if err5 != nil {
-		log.Fatal("couldn't create errs log", err5)
+		return fmt.Errorf("couldn't create errs log: %v", err5)
 	}
 	DS.errLogBuf = bufio.NewWriter(tmpF0)
 	DS.errLogBuf.Flush()
@@ -396,18 +618,10 @@ func (DS *MainSearch) initLogs(logdir string) {
 
 	tmpF1, err1 := os.Create(DS.logDir + "main:main.log")
 	if err1 != nil {
-		log.Fatal("couldn't create main log", err1)
+		return fmt.Errorf("couldn't create main log: %v", err1)
 	}
 	DS.mainLogBuf = bufio.NewWriter(tmpF1)
 	DS.mainLogBuf.Flush()
 	DS.mainLog = log.New(DS.mainLogBuf, "", log.LstdFlags)
-
-	tmpF2, err2 := os.Create(DS.logDir + "main:eqns.log")
-	if err2 != nil {
-		log.Fatal("couldn't create eqns log", err2)
-	}
-	DS.eqnsLogBuf = bufio.NewWriter(tmpF2)
-	DS.eqnsLogBuf.Flush()
-	DS.eqnsLog = log.New(DS.eqnsLogBuf, "", log.LstdFlags)
-
+	return nil
 }
@@ -0,0 +1,595 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	config "github.com/verdverm/go-pge/config"
+	pge "github.com/verdverm/go-pge/pge"
+	probs "github.com/verdverm/go-pge/problems"
+)
+
+/* Job server  (pge -serve :8080)
+ *
+ *   GET  /jobs             list the jobs
+ *   POST /jobs             submit a job, multipart form:
+ *                            data      the dataset file (required)
+ *                            name      problem name
+ *                            probcfg   base problem config under ConfigDir
+ *                                      (default by extension of data)
+ *                            problem   extra problem config lines
+ *                            searchcfg base search config under ConfigDir
+ *                            options   extra search config lines, e.g. MaxGen = 50
+ *   GET  /jobs/{id}        state, iteration and the current best reports
+ *   POST /jobs/{id}/stop   stop a queued or running job
+ *   GET  /jobs/{id}/front  download the final front of a finished job
+ *   GET  /jobs/{id}/events progress events, as Server-Sent Events while
+ *                          the job runs and as NDJSON once it is over
+ *
+ * Everything about a job lives in JobDir/{id}/: job.json with its state,
+ * the uploaded data, the problem and search configs as they will be run,
+ * the search logs and, once finished, front.json. On start the server
+ * reloads every job directory and queues again the jobs that were queued
+ * or running, so jobs survive a restart, though a running one starts
+ * over from iteration 0. Jobs run one at a time in submission order,
+ * a job whose setup fails or which panics is marked failed with the
+ * error and the server goes on to the next.
+ */
+
+const (
+	jobQueued  = "queued"
+	jobRunning = "running"
+	jobDone    = "done"
+	jobStopped = "stopped"
+	jobFailed  = "failed"
+)
+
+const (
+	jobInfoFile  = "job.json"
+	jobFrontFile = "front.json"
+	jobProbCfg   = "prob.cfg"
+	jobSrchCfg   = "pge.cfg" // must start with "pge" for MainSearch
+	jobDataDir   = "data/"
+	jobLogDir    = "logs/"
+	jobEventLog  = "events.ndjson" // in the search's log dir
+)
+
+// the persisted part of a job
+type jobInfo struct {
+	ID       string     `json:"id"`
+	Name     string     `json:"name"`
+	State    string     `json:"state"`
+	DataFile string     `json:"data_file"`
+	LogDir   string     `json:"log_dir,omitempty"`
+	Created  time.Time  `json:"created"`
+	Started  *time.Time `json:"started,omitempty"`
+	Finished *time.Time `json:"finished,omitempty"`
+	Iter     int        `json:"iter"`
+	MaxIter  int        `json:"max_iter"`
+	Restarts int        `json:"restarts"`
+	Error    string     `json:"error,omitempty"`
+}
+
+// a best report, as served by the job server
+type frontEntry struct {
+	Expr       string    `json:"expr"`
+	Pretty     string    `json:"pretty"`
+	Coeff      []float64 `json:"coeff"`
+	Size       int       `json:"size"`
+	TrainError *float64  `json:"train_error"` // null when NaN or Inf
+	TestError  *float64  `json:"test_error"`
+	PredError  *float64  `json:"pred_error"`
+	TestScore  int       `json:"test_score"`
+	UniqID     int       `json:"uniq_id"`
+	IterID     int       `json:"iter_id"`
+}
+
+type jobStatus struct {
+	jobInfo
+	Front []frontEntry `json:"front"`
+}
+
+type job struct {
+	sync.Mutex
+	info  jobInfo
+	dir   string
+	front []frontEntry
+	DS    *MainSearch // while running
+}
+
+type jobServer struct {
+	sync.Mutex
+	cnfg mainConfig
+	dir  string
+	jobs map[string]*job
+	next chan struct{} // wakes the runner
+	seq  int
+}
+
+func runServer(DS *MainSearch, addr string) {
+	js, err := newJobServer(DS.cnfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go js.runner()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs", js.handleJobs)
+	mux.HandleFunc("/jobs/", js.handleJob)
+
+	fmt.Printf("Serving jobs from %s on %s\n", js.dir, addr)
+	log.Fatal(http.ListenAndServe(addr, mux))
+}
+
+// newJobServer loads the job directories left by an earlier server
+func newJobServer(cnfg mainConfig) (*jobServer, error) {
+	js := &jobServer{cnfg: cnfg, jobs: make(map[string]*job)}
+	js.dir = cnfg.jobDir
+	if js.dir == "" {
+		js.dir = "jobs/"
+	}
+	if !strings.HasSuffix(js.dir, "/") {
+		js.dir += "/"
+	}
+	js.next = make(chan struct{}, 1)
+	if err := os.MkdirAll(js.dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	dirs, err := ioutil.ReadDir(js.dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		j := &job{dir: js.dir + d.Name() + "/"}
+		data, err := ioutil.ReadFile(j.dir + jobInfoFile)
+		if err != nil {
+			continue
+		}
+		if err = json.Unmarshal(data, &j.info); err != nil {
+			log.Printf("skipping job %s: %v\n", d.Name(), err)
+			continue
+		}
+		if data, err = ioutil.ReadFile(j.dir + jobFrontFile); err == nil {
+			json.Unmarshal(data, &j.front)
+		}
+		switch j.info.State {
+		case jobRunning:
+			j.info.Restarts++
+			j.info.State = jobQueued
+			j.info.Started, j.info.Iter = nil, 0
+			j.save()
+			fmt.Printf("Job %s was running, queued again\n", j.info.ID)
+		case jobQueued:
+			fmt.Printf("Job %s still queued\n", j.info.ID)
+		}
+		js.jobs[j.info.ID] = j
+	}
+	js.wake()
+	return js, nil
+}
+
+func (js *jobServer) wake() {
+	select {
+	case js.next <- struct{}{}:
+	default:
+	}
+}
+
+// runner runs the queued jobs, oldest first
+func (js *jobServer) runner() {
+	for range js.next {
+		for {
+			j := js.oldestQueued()
+			if j == nil {
+				break
+			}
+			js.run(j)
+		}
+	}
+}
+
+func (js *jobServer) oldestQueued() *job {
+	js.Lock()
+	defer js.Unlock()
+	var first *job
+	var created time.Time
+	for id, j := range js.jobs {
+		j.Lock()
+		q, c := j.info.State == jobQueued, j.info.Created
+		j.Unlock()
+		if !q {
+			continue
+		}
+		if first == nil || c.Before(created) || (c.Equal(created) && id < first.info.ID) {
+			first, created = j, c
+		}
+	}
+	return first
+}
+
+func (js *jobServer) run(j *job) {
+	DS := new(MainSearch)
+	DS.cnfg = js.cnfg
+	DS.cnfg.dataDir = j.dir
+	DS.cnfg.cfgDir = j.dir
+	DS.cnfg.logDir = j.dir + jobLogDir
+	DS.cnfg.probCfg = jobProbCfg
+	DS.cnfg.srchCfg = []string{jobSrchCfg}
+	DS.cnfg.eventLog = jobEventLog
+	DS.cnfg.eventAddr = ""
+	DS.events = newEventStream()
+	DS.watch = func(iter []int, eqns probs.ExprReportArray) {
+		front := frontEntries(DS.prob, eqns)
+		j.Lock()
+		j.info.Iter = iter[0]
+		j.front = front
+		j.Unlock()
+	}
+
+	j.Lock()
+	if j.info.State != jobQueued {
+		// stopped while waiting
+		j.Unlock()
+		return
+	}
+	now := time.Now()
+	j.info.State = jobRunning
+	j.info.Started = &now
+	j.DS = DS
+	j.save()
+	j.Unlock()
+
+	fmt.Printf("Job %s starting\n", j.info.ID)
+	front, err := j.search(DS)
+
+	j.Lock()
+	defer j.Unlock()
+	now = time.Now()
+	j.info.Finished = &now
+	j.front = front
+	j.DS = nil
+	switch {
+	case err != nil:
+		j.info.State = jobFailed
+		j.info.Error = err.Error()
+	case atomic.LoadInt32(&DS.halt) != 0:
+		j.info.State = jobStopped
+	default:
+		j.info.State = jobDone
+	}
+	j.save()
+	fmt.Printf("Job %s %s\n", j.info.ID, j.info.State)
+}
+
+// search sets up and runs DS, then writes the final front. A job whose
+// setup fails or which panics gets an error, the server carries on
+func (j *job) search(DS *MainSearch) (front []frontEntry, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	if err = DS.setup(); err != nil {
+		DS.events.close()
+		return nil, err
+	}
+	j.Lock()
+	j.info.MaxIter = DS.prob.MaxIter
+	j.info.LogDir = DS.logDir
+	j.save()
+	j.Unlock()
+	DS.Run()
+
+	front = frontEntries(DS.prob, DS.eqns)
+	data, err := json.MarshalIndent(front, "", "  ")
+	if err == nil {
+		err = writeFileAtomic(j.dir+jobFrontFile, data)
+	}
+	return front, err
+}
+
+// save writes job.json, the caller holds j
+func (j *job) save() {
+	data, err := json.MarshalIndent(&j.info, "", "  ")
+	if err == nil {
+		err = writeFileAtomic(j.dir+jobInfoFile, data)
+	}
+	if err != nil {
+		log.Printf("error saving job %s: %v\n", j.info.ID, err)
+	}
+}
+
+func writeFileAtomic(filename string, data []byte) error {
+	tmp := filename + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filename)
+}
+
+func frontEntries(P *probs.ExprProblem, eqns probs.ExprReportArray) []frontEntry {
+	front := make([]frontEntry, 0, len(eqns))
+	for _, R := range eqns {
+		if R == nil || R.Expr() == nil {
+			continue
+		}
+		F := frontEntry{
+			Expr:       R.Expr().String(),
+			Coeff:      R.Coeff(),
+			Size:       R.Size(),
+			TrainError: finite(R.TrainError()),
+			TestError:  finite(R.TestError()),
+			PredError:  finite(R.PredError()),
+			TestScore:  R.TestScore(),
+			UniqID:     R.UniqID(),
+			IterID:     R.IterID(),
+		}
+		if P != nil && len(P.Train) > 0 {
+			trn := P.Train[0]
+			F.Pretty = R.Expr().PrettyPrint(trn.GetIndepNames(), trn.GetSysNames(), R.Coeff())
+		}
+		front = append(front, F)
+	}
+	return front
+}
+
+// JSON has no NaN or Inf
+func finite(v float64) *float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil
+	}
+	return &v
+}
+
+func (js *jobServer) handleJobs(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		js.Lock()
+		list := make([]jobInfo, 0, len(js.jobs))
+		for _, j := range js.jobs {
+			j.Lock()
+			list = append(list, j.info)
+			j.Unlock()
+		}
+		js.Unlock()
+		sort.Slice(list, func(a, b int) bool { return list[a].Created.Before(list[b].Created) })
+		writeJSON(w, http.StatusOK, list)
+	case "POST":
+		js.submit(w, r)
+	default:
+		httpError(w, http.StatusMethodNotAllowed, "use GET or POST")
+	}
+}
+
+func (js *jobServer) handleJob(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/jobs/"), "/"), "/")
+	js.Lock()
+	j := js.jobs[parts[0]]
+	js.Unlock()
+	if j == nil {
+		httpError(w, http.StatusNotFound, "no job "+parts[0])
+		return
+	}
+
+	action := ""
+	if len(parts) > 1 {
+		action = parts[1]
+	}
+	switch {
+	case action == "" && r.Method == "GET":
+		j.Lock()
+		st := jobStatus{j.info, j.front}
+		j.Unlock()
+		writeJSON(w, http.StatusOK, st)
+
+	case action == "stop" && r.Method == "POST":
+		j.Lock()
+		switch j.info.State {
+		case jobQueued:
+			now := time.Now()
+			j.info.State = jobStopped
+			j.info.Finished = &now
+			j.save()
+		case jobRunning:
+			j.DS.Stop()
+		}
+		info := j.info
+		j.Unlock()
+		writeJSON(w, http.StatusAccepted, info)
+
+	case action == "events" && r.Method == "GET":
+		j.Lock()
+		var es *eventStream
+		if j.DS != nil {
+			es = j.DS.events
+		}
+		logDir := j.info.LogDir
+		j.Unlock()
+		if es != nil {
+			es.ServeHTTP(w, r)
+			return
+		}
+		if logDir == "" {
+			httpError(w, http.StatusNotFound, "job has no events yet")
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		http.ServeFile(w, r, logDir+jobEventLog)
+
+	case action == "front" && r.Method == "GET":
+		j.Lock()
+		state := j.info.State
+		j.Unlock()
+		if state != jobDone && state != jobStopped {
+			httpError(w, http.StatusConflict, "job is "+state)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", j.info.ID+"-front.json"))
+		http.ServeFile(w, r, j.dir+jobFrontFile)
+
+	default:
+		httpError(w, http.StatusNotFound, "unknown request")
+	}
+}
+
+// submit writes the job directory, checks that the configs parse
+// and the data reads, then queues the job
+func (js *jobServer) submit(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(64 << 20); err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	upload, hdr, err := r.FormFile("data")
+	if err != nil {
+		httpError(w, http.StatusBadRequest, "missing data file: "+err.Error())
+		return
+	}
+	defer upload.Close()
+
+	dataFn := filepath.Base(hdr.Filename)
+	if dataFn == "." || dataFn == "/" || strings.HasPrefix(dataFn, ".") {
+		dataFn = "data.txt"
+	}
+	ext := strings.ToLower(filepath.Ext(dataFn))
+
+	// configs: base file, then the submitted lines, then what the server decides
+	probcfg := r.FormValue("probcfg")
+	if probcfg == "" {
+		probcfg = "prob/prob_bench_default.cfg"
+		if ext == ".csv" || ext == ".tsv" {
+			probcfg = "prob/prob_csv_default.cfg"
+		}
+	}
+	searchcfg := r.FormValue("searchcfg")
+	if searchcfg == "" && len(js.cnfg.srchCfg) > 0 {
+		searchcfg = js.cnfg.srchCfg[0]
+	}
+	probData, err := js.readCfg(probcfg)
+	if err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	srchData, err := js.readCfg(searchcfg)
+	if err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	js.Lock()
+	js.seq++
+	id := fmt.Sprintf("%s-%03d", time.Now().Format("20060102-150405"), js.seq)
+	js.Unlock()
+
+	var pb bytes.Buffer
+	pb.Write(probData)
+	fmt.Fprintf(&pb, "\n\n# submitted\n%s\n\n# set by the job server\n", r.FormValue("problem"))
+	if ext == ".csv" || ext == ".tsv" {
+		fmt.Fprintf(&pb, "DataFormat = %s\n", ext[1:])
+	}
+	name := r.FormValue("name")
+	if name != "" {
+		fmt.Fprintf(&pb, "Name = %s\n", name)
+	}
+	fmt.Fprintf(&pb, "TrainData = %s\nTestData = %s\n", jobDataDir+dataFn, jobDataDir+dataFn)
+
+	var sb bytes.Buffer
+	sb.Write(srchData)
+	fmt.Fprintf(&sb, "\n\n# submitted\n%s\n", r.FormValue("options"))
+
+	eprob := new(probs.ExprProblem)
+	if err = config.ParseConfig(pb.Bytes(), probs.ProbConfigParser, eprob); err != nil {
+		httpError(w, http.StatusBadRequest, "problem config: "+err.Error())
+		return
+	}
+	if err = new(pge.PgeSearch).ParseConfigData(sb.Bytes()); err != nil {
+		httpError(w, http.StatusBadRequest, "search config: "+err.Error())
+		return
+	}
+
+	j := &job{dir: js.dir + id + "/"}
+	j.info = jobInfo{ID: id, Name: eprob.Name, State: jobQueued, DataFile: dataFn, Created: time.Now()}
+	fail := func(code int, err error) {
+		os.RemoveAll(j.dir)
+		httpError(w, code, err.Error())
+	}
+	if err = os.MkdirAll(j.dir+jobDataDir, os.ModePerm); err != nil {
+		fail(http.StatusInternalServerError, err)
+		return
+	}
+	df, err := os.Create(j.dir + jobDataDir + dataFn)
+	if err == nil {
+		_, err = io.Copy(df, upload)
+		if cerr := df.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err == nil {
+		err = ioutil.WriteFile(j.dir+jobProbCfg, pb.Bytes(), 0644)
+	}
+	if err == nil {
+		err = ioutil.WriteFile(j.dir+jobSrchCfg, sb.Bytes(), 0644)
+	}
+	if err != nil {
+		fail(http.StatusInternalServerError, err)
+		return
+	}
+
+	// read the data now, a bad file would otherwise end the server
+	check := &MainSearch{cnfg: mainConfig{dataDir: j.dir}}
+	sets, err := check.readDataFile(eprob, jobDataDir+dataFn)
+	if err == nil && (len(sets) == 0 || sets[0].NumPoints() == 0) {
+		err = fmt.Errorf("no data points in %s", dataFn)
+	}
+	if err != nil {
+		fail(http.StatusBadRequest, fmt.Errorf("data: %v", err))
+		return
+	}
+
+	j.save()
+	js.Lock()
+	js.jobs[id] = j
+	js.Unlock()
+	js.wake()
+
+	fmt.Printf("Job %s queued: %s\n", id, eprob.Name)
+	writeJSON(w, http.StatusCreated, j.info)
+}
+
+// readCfg reads a config under ConfigDir, refusing paths outside of it
+func (js *jobServer) readCfg(name string) ([]byte, error) {
+	clean := filepath.Clean(name)
+	if filepath.IsAbs(clean) || strings.HasPrefix(clean, "..") {
+		return nil, fmt.Errorf("config %q is not under the config dir", name)
+	}
+	return ioutil.ReadFile(js.cnfg.cfgDir + clean)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+func httpError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, map[string]string{"error": msg})
+}
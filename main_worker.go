@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/verdverm/go-pge/pge"
+)
+
+// runWorker loads the problem data and serves fits to searches
+// that list addr in their RemoteWorkers, until the process is killed
+func runWorker(DS *MainSearch, addr string) {
+	eprob, err := DS.loadProblem()
+	if err == nil {
+		err = DS.loadData(eprob)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Evaluator for %s listening on %s\nData fingerprint: %s\n", eprob.Name, l.Addr(), eprob.Fingerprint())
+
+	err = pge.ServeWorker(l, eprob)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,428 @@
+package pge
+
+import (
+	"fmt"
+	"math"
+
+	probs "github.com/verdverm/go-pge/problems"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+/* Flat postfix programs
+ *
+ * An expression tree is compiled once into a list of stack instructions,
+ * which are then run over whole data columns a block of points at a time.
+ * N-ary Add / Mul are emitted as chains of binary ops so the stack stays
+ * shallow. Trees with node types the compiler doesn't know fall back to
+ * the recursive Eval.
+ */
+
+type opcode uint8
+
+const (
+	opConst  opcode = iota // c[arg]
+	opConstF               // vals[arg]
+	opTime
+	opVar // x[arg]
+	opSys // s[arg]
+	opNeg
+	opAbs
+	opSqrt
+	opSin
+	opCos
+	opTan
+	opExp
+	opLog
+	opPowI // ^arg
+	opPowF // ^vals[arg]
+	opPowE
+	opAdd
+	opMul
+	opDiv
+)
+
+var opNames = [...]string{
+	"const", "constf", "time", "var", "sys",
+	"neg", "abs", "sqrt", "sin", "cos", "tan", "exp", "log",
+	"powi", "powf", "powe", "add", "mul", "div",
+}
+
+type instr struct {
+	op  opcode
+	arg int
+}
+
+// number of points evaluated per pass through the program
+const evalBlock = 256
+
+type Program struct {
+	code   []instr
+	vals   []float64
+	depth  int // max stack depth
+	numC   int // 1 + highest constant index
+	maxVar int // 1 + highest variable index
+
+	tree expr.Expr // set when the tree could not be compiled
+}
+
+// Compile flattens e into a Program. Unknown node types produce a
+// Program that evaluates the tree directly, along with the error.
+func Compile(e expr.Expr) (*Program, error) {
+	p := new(Program)
+	d, err := p.emit(e, 0)
+	if err != nil {
+		return &Program{tree: e, numC: p.numC}, err
+	}
+	p.depth = d
+	return p, nil
+}
+
+func (p *Program) NumConst() int  { return p.numC }
+func (p *Program) Compiled() bool { return p.tree == nil }
+
+func (p *Program) String() string {
+	if p.tree != nil {
+		return "tree: " + p.tree.String()
+	}
+	s := ""
+	for i, in := range p.code {
+		if i > 0 {
+			s += " "
+		}
+		switch in.op {
+		case opConst, opVar, opSys, opPowI:
+			s += fmt.Sprintf("%s[%d]", opNames[in.op], in.arg)
+		case opConstF, opPowF:
+			s += fmt.Sprintf("%s[%g]", opNames[in.op], p.vals[in.arg])
+		default:
+			s += opNames[in.op]
+		}
+	}
+	return s
+}
+
+func (p *Program) push(op opcode, arg int) { p.code = append(p.code, instr{op, arg}) }
+
+func (p *Program) pushF(op opcode, v float64) {
+	p.vals = append(p.vals, v)
+	p.push(op, len(p.vals)-1)
+}
+
+// emit appends the code for e, sp is the stack depth before e runs.
+// Returns the maximum depth reached.
+func (p *Program) emit(e expr.Expr, sp int) (int, error) {
+	if e == nil {
+		return 0, fmt.Errorf("nil expression")
+	}
+	max := func(a, b int) int {
+		if a > b {
+			return a
+		}
+		return b
+	}
+	unary := func(c expr.Expr, op opcode) (int, error) {
+		d, err := p.emit(c, sp)
+		p.push(op, 0)
+		return d, err
+	}
+
+	switch n := e.(type) {
+	case *expr.Constant:
+		p.push(opConst, n.P)
+		if n.P+1 > p.numC {
+			p.numC = n.P + 1
+		}
+		return sp + 1, nil
+	case *expr.ConstantF:
+		p.pushF(opConstF, n.F)
+		return sp + 1, nil
+	case *expr.Time:
+		p.push(opTime, 0)
+		return sp + 1, nil
+	case *expr.Var:
+		p.push(opVar, n.P)
+		if n.P+1 > p.maxVar {
+			p.maxVar = n.P + 1
+		}
+		return sp + 1, nil
+	case *expr.System:
+		p.push(opSys, n.P)
+		return sp + 1, nil
+
+	case *expr.Neg:
+		return unary(n.C, opNeg)
+	case *expr.Abs:
+		return unary(n.C, opAbs)
+	case *expr.Sqrt:
+		return unary(n.C, opSqrt)
+	case *expr.Sin:
+		return unary(n.C, opSin)
+	case *expr.Cos:
+		return unary(n.C, opCos)
+	case *expr.Tan:
+		return unary(n.C, opTan)
+	case *expr.Exp:
+		return unary(n.C, opExp)
+	case *expr.Log:
+		return unary(n.C, opLog)
+
+	case *expr.PowI:
+		d, err := p.emit(n.Base, sp)
+		p.push(opPowI, n.Power)
+		return d, err
+	case *expr.PowF:
+		d, err := p.emit(n.Base, sp)
+		p.pushF(opPowF, n.Power)
+		return d, err
+	case *expr.PowE:
+		d1, err := p.emit(n.Base, sp)
+		if err != nil {
+			return 0, err
+		}
+		d2, err := p.emit(n.Power, sp+1)
+		p.push(opPowE, 0)
+		return max(d1, d2), err
+	case *expr.Div:
+		d1, err := p.emit(n.Numer, sp)
+		if err != nil {
+			return 0, err
+		}
+		d2, err := p.emit(n.Denom, sp+1)
+		p.push(opDiv, 0)
+		return max(d1, d2), err
+
+	case *expr.Add:
+		return p.emitChain(n.CS, opAdd, 0.0, sp)
+	case *expr.Mul:
+		return p.emitChain(n.CS, opMul, 1.0, sp)
+	}
+	return 0, fmt.Errorf("cannot compile %T", e)
+}
+
+func (p *Program) emitChain(cs []expr.Expr, op opcode, empty float64, sp int) (int, error) {
+	depth, cnt := sp+1, 0
+	for _, c := range cs {
+		if c == nil {
+			continue
+		}
+		at := sp
+		if cnt > 0 {
+			at = sp + 1
+		}
+		d, err := p.emit(c, at)
+		if err != nil {
+			return 0, err
+		}
+		if d > depth {
+			depth = d
+		}
+		if cnt > 0 {
+			p.push(op, 0)
+		}
+		cnt++
+	}
+	if cnt == 0 {
+		p.pushF(opConstF, empty)
+	}
+	return depth, nil
+}
+
+// Eval runs the program for a single point, with the same
+// arguments as expr.Expr.Eval
+func (p *Program) Eval(t float64, x, c, s []float64) float64 {
+	if p.tree != nil {
+		return p.tree.Eval(t, x, c, s)
+	}
+	var out [1]float64
+	var tc [1]float64
+	tc[0] = t
+	xc := make([][]float64, len(x))
+	for i := range x {
+		xc[i] = x[i : i+1]
+	}
+	p.EvalColumns(tc[:], xc, c, s, out[:])
+	return out[0]
+}
+
+// EvalColumns evaluates the program at every point of the columns into out.
+// t may be nil, in which case Time is 0. len(out) is the number of points.
+func (p *Program) EvalColumns(t []float64, x [][]float64, c, s []float64, out []float64) {
+	N := len(out)
+	if p.tree != nil {
+		row := make([]float64, len(x))
+		for i := 0; i < N; i++ {
+			for j := range x {
+				row[j] = x[j][i]
+			}
+			tv := 0.0
+			if t != nil {
+				tv = t[i]
+			}
+			out[i] = p.tree.Eval(tv, row, c, s)
+		}
+		return
+	}
+
+	blk := evalBlock
+	if N < blk {
+		blk = N
+	}
+	stack := make([][]float64, p.depth)
+	mem := make([]float64, p.depth*blk)
+	for i := range stack {
+		stack[i] = mem[i*blk : (i+1)*blk]
+	}
+	for off := 0; off < N; off += blk {
+		n := blk
+		if off+n > N {
+			n = N - off
+		}
+		p.run(stack, off, n, t, x, c, s)
+		copy(out[off:off+n], stack[0][:n])
+	}
+}
+
+func (p *Program) run(stack [][]float64, off, n int, t []float64, x [][]float64, c, s []float64) {
+	sp := -1
+	for _, in := range p.code {
+		switch in.op {
+		case opConst, opConstF, opSys:
+			var v float64
+			switch in.op {
+			case opConst:
+				v = c[in.arg]
+			case opConstF:
+				v = p.vals[in.arg]
+			default:
+				v = s[in.arg]
+			}
+			sp++
+			dst := stack[sp][:n]
+			for i := range dst {
+				dst[i] = v
+			}
+		case opTime:
+			sp++
+			dst := stack[sp][:n]
+			if t == nil {
+				for i := range dst {
+					dst[i] = 0.0
+				}
+			} else {
+				copy(dst, t[off:off+n])
+			}
+		case opVar:
+			sp++
+			copy(stack[sp][:n], x[in.arg][off:off+n])
+
+		case opNeg:
+			dst := stack[sp][:n]
+			for i, v := range dst {
+				dst[i] = -v
+			}
+		case opAbs:
+			dst := stack[sp][:n]
+			for i, v := range dst {
+				dst[i] = math.Abs(v)
+			}
+		case opSqrt:
+			dst := stack[sp][:n]
+			for i, v := range dst {
+				dst[i] = math.Sqrt(v)
+			}
+		case opSin:
+			dst := stack[sp][:n]
+			for i, v := range dst {
+				dst[i] = math.Sin(v)
+			}
+		case opCos:
+			dst := stack[sp][:n]
+			for i, v := range dst {
+				dst[i] = math.Cos(v)
+			}
+		case opTan:
+			dst := stack[sp][:n]
+			for i, v := range dst {
+				dst[i] = math.Tan(v)
+			}
+		case opExp:
+			dst := stack[sp][:n]
+			for i, v := range dst {
+				dst[i] = math.Exp(v)
+			}
+		case opLog:
+			dst := stack[sp][:n]
+			for i, v := range dst {
+				dst[i] = math.Log(v)
+			}
+		case opPowI:
+			dst := stack[sp][:n]
+			pw := float64(in.arg)
+			switch in.arg {
+			case 2:
+				for i, v := range dst {
+					dst[i] = v * v
+				}
+			case -1:
+				for i, v := range dst {
+					dst[i] = 1.0 / v
+				}
+			default:
+				for i, v := range dst {
+					dst[i] = math.Pow(v, pw)
+				}
+			}
+		case opPowF:
+			dst := stack[sp][:n]
+			pw := p.vals[in.arg]
+			for i, v := range dst {
+				dst[i] = math.Pow(v, pw)
+			}
+
+		case opPowE:
+			dst, src := stack[sp-1][:n], stack[sp][:n]
+			for i, v := range src {
+				dst[i] = math.Pow(dst[i], v)
+			}
+			sp--
+		case opAdd:
+			dst, src := stack[sp-1][:n], stack[sp][:n]
+			for i, v := range src {
+				dst[i] += v
+			}
+			sp--
+		case opMul:
+			dst, src := stack[sp-1][:n], stack[sp][:n]
+			for i, v := range src {
+				dst[i] *= v
+			}
+			sp--
+		case opDiv:
+			dst, src := stack[sp-1][:n], stack[sp][:n]
+			for i, v := range src {
+				dst[i] /= v
+			}
+			sp--
+		}
+	}
+}
+
+// evalSet evaluates prog over one data set the way the problem type asks:
+// benchmarks see all indeps as x, diffeqs use indep 0 as time
+func evalSet(prog *Program, P *probs.ExprProblem, PS *probs.PointSet, coeff []float64, out []float64) {
+	cols := PS.IndepCols()
+	if P.SearchType == probs.ExprDiffeq {
+		prog.EvalColumns(cols[0], cols[1:], coeff, PS.SysVals(), out)
+	} else {
+		prog.EvalColumns(nil, cols, coeff, PS.SysVals(), out)
+	}
+}
+
+// Predict evaluates e with coeff at every point of PS,
+// the same way the search scores it
+func Predict(e expr.Expr, P *probs.ExprProblem, PS *probs.PointSet, coeff []float64) []float64 {
+	prog, _ := Compile(e)
+	out := make([]float64, len(PS.DepndCol(P.SearchVar)))
+	evalSet(prog, P, PS, coeff, out)
+	return out
+}
@@ -0,0 +1,177 @@
+package pge
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	expr "github.com/verdverm/go-symexpr"
+)
+
+// testColumns gives n points of nv variables in [0.5,2.5), positive so
+// Sqrt, Log and PowF are defined, and the Time column
+func testColumns(n, nv int) (t []float64, x [][]float64) {
+	rng := rand.New(rand.NewSource(1))
+	col := func() []float64 {
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = 0.5 + 2*rng.Float64()
+		}
+		return c
+	}
+	t = col()
+	x = make([][]float64, nv)
+	for j := range x {
+		x[j] = col()
+	}
+	return t, x
+}
+
+// one expression for each opcode, and some nesting
+func compileExprs() map[string]expr.Expr {
+	x, y := expr.NewVar(0), expr.NewVar(1)
+	sum := func(cs ...expr.Expr) expr.Expr {
+		A := expr.NewAdd()
+		for _, c := range cs {
+			A.Insert(c)
+		}
+		return A
+	}
+	prod := func(cs ...expr.Expr) expr.Expr {
+		M := expr.NewMul()
+		for _, c := range cs {
+			M.Insert(c)
+		}
+		return M
+	}
+
+	return map[string]expr.Expr{
+		"const":  expr.NewConstant(1),
+		"constf": expr.NewConstantF(0.25),
+		"time":   expr.NewTime(),
+		"var":    expr.NewVar(2),
+		"sys":    expr.NewSystem(1),
+		"neg":    expr.NewNeg(x.Clone()),
+		"abs":    expr.NewAbs(sum(x.Clone(), expr.NewNeg(y.Clone()))),
+		"sqrt":   expr.NewSqrt(x.Clone()),
+		"sin":    expr.NewSin(x.Clone()),
+		"cos":    expr.NewCos(x.Clone()),
+		"tan":    expr.NewTan(x.Clone()),
+		"exp":    expr.NewExp(x.Clone()),
+		"log":    expr.NewLog(x.Clone()),
+		"powi2":  expr.NewPowI(x.Clone(), 2),
+		"powi-1": expr.NewPowI(x.Clone(), -1),
+		"powi3":  expr.NewPowI(x.Clone(), 3),
+		"powf":   expr.NewPowF(x.Clone(), 1.5),
+		"powe":   expr.NewPowE(x.Clone(), y.Clone()),
+		"add":    sum(x.Clone(), y.Clone(), expr.NewConstant(0)),
+		"mul":    prod(x.Clone(), y.Clone(), expr.NewConstant(0)),
+		"div":    expr.NewDiv(x.Clone(), y.Clone()),
+		"nested": sum(
+			expr.NewConstant(0),
+			prod(expr.NewConstant(1), expr.NewSin(prod(x.Clone(), expr.NewTime()))),
+			expr.NewDiv(prod(expr.NewConstant(2), expr.NewPowI(y.Clone(), 2)),
+				sum(expr.NewConstantF(1), expr.NewExp(expr.NewNeg(expr.NewVar(2))))),
+			prod(expr.NewSystem(0), expr.NewLog(sum(x.Clone(), y.Clone()))),
+		),
+	}
+}
+
+func TestCompileEval(t *testing.T) {
+	// not a multiple of evalBlock, so the last block is a short one
+	N := 2*evalBlock + 37
+	tc, xc := testColumns(N, 3)
+	c := []float64{1.5, -0.75, 2}
+	s := []float64{0.5, 3}
+
+	used := make(map[opcode]bool)
+	row := make([]float64, len(xc))
+	out := make([]float64, N)
+	for name, e := range compileExprs() {
+		prog, err := Compile(e)
+		if err != nil || !prog.Compiled() {
+			t.Fatalf("%s: %v didn't compile: %v", name, e, err)
+		}
+		for _, in := range prog.code {
+			used[in.op] = true
+		}
+
+		prog.EvalColumns(tc, xc, c, s, out)
+		for i := 0; i < N; i++ {
+			for j := range xc {
+				row[j] = xc[j][i]
+			}
+			want := e.Eval(tc[i], row, c, s)
+			if d := math.Abs(out[i] - want); !(d <= 1e-12*(1+math.Abs(want))) {
+				t.Fatalf("%s: %v at point %d is %.17g, want %.17g", name, prog, i, out[i], want)
+			}
+			if i == N/2 {
+				if got := prog.Eval(tc[i], row, c, s); got != out[i] {
+					t.Errorf("%s: Eval gave %.17g, EvalColumns %.17g", name, got, out[i])
+				}
+			}
+		}
+	}
+	for op := range opNames {
+		if !used[opcode(op)] {
+			t.Errorf("no test expression uses %s", opNames[op])
+		}
+	}
+}
+
+// nil Time evaluates as 0
+func TestCompileNilTime(t *testing.T) {
+	prog, err := Compile(expr.NewTime())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, xc := testColumns(10, 1)
+	out := make([]float64, 10)
+	for i := range out {
+		out[i] = 1
+	}
+	prog.EvalColumns(nil, xc, nil, nil, out)
+	for i, v := range out {
+		if v != 0 {
+			t.Fatalf("point %d: Time = %g with no time column", i, v)
+		}
+	}
+}
+
+// a typical candidate of a search
+func benchExpr() expr.Expr {
+	return compileExprs()["nested"]
+}
+
+const benchPoints = 10000
+
+func BenchmarkEvalCompiled(b *testing.B) {
+	tc, xc := testColumns(benchPoints, 3)
+	c, s := []float64{1.5, -0.75, 2}, []float64{0.5, 3}
+	prog, err := Compile(benchExpr())
+	if err != nil {
+		b.Fatal(err)
+	}
+	out := make([]float64, benchPoints)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		prog.EvalColumns(tc, xc, c, s, out)
+	}
+}
+
+func BenchmarkEvalTree(b *testing.B) {
+	tc, xc := testColumns(benchPoints, 3)
+	c, s := []float64{1.5, -0.75, 2}, []float64{0.5, 3}
+	e := benchExpr()
+	out := make([]float64, benchPoints)
+	row := make([]float64, len(xc))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range out {
+			for j := range xc {
+				row[j] = xc[j][i]
+			}
+			out[i] = e.Eval(tc[i], row, c, s)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package pge
+
+import (
+	"fmt"
+
+	expr "github.com/verdverm/go-symexpr"
+)
+
+// derivPrograms compiles the partial derivative of e with respect to
+// each of its numC constants. A nil entry means the derivative is zero.
+func derivPrograms(e expr.Expr, numC int) ([]*Program, error) {
+	progs := make([]*Program, numC)
+	for k := 0; k < numC; k++ {
+		d, err := derivConst(e, k)
+		if err != nil {
+			return nil, err
+		}
+		if d == nil {
+			continue
+		}
+		progs[k], _ = Compile(d)
+	}
+	return progs, nil
+}
+
+/* Symbolic partial derivative with respect to Constant k
+ *
+ * Returns nil when the derivative is identically zero, which also
+ * prunes the product and chain rule terms as they are built. The result
+ * shares no nodes with e.
+ */
+func derivConst(e expr.Expr, k int) (expr.Expr, error) {
+	if e == nil {
+		return nil, fmt.Errorf("nil expression")
+	}
+	switch n := e.(type) {
+	case *expr.Constant:
+		if n.P == k {
+			return expr.NewConstantF(1.0), nil
+		}
+		return nil, nil
+	case *expr.ConstantF, *expr.Time, *expr.Var, *expr.System:
+		return nil, nil
+
+	case *expr.Neg:
+		du, err := derivConst(n.C, k)
+		if du == nil || err != nil {
+			return nil, err
+		}
+		return expr.NewNeg(du), nil
+	case *expr.Abs:
+		// du * u/|u|
+		return chain(n.C, k, func(u expr.Expr) expr.Expr {
+			return expr.NewDiv(u.Clone(), expr.NewAbs(u.Clone()))
+		})
+	case *expr.Sqrt:
+		// du / (2 sqrt(u))
+		return chain(n.C, k, func(u expr.Expr) expr.Expr {
+			return expr.NewDiv(expr.NewConstantF(0.5), expr.NewSqrt(u.Clone()))
+		})
+	case *expr.Sin:
+		return chain(n.C, k, func(u expr.Expr) expr.Expr {
+			return expr.NewCos(u.Clone())
+		})
+	case *expr.Cos:
+		return chain(n.C, k, func(u expr.Expr) expr.Expr {
+			return expr.NewNeg(expr.NewSin(u.Clone()))
+		})
+	case *expr.Tan:
+		return chain(n.C, k, func(u expr.Expr) expr.Expr {
+			return expr.NewPowI(expr.NewCos(u.Clone()), -2)
+		})
+	case *expr.Exp:
+		return chain(n.C, k, func(u expr.Expr) expr.Expr {
+			return expr.NewExp(u.Clone())
+		})
+	case *expr.Log:
+		return chain(n.C, k, func(u expr.Expr) expr.Expr {
+			return expr.NewPowI(u.Clone(), -1)
+		})
+	case *expr.PowI:
+		// n u^(n-1) du
+		return chain(n.Base, k, func(u expr.Expr) expr.Expr {
+			switch n.Power {
+			case 1:
+				return expr.NewConstantF(1.0)
+			case 2:
+				return product(expr.NewConstantF(2.0), u.Clone())
+			}
+			return product(expr.NewConstantF(float64(n.Power)), expr.NewPowI(u.Clone(), n.Power-1))
+		})
+	case *expr.PowF:
+		return chain(n.Base, k, func(u expr.Expr) expr.Expr {
+			return product(expr.NewConstantF(n.Power), expr.NewPowF(u.Clone(), n.Power-1.0))
+		})
+	case *expr.PowE:
+		// u^v (dv log(u) + v du / u)
+		du, err := derivConst(n.Base, k)
+		if err != nil {
+			return nil, err
+		}
+		dv, err := derivConst(n.Power, k)
+		if err != nil {
+			return nil, err
+		}
+		var terms []expr.Expr
+		if dv != nil {
+			terms = append(terms, product(dv, expr.NewLog(n.Base.Clone())))
+		}
+		if du != nil {
+			terms = append(terms, product(n.Power.Clone(), du, expr.NewPowI(n.Base.Clone(), -1)))
+		}
+		if len(terms) == 0 {
+			return nil, nil
+		}
+		return product(n.Clone(), sum(terms...)), nil
+	case *expr.Div:
+		// du/v - u dv / v^2
+		du, err := derivConst(n.Numer, k)
+		if err != nil {
+			return nil, err
+		}
+		dv, err := derivConst(n.Denom, k)
+		if err != nil {
+			return nil, err
+		}
+		var terms []expr.Expr
+		if du != nil {
+			terms = append(terms, expr.NewDiv(du, n.Denom.Clone()))
+		}
+		if dv != nil {
+			terms = append(terms, expr.NewNeg(expr.NewDiv(product(n.Numer.Clone(), dv),
+				expr.NewPowI(n.Denom.Clone(), 2))))
+		}
+		if len(terms) == 0 {
+			return nil, nil
+		}
+		return sum(terms...), nil
+
+	case *expr.Add:
+		var terms []expr.Expr
+		for _, c := range n.CS {
+			if c == nil {
+				continue
+			}
+			dc, err := derivConst(c, k)
+			if err != nil {
+				return nil, err
+			}
+			if dc != nil {
+				terms = append(terms, dc)
+			}
+		}
+		if len(terms) == 0 {
+			return nil, nil
+		}
+		return sum(terms...), nil
+	case *expr.Mul:
+		var cs []expr.Expr
+		for _, c := range n.CS {
+			if c != nil {
+				cs = append(cs, c)
+			}
+		}
+		var terms []expr.Expr
+		for i, c := range cs {
+			dc, err := derivConst(c, k)
+			if err != nil {
+				return nil, err
+			}
+			if dc == nil {
+				continue
+			}
+			fs := []expr.Expr{dc}
+			for j, o := range cs {
+				if j != i {
+					fs = append(fs, o.Clone())
+				}
+			}
+			terms = append(terms, product(fs...))
+		}
+		if len(terms) == 0 {
+			return nil, nil
+		}
+		return sum(terms...), nil
+	}
+	return nil, fmt.Errorf("cannot differentiate %T", e)
+}
+
+// du * outer(u), nil when du is zero
+func chain(u expr.Expr, k int, outer func(u expr.Expr) expr.Expr) (expr.Expr, error) {
+	du, err := derivConst(u, k)
+	if du == nil || err != nil {
+		return nil, err
+	}
+	return product(du, outer(u)), nil
+}
+
+// product and sum skip the Mul / Add node when there's only one term,
+// and product drops factors of exactly 1
+func product(fs ...expr.Expr) expr.Expr {
+	M := expr.NewMul()
+	cnt := 0
+	var last expr.Expr
+	for _, f := range fs {
+		if cf, ok := f.(*expr.ConstantF); ok && cf.F == 1.0 {
+			continue
+		}
+		M.Insert(f)
+		last = f
+		cnt++
+	}
+	switch cnt {
+	case 0:
+		return expr.NewConstantF(1.0)
+	case 1:
+		return last
+	}
+	return M
+}
+
+func sum(ts ...expr.Expr) expr.Expr {
+	if len(ts) == 1 {
+		return ts[0]
+	}
+	A := expr.NewAdd()
+	for _, t := range ts {
+		A.Insert(t)
+	}
+	return A
+}
@@ -0,0 +1,104 @@
+package pge
+
+import (
+	"io/ioutil"
+	"math"
+	"testing"
+
+	config "github.com/verdverm/go-pge/config"
+	probs "github.com/verdverm/go-pge/problems"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+// the config and data written by  pge -gen diffeq:DampedOscillator -seed 1
+const (
+	diffeqTestCfg  = "../config/prob/diffeq/DampedOscillator_v.cfg"
+	diffeqTestData = "../data/"
+)
+
+// loadDiffeqProblem reads the problem the way MainSearch does
+func loadDiffeqProblem(t *testing.T) *probs.ExprProblem {
+	data, err := ioutil.ReadFile(diffeqTestCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	P := new(probs.ExprProblem)
+	if err = config.ParseConfig(data, probs.ProbConfigParser, P); err != nil {
+		t.Fatal(err)
+	}
+	read := func(fns []string) (sets []*probs.PointSet) {
+		for _, fn := range fns {
+			PS, err := probs.LoadPointSet(diffeqTestData+fn, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sets = append(sets, PS)
+		}
+		return sets
+	}
+	P.Train = read(P.TrainFns)
+	P.Test = read(P.TestFns)
+	return P
+}
+
+// d(v) = -k*x - c*v with the states as Var 0, 1 and k, c as System 0, 1,
+// Time being indep 0 of the data
+func dampedRHS(coeff bool) expr.Expr {
+	term := func(s, x int) expr.Expr {
+		M := expr.NewMul()
+		if coeff {
+			M.Insert(expr.NewConstant(0))
+		} else {
+			M.Insert(expr.NewConstantF(-1))
+		}
+		M.Insert(expr.NewSystem(s))
+		M.Insert(expr.NewVar(x))
+		return M
+	}
+	A := expr.NewAdd()
+	A.Insert(term(0, 0))
+	A.Insert(term(1, 1))
+	return A
+}
+
+func TestDiffeqEvalSet(t *testing.T) {
+	P := loadDiffeqProblem(t)
+	if P.SearchType != probs.ExprDiffeq {
+		t.Fatalf("SearchType %v, want ExprDiffeq", P.SearchType)
+	}
+
+	rhs := dampedRHS(false)
+	for _, PS := range append(P.Train, P.Test...) {
+		if PS.IndepName(0) != "Time" {
+			t.Fatalf("%s: indep 0 is %s, want Time", PS.FN(), PS.IndepName(0))
+		}
+		ts := Predict(expr.NewTime(), P, PS, nil)
+		for i, tv := range PS.IndepCol(0) {
+			if ts[i] != tv {
+				t.Fatalf("%s point %d: Time = %g, want %g", PS.FN(), i, ts[i], tv)
+			}
+		}
+
+		// the data keeps every digit, so the true derivative is matched
+		// to rounding, which six decimals would not
+		got, want := Predict(rhs, P, PS, nil), PS.DepndCol(P.SearchVar)
+		for i := range want {
+			if d := math.Abs(got[i] - want[i]); d > 1e-12*(1+math.Abs(want[i])) {
+				t.Fatalf("%s point %d: %v = %.17g, want %.17g", PS.FN(), i, rhs, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestDiffeqRegress(t *testing.T) {
+	P := loadDiffeqProblem(t)
+	R := RegressExpr(dampedRHS(true), P, nil)
+	for i, c := range R.Coeff() {
+		if math.Abs(c+1) > 1e-6 {
+			t.Errorf("coeff %d = %g, want -1", i, c)
+		}
+	}
+	if R.TrainError() > 1e-9 || R.TestError() > 1e-9 {
+		t.Errorf("train error %g, test error %g, want 0", R.TrainError(), R.TestError())
+	}
+}
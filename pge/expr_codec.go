@@ -0,0 +1,318 @@
+package pge
+
+import (
+	"fmt"
+
+	results "github.com/verdverm/go-pge/results"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+/* Wire form of an expression
+ *
+ * Prefix order, each node is its code followed by its arguments:
+ *
+ *   CONSTANT P   CONSTANTF vi   TIME   VAR P   SYSTEM P
+ *   NEG..LOG child   POWI power base   POWF vi base   POWE base power
+ *   DIV numer denom   ADD n children...   MUL n children...
+ *
+ * vi indexes into Vals. Child order is kept exactly, so numbering the
+ * constants on either end gives the same coefficient layout.
+ *
+ * The codes are the codec's own, not go-symexpr's ExprType values, so
+ * the RPC and the results logs don't change when that enum does.
+ * Append new codes, never renumber.
+ */
+type ExprCode struct {
+	Ops  []int
+	Vals []float64
+}
+
+const (
+	codeConstant = iota + 1
+	codeConstantF
+	codeTime
+	codeVar
+	codeSystem
+	codeNeg
+	codeAbs
+	codeSqrt
+	codeSin
+	codeCos
+	codeTan
+	codeExp
+	codeLog
+	codePowI
+	codePowF
+	codePowE
+	codeAdd
+	codeMul
+	codeDiv
+)
+
+// the code of each node type, and back
+var exprCodes = map[expr.ExprType]int{
+	expr.CONSTANT:  codeConstant,
+	expr.CONSTANTF: codeConstantF,
+	expr.TIME:      codeTime,
+	expr.VAR:       codeVar,
+	expr.SYSTEM:    codeSystem,
+	expr.NEG:       codeNeg,
+	expr.ABS:       codeAbs,
+	expr.SQRT:      codeSqrt,
+	expr.SIN:       codeSin,
+	expr.COS:       codeCos,
+	expr.TAN:       codeTan,
+	expr.EXP:       codeExp,
+	expr.LOG:       codeLog,
+	expr.POWI:      codePowI,
+	expr.POWF:      codePowF,
+	expr.POWE:      codePowE,
+	expr.ADD:       codeAdd,
+	expr.MUL:       codeMul,
+	expr.DIV:       codeDiv,
+}
+
+var exprTypes = func() map[int]expr.ExprType {
+	m := make(map[int]expr.ExprType, len(exprCodes))
+	for t, c := range exprCodes {
+		m[c] = t
+	}
+	return m
+}()
+
+func EncodeExpr(e expr.Expr) (code ExprCode, err error) {
+	err = code.encode(e)
+	return
+}
+
+func (code *ExprCode) encode(e expr.Expr) error {
+	if e == nil {
+		return fmt.Errorf("nil expression")
+	}
+	op := func(args ...int) { code.Ops = append(code.Ops, args...) }
+	val := func(v float64) int {
+		code.Vals = append(code.Vals, v)
+		return len(code.Vals) - 1
+	}
+	T, ok := exprCodes[e.ExprType()]
+	if !ok {
+		return fmt.Errorf("cannot encode %T", e)
+	}
+
+	switch n := e.(type) {
+	case *expr.Constant:
+		op(T, n.P)
+	case *expr.ConstantF:
+		op(T, val(n.F))
+	case *expr.Time:
+		op(T)
+	case *expr.Var:
+		op(T, n.P)
+	case *expr.System:
+		op(T, n.P)
+
+	case *expr.Neg:
+		op(T)
+		return code.encode(n.C)
+	case *expr.Abs:
+		op(T)
+		return code.encode(n.C)
+	case *expr.Sqrt:
+		op(T)
+		return code.encode(n.C)
+	case *expr.Sin:
+		op(T)
+		return code.encode(n.C)
+	case *expr.Cos:
+		op(T)
+		return code.encode(n.C)
+	case *expr.Tan:
+		op(T)
+		return code.encode(n.C)
+	case *expr.Exp:
+		op(T)
+		return code.encode(n.C)
+	case *expr.Log:
+		op(T)
+		return code.encode(n.C)
+
+	case *expr.PowI:
+		op(T, n.Power)
+		return code.encode(n.Base)
+	case *expr.PowF:
+		op(T, val(n.Power))
+		return code.encode(n.Base)
+	case *expr.PowE:
+		op(T)
+		if err := code.encode(n.Base); err != nil {
+			return err
+		}
+		return code.encode(n.Power)
+	case *expr.Div:
+		op(T)
+		if err := code.encode(n.Numer); err != nil {
+			return err
+		}
+		return code.encode(n.Denom)
+
+	case *expr.Add:
+		return code.encodeList(T, n.CS)
+	case *expr.Mul:
+		return code.encodeList(T, n.CS)
+
+	default:
+		return fmt.Errorf("cannot encode %T", e)
+	}
+	return nil
+}
+
+func (code *ExprCode) encodeList(T int, cs []expr.Expr) error {
+	cnt := 0
+	for _, c := range cs {
+		if c != nil {
+			cnt++
+		}
+	}
+	code.Ops = append(code.Ops, T, cnt)
+	for _, c := range cs {
+		if c == nil {
+			continue
+		}
+		if err := code.encode(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResultsCode is EncodeExpr for a results log, nil when e can't be encoded
+func ResultsCode(e expr.Expr) *results.Code {
+	code, err := EncodeExpr(e)
+	if err != nil {
+		return nil
+	}
+	return &results.Code{Ops: code.Ops, Vals: results.Floats(code.Vals)}
+}
+
+// DecodeResults rebuilds the expression of a logged report
+func DecodeResults(R *results.Report) (expr.Expr, error) {
+	if R.Code == nil {
+		return nil, fmt.Errorf("report has no expression code")
+	}
+	code := ExprCode{Ops: R.Code.Ops, Vals: make([]float64, len(R.Code.Vals))}
+	for i, v := range R.Code.Vals {
+		code.Vals[i] = float64(v)
+	}
+	return DecodeExpr(code)
+}
+
+func DecodeExpr(code ExprCode) (e expr.Expr, err error) {
+	pos := 0
+	defer func() {
+		// index out of range on a truncated code
+		if r := recover(); r != nil {
+			e, err = nil, fmt.Errorf("bad expression code at %d: %v", pos, r)
+		}
+	}()
+	e, err = code.decode(&pos)
+	if err == nil && pos != len(code.Ops) {
+		err = fmt.Errorf("%d trailing ops in expression code", len(code.Ops)-pos)
+	}
+	if err == nil {
+		e.CalcExprStats()
+	}
+	return e, err
+}
+
+func (code *ExprCode) decode(pos *int) (expr.Expr, error) {
+	next := func() int {
+		v := code.Ops[*pos]
+		*pos++
+		return v
+	}
+	child := func(wrap func(c expr.Expr) expr.Expr) (expr.Expr, error) {
+		c, err := code.decode(pos)
+		if err != nil {
+			return nil, err
+		}
+		return wrap(c), nil
+	}
+	pair := func(wrap func(a, b expr.Expr) expr.Expr) (expr.Expr, error) {
+		a, err := code.decode(pos)
+		if err != nil {
+			return nil, err
+		}
+		b, err := code.decode(pos)
+		if err != nil {
+			return nil, err
+		}
+		return wrap(a, b), nil
+	}
+
+	C := next()
+	T, ok := exprTypes[C]
+	if !ok {
+		return nil, fmt.Errorf("unknown expression code %d", C)
+	}
+	switch T {
+	case expr.CONSTANT:
+		return expr.NewConstant(next()), nil
+	case expr.CONSTANTF:
+		return expr.NewConstantF(code.Vals[next()]), nil
+	case expr.TIME:
+		return expr.NewTime(), nil
+	case expr.VAR:
+		return expr.NewVar(next()), nil
+	case expr.SYSTEM:
+		return expr.NewSystem(next()), nil
+
+	case expr.NEG:
+		return child(func(c expr.Expr) expr.Expr { return expr.NewNeg(c) })
+	case expr.ABS:
+		return child(func(c expr.Expr) expr.Expr { return expr.NewAbs(c) })
+	case expr.SQRT:
+		return child(func(c expr.Expr) expr.Expr { return expr.NewSqrt(c) })
+	case expr.SIN:
+		return child(func(c expr.Expr) expr.Expr { return expr.NewSin(c) })
+	case expr.COS:
+		return child(func(c expr.Expr) expr.Expr { return expr.NewCos(c) })
+	case expr.TAN:
+		return child(func(c expr.Expr) expr.Expr { return expr.NewTan(c) })
+	case expr.EXP:
+		return child(func(c expr.Expr) expr.Expr { return expr.NewExp(c) })
+	case expr.LOG:
+		return child(func(c expr.Expr) expr.Expr { return expr.NewLog(c) })
+
+	case expr.POWI:
+		p := next()
+		return child(func(c expr.Expr) expr.Expr { return expr.NewPowI(c, p) })
+	case expr.POWF:
+		p := code.Vals[next()]
+		return child(func(c expr.Expr) expr.Expr { return expr.NewPowF(c, p) })
+	case expr.POWE:
+		return pair(func(a, b expr.Expr) expr.Expr { return expr.NewPowE(a, b) })
+	case expr.DIV:
+		return pair(func(a, b expr.Expr) expr.Expr { return expr.NewDiv(a, b) })
+
+	case expr.ADD, expr.MUL:
+		cnt := next()
+		cs := make([]expr.Expr, cnt)
+		for i := range cs {
+			c, err := code.decode(pos)
+			if err != nil {
+				return nil, err
+			}
+			cs[i] = c
+		}
+		// set the children directly, Insert could reorder them
+		if T == expr.ADD {
+			A := expr.NewAdd()
+			A.CS = cs
+			return A, nil
+		}
+		M := expr.NewMul()
+		M.CS = cs
+		return M, nil
+	}
+	return nil, fmt.Errorf("unknown expression code %d", C)
+}
@@ -0,0 +1,144 @@
+package pge
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	results "github.com/verdverm/go-pge/results"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+// an expression of every node type the codec knows, and some nesting
+func codecExprs() []expr.Expr {
+	x, y := expr.NewVar(0), expr.NewVar(1)
+	term := expr.NewMul()
+	term.Insert(expr.NewConstant(1))
+	term.Insert(x.Clone())
+	term.Insert(expr.NewSystem(0))
+	add := expr.NewAdd()
+	add.Insert(expr.NewConstant(0))
+	add.Insert(term)
+	add.Insert(expr.NewDiv(expr.NewSin(expr.NewTime()), expr.NewPowI(y.Clone(), 2)))
+	mul := expr.NewMul()
+	mul.Insert(expr.NewConstantF(-2.5))
+	mul.Insert(expr.NewExp(expr.NewNeg(x.Clone())))
+
+	return []expr.Expr{
+		expr.NewConstant(3),
+		expr.NewConstantF(0.1),
+		expr.NewTime(),
+		expr.NewVar(2),
+		expr.NewSystem(1),
+		expr.NewNeg(x.Clone()),
+		expr.NewAbs(x.Clone()),
+		expr.NewSqrt(x.Clone()),
+		expr.NewSin(x.Clone()),
+		expr.NewCos(x.Clone()),
+		expr.NewTan(x.Clone()),
+		expr.NewExp(x.Clone()),
+		expr.NewLog(x.Clone()),
+		expr.NewPowI(x.Clone(), -3),
+		expr.NewPowF(x.Clone(), 1.5),
+		expr.NewPowE(x.Clone(), y.Clone()),
+		expr.NewDiv(x.Clone(), y.Clone()),
+		add,
+		mul,
+	}
+}
+
+func TestExprCodecRoundTrip(t *testing.T) {
+	seen := make(map[expr.ExprType]bool)
+	var walk func(e expr.Expr)
+	walk = func(e expr.Expr) {
+		seen[e.ExprType()] = true
+		switch n := e.(type) {
+		case *expr.Add:
+			for _, c := range n.CS {
+				walk(c)
+			}
+		case *expr.Mul:
+			for _, c := range n.CS {
+				walk(c)
+			}
+		case *expr.Div:
+			walk(n.Numer)
+			walk(n.Denom)
+		case *expr.PowE:
+			walk(n.Base)
+			walk(n.Power)
+		}
+	}
+
+	tv, xs, cs, ss := 0.7, []float64{0.3, 1.7, 2.2}, []float64{1.1, -0.4, 2, 3}, []float64{0.9, 4}
+	for _, e := range codecExprs() {
+		walk(e)
+		code, err := EncodeExpr(e)
+		if err != nil {
+			t.Fatalf("%v: %v", e, err)
+		}
+		d, err := DecodeExpr(code)
+		if err != nil {
+			t.Fatalf("%v: decode %v: %v", e, code, err)
+		}
+		if d.String() != e.String() {
+			t.Errorf("round trip of %v gave %v", e, d)
+		}
+		again, err := EncodeExpr(d)
+		if err != nil || !reflect.DeepEqual(again, code) {
+			t.Errorf("%v: encoded %v, then %v (%v)", e, code, again, err)
+		}
+		want, got := e.Eval(tv, xs, cs, ss), d.Eval(tv, xs, cs, ss)
+		if got != want && !(math.IsNaN(got) && math.IsNaN(want)) {
+			t.Errorf("%v: evaluates to %g after the round trip, %g before", e, got, want)
+		}
+
+		// and through a results log record
+		R := &results.Report{Code: ResultsCode(e)}
+		if d, err = DecodeResults(R); err != nil || d.String() != e.String() {
+			t.Errorf("%v: results round trip gave %v (%v)", e, d, err)
+		}
+	}
+	for T := range exprCodes {
+		if !seen[T] {
+			t.Errorf("no test expression of type %d", T)
+		}
+	}
+}
+
+func TestExprCodecBad(t *testing.T) {
+	good, err := EncodeExpr(codecExprs()[len(codecExprs())-2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad := []ExprCode{
+		{},                                // empty
+		{Ops: good.Ops[:len(good.Ops)-1]}, // truncated
+		{Ops: append(append([]int{}, good.Ops...), codeTime)}, // trailing
+		{Ops: []int{0}},                // not a code
+		{Ops: []int{codeDiv + 100}},    // not a code either
+		{Ops: []int{codeConstantF, 0}}, // missing value
+	}
+	for _, code := range bad {
+		if e, err := DecodeExpr(code); err == nil {
+			t.Errorf("decoding %v gave %v, want an error", code, e)
+		}
+	}
+	if _, err := EncodeExpr(nil); err == nil {
+		t.Error("encoding nil, want an error")
+	}
+}
+
+// the codes are part of the wire and log formats
+func TestExprCodecCodes(t *testing.T) {
+	code, err := EncodeExpr(expr.NewDiv(expr.NewVar(1), expr.NewConstant(0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{19, 4, 1, 1, 0}; !reflect.DeepEqual(code.Ops, want) {
+		t.Errorf("x_1 / c_0 encodes as %v, want %v", code.Ops, want)
+	}
+	if len(exprTypes) != len(exprCodes) {
+		t.Errorf("%d codes for %d node types, two types share a code", len(exprTypes), len(exprCodes))
+	}
+}
@@ -0,0 +1,455 @@
+package pge
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	probs "github.com/verdverm/go-pge/problems"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+// options for fitting the coefficients of a candidate
+type FitParams struct {
+	Method   string  // native, the only fitter
+	Jacobian string  // analytic, fd (finite differences)
+	MaxIter  int     // iteration cap for the native fitter
+	Tol      float64 // relative tolerance on the step, gradient and cost
+
+	TermCacheMB int // memory for cached term columns, <0 disables
+
+	terms *termCache // shared by the evaluators, nil when disabled
+}
+
+func (fp *FitParams) defaults() {
+	if fp.Method == "" {
+		fp.Method = "native"
+	}
+	if fp.Jacobian == "" {
+		fp.Jacobian = "analytic"
+	}
+	if fp.MaxIter <= 0 {
+		fp.MaxIter = 200
+	}
+	if fp.Tol <= 0.0 {
+		fp.Tol = 1e-12
+	}
+	if fp.TermCacheMB == 0 {
+		fp.TermCacheMB = 256
+	}
+}
+
+func parseFitParams(field, value string, fp *FitParams) (found bool, err error) {
+	found = true
+	switch strings.ToUpper(field) {
+	case "FITMETHOD":
+		// the levmar library fits trees, not compiled programs
+		switch strings.ToLower(value) {
+		case "native":
+			fp.Method = strings.ToLower(value)
+		default:
+			err = fmt.Errorf("unknown FitMethod %q", value)
+		}
+	case "FITJACOBIAN":
+		switch strings.ToLower(value) {
+		case "analytic", "fd":
+			fp.Jacobian = strings.ToLower(value)
+		default:
+			err = fmt.Errorf("unknown FitJacobian %q", value)
+		}
+	case "FITMAXITER":
+		fp.MaxIter, err = strconv.Atoi(value)
+	case "FITTOL":
+		fp.Tol, err = strconv.ParseFloat(value, 64)
+	case "TERMCACHEMB":
+		fp.TermCacheMB, err = strconv.Atoi(value)
+	default:
+		found = false
+	}
+	return
+}
+
+/* Least squares fitting model
+ *
+ * Residuals are sqrt(w)*(f(x;c) - y) over all the training sets, laid out
+ * set after set. The Jacobian is stored column-wise, J[j] holding the
+ * derivative of every residual with respect to c_j, so each column is
+ * one pass of a compiled program over the data. With jprogs set the
+ * columns come from the compiled partial derivatives, otherwise they
+ * are estimated by forward differences.
+ *
+ * When the terms are split (useTerms), the output is assembled from the
+ * cached c_k * basis columns plus the program for the remaining terms,
+ * and the Jacobian column of such a c_k is just its basis.
+ */
+type fitModel struct {
+	P      *probs.ExprProblem
+	prog   *Program
+	jprogs []*Program // d prog / d c_j, nil entries are zero
+	sets   []*probs.PointSet
+
+	lin      []linCol
+	linOf    []int // coefficient -> index into lin, -1 if not linear
+	rest     *Program
+	restExpr expr.Expr // the remaining terms, nil if none
+
+	ys    []float64
+	sqrtw []float64 // nil when unweighted
+	tmp   []float64
+}
+
+func newFitModel(prog *Program, P *probs.ExprProblem, sets []*probs.PointSet) *fitModel {
+	m := &fitModel{P: P, prog: prog, sets: sets}
+	weighted := false
+	for _, PS := range sets {
+		m.ys = append(m.ys, PS.DepndCol(P.SearchVar)...)
+		if PS.Weighted() {
+			weighted = true
+		}
+	}
+	if weighted {
+		for _, PS := range sets {
+			ws := PS.Weights()
+			for i := 0; i < PS.NumPoints(); i++ {
+				w := 1.0
+				if ws != nil {
+					w = ws[i]
+				}
+				m.sqrtw = append(m.sqrtw, math.Sqrt(w))
+			}
+		}
+	}
+	m.tmp = make([]float64, len(m.ys))
+	return m
+}
+
+type linCol struct {
+	k   int       // coefficient, -1 for none
+	col []float64 // basis column, nil for all ones
+}
+
+func (m *fitModel) numResid() int { return len(m.ys) }
+
+// useTerms switches to assembling the output from cached term columns.
+// Does nothing when e has no c_k * basis terms.
+func (m *fitModel) useTerms(e expr.Expr, numC int, tc *termCache) {
+	split, rest := splitTerms(e)
+	if len(split) == 0 {
+		return
+	}
+	m.lin = make([]linCol, len(split))
+	m.linOf = make([]int, numC)
+	for i := range m.linOf {
+		m.linOf[i] = -1
+	}
+	for i, st := range split {
+		m.lin[i].k = st.k
+		if st.k >= 0 && st.k < numC {
+			m.linOf[st.k] = i
+		}
+		if st.basis == nil {
+			continue
+		}
+		basis := st.basis
+		key := serialKey(basis.Serial(make([]int, 0, 64)))
+		m.lin[i].col = tc.get(key, func() []float64 {
+			bp, _ := Compile(basis)
+			col := make([]float64, len(m.ys))
+			m.eval(bp, nil, col)
+			return col
+		})
+	}
+	if len(rest) > 0 {
+		m.restExpr = sum(rest...)
+		m.rest, _ = Compile(m.restExpr)
+	}
+}
+
+// useAnalytic compiles the partial derivatives of e for the Jacobian,
+// leaving finite differences in place if e can't be differentiated
+func (m *fitModel) useAnalytic(e expr.Expr, numC int) {
+	if m.lin != nil {
+		if m.restExpr == nil {
+			m.jprogs = make([]*Program, numC)
+			return
+		}
+		e = m.restExpr
+	}
+	if progs, err := derivPrograms(e, numC); err == nil {
+		m.jprogs = progs
+	}
+}
+
+// evaluate prog into out over all sets
+func (m *fitModel) eval(prog *Program, c, out []float64) {
+	off := 0
+	for _, PS := range m.sets {
+		n := PS.NumPoints()
+		evalSet(prog, m.P, PS, c, out[off:off+n])
+		off += n
+	}
+}
+
+func (m *fitModel) residuals(c, r []float64) {
+	if m.lin == nil {
+		m.eval(m.prog, c, r)
+	} else {
+		if m.rest != nil {
+			m.eval(m.rest, c, r)
+		} else {
+			for i := range r {
+				r[i] = 0.0
+			}
+		}
+		for _, t := range m.lin {
+			ck := 1.0
+			if t.k >= 0 {
+				ck = c[t.k]
+			}
+			if t.col == nil {
+				for i := range r {
+					r[i] += ck
+				}
+				continue
+			}
+			for i, v := range t.col {
+				r[i] += ck * v
+			}
+		}
+	}
+	for i, y := range m.ys {
+		r[i] -= y
+	}
+	if m.sqrtw != nil {
+		for i, w := range m.sqrtw {
+			r[i] *= w
+		}
+	}
+}
+
+// r holds the residuals at c
+func (m *fitModel) jacobian(c, r []float64, J [][]float64) {
+	for j := range c {
+		if m.jprogs == nil || !m.analyticColumn(j, c, J[j]) {
+			m.fdColumn(j, c, r, J[j])
+		}
+	}
+}
+
+// false when the derivative isn't finite everywhere, e.g. Abs or Sqrt at 0
+func (m *fitModel) analyticColumn(j int, c, col []float64) bool {
+	if m.lin != nil && m.linOf[j] >= 0 {
+		basis := m.lin[m.linOf[j]].col
+		for i := range col {
+			v := 1.0
+			if basis != nil {
+				v = basis[i]
+			}
+			if m.sqrtw != nil {
+				v *= m.sqrtw[i]
+			}
+			col[i] = v
+		}
+		return true
+	}
+	jp := m.jprogs[j]
+	if jp == nil {
+		for i := range col {
+			col[i] = 0.0
+		}
+		return true
+	}
+	m.eval(jp, c, col)
+	for i, v := range col {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+		if m.sqrtw != nil {
+			col[i] = v * m.sqrtw[i]
+		}
+	}
+	return true
+}
+
+// forward difference
+func (m *fitModel) fdColumn(j int, c, r, col []float64) {
+	cj := c[j]
+	h := 1.4901161193847656e-08 * math.Max(math.Abs(cj), 1.0) // sqrt(eps)
+	c[j] = cj + h
+	m.residuals(c, m.tmp)
+	c[j] = cj
+	for i, v := range m.tmp {
+		col[i] = (v - r[i]) / h
+	}
+}
+
+func sumSq(r []float64) float64 {
+	s := 0.0
+	for _, v := range r {
+		s += v * v
+	}
+	return s
+}
+
+/* Levenberg-Marquardt
+ *
+ * Solves (J'J + mu*I) dc = -J'r by Cholesky, adjusting mu with
+ * Nielsen's gain ratio rule. Returns the best coefficients found,
+ * which are the guess when the model can't be evaluated there.
+ */
+func fitLevmar(m *fitModel, guess []float64, fp *FitParams) []float64 {
+	M, N := len(guess), m.numResid()
+	c := append([]float64(nil), guess...)
+	if M == 0 || N == 0 {
+		return c
+	}
+
+	r := make([]float64, N)
+	rNew := make([]float64, N)
+	m.residuals(c, r)
+	cost := sumSq(r)
+	if math.IsNaN(cost) || math.IsInf(cost, 0) {
+		return c
+	}
+
+	J := make([][]float64, M)
+	for j := range J {
+		J[j] = make([]float64, N)
+	}
+	A := make([]float64, M*M)
+	L := make([]float64, M*M)
+	g := make([]float64, M)
+	dc := make([]float64, M)
+	cNew := make([]float64, M)
+
+	mu, nu := -1.0, 2.0
+	fresh := true
+	for iter := 0; iter < fp.MaxIter; iter++ {
+		if fresh {
+			m.jacobian(c, r, J)
+			for a := 0; a < M; a++ {
+				g[a] = dot(J[a], r)
+				for b := 0; b <= a; b++ {
+					v := dot(J[a], J[b])
+					A[a*M+b], A[b*M+a] = v, v
+				}
+			}
+			gmax := 0.0
+			for _, v := range g {
+				gmax = math.Max(gmax, math.Abs(v))
+			}
+			if gmax <= fp.Tol*math.Max(cost, 1e-300) || math.IsNaN(gmax) {
+				break
+			}
+			if mu < 0.0 {
+				dmax := 0.0
+				for a := 0; a < M; a++ {
+					dmax = math.Max(dmax, A[a*M+a])
+				}
+				mu = 1e-3 * math.Max(dmax, 1e-300)
+			}
+			fresh = false
+		}
+
+		// solve (A + mu*I) dc = -g
+		copy(L, A)
+		for a := 0; a < M; a++ {
+			L[a*M+a] += mu
+		}
+		if !cholSolve(L, M, g, dc) {
+			mu *= nu
+			nu *= 2.0
+			continue
+		}
+
+		cnorm, dnorm := 0.0, 0.0
+		for a := range c {
+			cNew[a] = c[a] + dc[a]
+			cnorm += c[a] * c[a]
+			dnorm += dc[a] * dc[a]
+		}
+		if math.Sqrt(dnorm) <= fp.Tol*(math.Sqrt(cnorm)+fp.Tol) {
+			break
+		}
+
+		m.residuals(cNew, rNew)
+		costNew := sumSq(rNew)
+
+		// predicted reduction: dc'(mu*dc - g)
+		pred := 0.0
+		for a := range dc {
+			pred += dc[a] * (mu*dc[a] - g[a])
+		}
+		rho := -1.0
+		if !math.IsNaN(costNew) && !math.IsInf(costNew, 0) && pred > 0.0 {
+			rho = (cost - costNew) / pred
+		}
+
+		if rho > 0.0 {
+			done := cost-costNew <= fp.Tol*cost
+			c, cNew = cNew, c
+			r, rNew = rNew, r
+			cost = costNew
+			f := 2.0*rho - 1.0
+			mu *= math.Max(1.0/3.0, 1.0-f*f*f)
+			nu = 2.0
+			fresh = true
+			if done || cost == 0.0 {
+				break
+			}
+		} else {
+			mu *= nu
+			nu *= 2.0
+			if math.IsInf(mu, 0) {
+				break
+			}
+		}
+	}
+	return c
+}
+
+func dot(a, b []float64) float64 {
+	s := 0.0
+	for i, v := range a {
+		s += v * b[i]
+	}
+	return s
+}
+
+// solve L x = -g for symmetric positive definite L (overwritten by its factor)
+func cholSolve(L []float64, M int, g, x []float64) bool {
+	for j := 0; j < M; j++ {
+		d := L[j*M+j]
+		for k := 0; k < j; k++ {
+			d -= L[j*M+k] * L[j*M+k]
+		}
+		if d <= 0.0 || math.IsNaN(d) {
+			return false
+		}
+		d = math.Sqrt(d)
+		L[j*M+j] = d
+		for i := j + 1; i < M; i++ {
+			s := L[i*M+j]
+			for k := 0; k < j; k++ {
+				s -= L[i*M+k] * L[j*M+k]
+			}
+			L[i*M+j] = s / d
+		}
+	}
+	for i := 0; i < M; i++ {
+		s := -g[i]
+		for k := 0; k < i; k++ {
+			s -= L[i*M+k] * x[k]
+		}
+		x[i] = s / L[i*M+i]
+	}
+	for i := M - 1; i >= 0; i-- {
+		s := x[i]
+		for k := i + 1; k < M; k++ {
+			s -= L[k*M+i] * x[k]
+		}
+		x[i] = s / L[i*M+i]
+	}
+	return true
+}
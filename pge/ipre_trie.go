@@ -40,3 +40,15 @@ func (n *IpreNode) InsertSerial(s []int) (did_ins bool) {
 
 	return did_ins
 }
+
+// Lookup reports whether s has been inserted, without changing the trie.
+// Safe to call from several goroutines while nothing is inserting.
+func (n *IpreNode) Lookup(s []int) bool {
+	for _, v := range s {
+		n = n.next[v]
+		if n == nil {
+			return false
+		}
+	}
+	return true
+}
@@ -8,10 +8,10 @@ import (
 )
 
 func (PS *PgeSearch) GenInitExprMethod1() *probs.ReportQueue {
-	fmt.Printf("generating initial expressions\n")
+	Vprintf(VInfo, "generating initial expressions\n")
 
 	GP := PS.cnfg.treecfg
-	fmt.Printf("%v\n", GP)
+	Vprintf(VIter, "%v\n", GP)
 
 	eList := make([]expr.Expr, 0)
 
@@ -33,12 +33,12 @@ func (PS *PgeSearch) GenInitExprMethod1() *probs.ReportQueue {
 	exprs.SetSort(probs.PESORT_PARETO_TST_ERR)
 
 	for i, e := range eList {
-		fmt.Printf("%d:  %v\n", i, e)
+		Vprintf(VDebug, "%d:  %v\n", i, e)
 		serial := make([]int, 0, 64)
 		serial = e.Serial(serial)
 		PS.Trie.InsertSerial(serial)
 		// on train data
-		re := RegressExpr(e, PS.prob)
+		re := RegressExpr(e, PS.prob, &PS.cnfg.fit)
 		re.SetUnitID(i)
 		exprs.Push(re)
 	}
@@ -72,7 +72,7 @@ func (PS *PgeSearch) GenInitExprAddMethod1() []expr.Expr {
 		exprs = append(exprs, a)
 	}
 
-	fmt.Println("Initial Add:  ", exprs)
+	Vprintln(VDebug, "Initial Add:  ", exprs)
 	return exprs
 }
 
@@ -92,7 +92,7 @@ func (PS *PgeSearch) GenInitExprMulMethod1() []expr.Expr {
 		exprs = append(exprs, m)
 	}
 
-	fmt.Println("Initial Mul:  ", exprs)
+	Vprintln(VDebug, "Initial Mul:  ", exprs)
 	return exprs
 }
 
@@ -112,6 +112,6 @@ func (PS *PgeSearch) GenInitExprDivMethod1() []expr.Expr {
 		exprs = append(exprs, d)
 	}
 
-	fmt.Println("Initial Div:  ", exprs)
+	Vprintln(VDebug, "Initial Div:  ", exprs)
 	return exprs
 }
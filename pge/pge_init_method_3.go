@@ -1,18 +1,16 @@
 package pge
 
 import (
-	"fmt"
-
 	probs "github.com/verdverm/go-pge/problems"
 	expr "github.com/verdverm/go-symexpr"
 )
 
 // This is the FFXish style init function
 func (PS *PgeSearch) GenInitExprMethod3() *probs.ReportQueue {
-	fmt.Printf("generating initial expressions\n")
+	Vprintf(VInfo, "generating initial expressions\n")
 
 	GP := PS.cnfg.treecfg
-	fmt.Printf("%v\n", GP)
+	Vprintf(VIter, "%v\n", GP)
 
 	bases := make([]expr.Expr, 0)
 
@@ -77,12 +75,12 @@ func (PS *PgeSearch) GenInitExprMethod3() *probs.ReportQueue {
 	exprs.SetSort(probs.PESORT_PARETO_TST_ERR)
 
 	for i, e := range bases {
-		fmt.Printf("%d:  %v\n", i, e)
+		Vprintf(VDebug, "%d:  %v\n", i, e)
 		serial := make([]int, 0, 64)
 		serial = e.Serial(serial)
 		PS.Trie.InsertSerial(serial)
 		// on train data
-		re := RegressExpr(e, PS.prob)
+		re := RegressExpr(e, PS.prob, &PS.cnfg.fit)
 		re.SetUnitID(i)
 		exprs.Push(re)
 	}
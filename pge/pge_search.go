@@ -7,12 +7,16 @@ import (
 	"log"
 	"math"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	levmar "github.com/verdverm/go-levmar"
 	config "github.com/verdverm/go-pge/config"
 	probs "github.com/verdverm/go-pge/problems"
+	results "github.com/verdverm/go-pge/results"
 	expr "github.com/verdverm/go-symexpr"
 )
 
@@ -34,7 +38,12 @@ type pgeConfig struct {
 	initMethod string
 	growMethod string
 
-	evalrCount int
+	evalrCount    int
+	expandWorkers int
+
+	fit    FitParams
+	race   RaceParams
+	remote RemoteParams
 }
 
 func pgeConfigParser(field, value string, config interface{}) (err error) {
@@ -56,6 +65,12 @@ func pgeConfigParser(field, value string, config interface{}) (err error) {
 
 	case "EVALRCOUNT":
 		PC.evalrCount, err = strconv.Atoi(value)
+	case "EXPANDWORKERS":
+		PC.expandWorkers, err = strconv.Atoi(value)
+	case "INITMETHOD":
+		PC.initMethod = value
+	case "GROWMETHOD":
+		PC.growMethod = value
 
 	case "SORTTYPE":
 		switch strings.ToLower(value) {
@@ -72,14 +87,38 @@ func pgeConfigParser(field, value string, config interface{}) (err error) {
 		PC.zeroEpsilon, err = strconv.ParseFloat(value, 64)
 
 	default:
+		found, ferr := parseFitParams(field, value, &PC.fit)
+		if ferr != nil {
+			log.Printf("Error parsing %s: %v\n", field, ferr)
+			return ferr
+		}
+		if found {
+			return
+		}
+		found, ferr = parseRaceParams(field, value, &PC.race)
+		if ferr != nil {
+			log.Printf("Error parsing %s: %v\n", field, ferr)
+			return ferr
+		}
+		if found {
+			return
+		}
+		found, ferr = parseRemoteParams(field, value, &PC.remote)
+		if ferr != nil {
+			log.Printf("Error parsing %s: %v\n", field, ferr)
+			return ferr
+		}
+		if found {
+			return
+		}
+
 		// check augillary parsable structures [only TreeParams for now]
 		if PC.treecfg == nil {
 			PC.treecfg = new(probs.TreeParams)
 		}
-		found, ferr := probs.ParseTreeParams(field, value, PC.treecfg)
+		found, ferr = probs.ParseTreeParams(field, value, PC.treecfg)
 		if ferr != nil {
-			log.Fatalf("error parsing PGE - treecfg Config\n")
-			return ferr
+			return fmt.Errorf("error parsing PGE - treecfg Config %s: %v", field, ferr)
 		}
 		if !found {
 			log.Printf("PGE Config Not Implemented: %s, %s\n\n", field, value)
@@ -112,23 +151,23 @@ type PgeSearch struct {
 	logDir     string
 	mainLog    *log.Logger
 	mainLogBuf *bufio.Writer
-	eqnsLog    *log.Logger
-	eqnsLogBuf *bufio.Writer
 	errLog     *log.Logger
 	errLogBuf  *bufio.Writer
+	logFiles   []*os.File
 
-	fitnessLog    *log.Logger
-	fitnessLogBuf *bufio.Writer
-	ipreLog       *log.Logger
-	ipreLogBuf    *bufio.Writer
+	// results logs, see package results
+	run      *results.Run
+	eqnsOut  *results.Writer // reports
+	itersOut *results.Writer // iteration stats
 
 	// equations visited
 	Trie  *IpreNode
 	Queue *probs.ReportQueue
 
-	// eval channels
-	eval_in  chan expr.Expr
-	eval_out chan *probs.ExprReport
+	// eval channels, and the evaluators reading them
+	eval_in  chan evalJob
+	eval_out chan evalJob
+	evalWG   sync.WaitGroup
 
 	// genStuff
 	GenRoots   []expr.Expr
@@ -139,12 +178,27 @@ type PgeSearch struct {
 	// FFXish stuff
 	ffxBases []expr.Expr
 
+	// racing, nil when disabled
+	racer       *racer
+	raceCnt     int
+	raceDiscard int
+
+	// remote evaluators, empty when none are configured
+	remotes     []*remoteEvalr
+	fingerprint string // of the problem data
+	remoteStop  chan struct{}
+	remoteWG    sync.WaitGroup
+
 	// statistics
 	neqns    int
 	ipreCnt  int
 	maxSize  int
 	maxScore int
 	minError float64
+
+	// of the last step
+	stepEvals    int
+	stepDiscards int
 }
 
 func (PS *PgeSearch) GetMaxIter() int {
@@ -167,37 +221,53 @@ func (PS *PgeSearch) SetEvalrCount(cnt int) {
 }
 
 func (PS *PgeSearch) ParseConfig(filename string) {
-	fmt.Printf("Parsing PGE Config: %s\n", filename)
+	Vprintf(VInfo, "Parsing PGE Config: %s\n", filename)
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
 		log.Fatal(err)
 	}
-	err = config.ParseConfig(data, pgeConfigParser, &PS.cnfg)
+	err = PS.ParseConfigData(data)
 	if err != nil {
 		log.Fatal(err)
 	}
 }
 
-func (PS *PgeSearch) Init(done chan int, prob *probs.ExprProblem, logdir string, input interface{}) {
-	fmt.Printf("Init'n PGE\n")
+// ParseConfigData parses the contents of a PGE config file,
+// returning the error where ParseConfig would exit
+func (PS *PgeSearch) ParseConfigData(data []byte) error {
+	return config.ParseConfig(data, pgeConfigParser, &PS.cnfg)
+}
+
+func (PS *PgeSearch) Init(done chan int, prob *probs.ExprProblem, logdir string, input interface{}) error {
+	Vprintf(VInfo, "Init'n PGE\n")
 	// setup data
 
 	// open logs
-	PS.initLogs(logdir)
+	PS.prob = prob
+	if err := PS.initLogs(logdir); err != nil {
+		return err
+	}
 
 	// copy in common config options
-	PS.prob = prob
 	if PS.cnfg.treecfg == nil {
 		PS.cnfg.treecfg = PS.prob.TreeCfg.Clone()
 	}
 	srules := expr.DefaultRules()
 	srules.ConvertConsts = true
 	PS.cnfg.simprules = srules
+	PS.cnfg.fit.defaults()
+	if PS.cnfg.fit.TermCacheMB > 0 {
+		PS.cnfg.fit.terms = newTermCache(int64(PS.cnfg.fit.TermCacheMB) << 20)
+	}
+	if PS.cnfg.race.Enabled {
+		PS.cnfg.race.defaults()
+		PS.racer = newRacer(PS.prob, &PS.cnfg.fit, &PS.cnfg.race)
+	}
 
-	fmt.Println("Roots:   ", PS.cnfg.treecfg.RootsS)
-	fmt.Println("Nodes:   ", PS.cnfg.treecfg.NodesS)
-	fmt.Println("Leafs:   ", PS.cnfg.treecfg.LeafsS)
-	fmt.Println("NonTrig: ", PS.cnfg.treecfg.NonTrigS)
+	Vprintln(VIter, "Roots:   ", PS.cnfg.treecfg.RootsS)
+	Vprintln(VIter, "Nodes:   ", PS.cnfg.treecfg.NodesS)
+	Vprintln(VIter, "Leafs:   ", PS.cnfg.treecfg.LeafsS)
+	Vprintln(VIter, "NonTrig: ", PS.cnfg.treecfg.NonTrigS)
 
 	PS.GenRoots = make([]expr.Expr, len(PS.cnfg.treecfg.Roots))
 	for i := 0; i < len(PS.GenRoots); i++ {
@@ -219,7 +289,7 @@ func (PS *PgeSearch) Init(done chan int, prob *probs.ExprProblem, logdir string,
 			PS.GenLeafs = append(PS.GenLeafs, expr.NewTime())
 
 		case expr.VAR:
-			fmt.Println("Use Vars: ", PS.cnfg.treecfg.UsableVars)
+			Vprintln(VIter, "Use Vars: ", PS.cnfg.treecfg.UsableVars)
 			for _, i := range PS.cnfg.treecfg.UsableVars {
 				PS.GenLeafs = append(PS.GenLeafs, expr.NewVar(i))
 			}
@@ -238,10 +308,10 @@ func (PS *PgeSearch) Init(done chan int, prob *probs.ExprProblem, logdir string,
 	}
 	***/
 
-	fmt.Println("Roots:   ", PS.GenRoots)
-	fmt.Println("Nodes:   ", PS.GenNodes)
-	fmt.Println("Leafs:   ", PS.GenLeafs)
-	fmt.Println("NonTrig: ", PS.GenNonTrig)
+	Vprintln(VIter, "Roots:   ", PS.GenRoots)
+	Vprintln(VIter, "Nodes:   ", PS.GenNodes)
+	Vprintln(VIter, "Leafs:   ", PS.GenLeafs)
+	Vprintln(VIter, "NonTrig: ", PS.GenNonTrig)
 
 	// setup communication struct
 	PS.commup = input.(*probs.ExprProblemComm)
@@ -260,32 +330,76 @@ func (PS *PgeSearch) Init(done chan int, prob *probs.ExprProblem, logdir string,
 
 	PS.minError = math.Inf(1)
 
-	PS.eval_in = make(chan expr.Expr, 4048)
-	PS.eval_out = make(chan *probs.ExprReport, 4048)
+	PS.startEvaluators()
+	return nil
+}
 
-	for i := 0; i < PS.cnfg.evalrCount; i++ {
+/* Evaluator lifecycle
+ *
+ * startEvaluators opens the eval channels and starts evalrCount
+ * goroutines reading from eval_in, plus one for each remote worker.
+ * stopEvaluators closes eval_in, waits for every evaluator to return,
+ * then closes and drains eval_out and hangs up on the workers.
+ * Evaluators only finish by eval_in closing, they never look at PS.stop.
+ */
+func (PS *PgeSearch) startEvaluators() {
+	PS.eval_in = make(chan evalJob, 4048)
+	PS.eval_out = make(chan evalJob, 4048)
+
+	cnt := PS.cnfg.evalrCount
+	if cnt < 1 {
+		cnt = 1
+	}
+	for i := 0; i < cnt; i++ {
+		PS.evalWG.Add(1)
 		go PS.Evaluate()
 	}
+	PS.startRemotes()
+}
+
+func (PS *PgeSearch) stopEvaluators() {
+	if PS.eval_in == nil {
+		return
+	}
+	close(PS.eval_in)
+	PS.evalWG.Wait()
+	close(PS.eval_out)
+	for range PS.eval_out {
+		// results nobody asked for any more
+	}
+	PS.eval_in, PS.eval_out = nil, nil
+	PS.stopRemotes()
+}
+
+// the idx'th expression sent out in a step, and its report
+// (nil when discarded by racing)
+type evalJob struct {
+	idx int
+	e   expr.Expr
+	re  *probs.ExprReport
 }
 
 func (PS *PgeSearch) Evaluate() {
+	defer PS.evalWG.Done()
 
-	for !PS.stop {
-		e := <-PS.eval_in
-		if e == nil {
+	for job := range PS.eval_in {
+		if job.e == nil {
 			continue
 		}
-		PS.eval_out <- RegressExpr(e, PS.prob)
+		if PS.racer == nil || !PS.racer.hopeless(job.e) {
+			job.re = RegressExpr(job.e, PS.prob, &PS.cnfg.fit)
+		}
+		PS.eval_out <- job
 	}
 
 }
 
 func (PS *PgeSearch) Run() {
-	fmt.Printf("Running PGE\n")
+	Vprintf(VInfo, "Running PGE\n")
 
 	PS.loop()
 
-	fmt.Println("PGE exitting")
+	Vprintln(VInfo, "PGE exitting")
 
 	PS.Clean()
 	PS.commup.Cmds <- -1
@@ -296,15 +410,17 @@ func (PS *PgeSearch) loop() {
 	PS.checkMessages()
 	for !PS.stop {
 
-		fmt.Println("in: PS.step() ", PS.iter)
+		Vprintln(VIter, "in: PS.step() ", PS.iter)
+		start := time.Now()
 		PS.step()
 
 		// if PS.iter%PS.cnfg.pgeRptEpoch == 0 {
-		PS.reportExpr()
+		PS.reportExpr(false)
 		// }
 
 		// report current iteration
 		PS.commup.Gen <- [2]int{PS.id, PS.iter}
+		PS.sendStats(time.Since(start))
 		PS.iter++
 
 		PS.Clean()
@@ -335,15 +451,15 @@ func (PS *PgeSearch) loop() {
 		}
 		if e.TestError() < PS.minError {
 			PS.minError = e.TestError()
-			fmt.Printf("EXITING New Min Error:  %v\n", e)
+			Vprintf(VIter, "EXITING New Min Error:  %v\n", e)
 		}
 		if e.Size() > PS.maxSize {
 			PS.maxSize = e.Size()
 		}
 	}
 
-	fmt.Println("PGE sending last report")
-	PS.reportExpr()
+	Vprintln(VIter, "PGE sending last report")
+	PS.reportExpr(true)
 
 }
 
@@ -356,25 +472,19 @@ func (PS *PgeSearch) step() {
 
 	ex := PS.expandPeeled(es)
 
-	for cnt := range ex {
-		E := ex[cnt]
-
-		if E == nil {
-			continue
-		}
+	if PS.racer != nil {
+		PS.racer.setFront(PS.Best.GetQueue())
+	}
 
-		for _, e := range E {
-			if e == nil {
+	// memo insertion stays sequential and in order,
+	// so the first of any duplicates is the one kept
+	for _, E := range ex {
+		for _, c := range E {
+			if c.known {
+				PS.Trie.vst++
 				continue
 			}
-			if !PS.cnfg.treecfg.CheckExpr(e) {
-				continue
-			}
-
-			// check ipre_trie
-			serial := make([]int, 0, 64)
-			serial = e.Serial(serial)
-			ins := PS.Trie.InsertSerial(serial)
+			ins := PS.Trie.InsertSerial(c.serial)
 			if !ins {
 				continue
 			}
@@ -383,13 +493,26 @@ func (PS *PgeSearch) step() {
 			// re := RegressExpr(e, PS.prob)
 
 			// start channeled eval
-			PS.eval_in <- e
+			PS.eval_in <- evalJob{idx: eval_cnt, e: c.e}
 			eval_cnt++
 		}
 	}
+	// put the results back in the order they were sent
+	results := make([]*probs.ExprReport, eval_cnt)
 	for i := 0; i < eval_cnt; i++ {
-		re := <-PS.eval_out
-		// end channeled eval
+		job := <-PS.eval_out
+		results[job.idx] = job.re
+	}
+	// end channeled eval
+
+	discards := 0
+	for _, re := range results {
+
+		// discarded by racing
+		if re == nil {
+			discards++
+			continue
+		}
 
 		// check for NaN/Inf in re.error  and  if so, skip
 		if math.IsNaN(re.TestError()) || math.IsInf(re.TestError(), 0) {
@@ -428,9 +551,39 @@ func (PS *PgeSearch) step() {
 	}
 	// } // for sequential eval
 	PS.Queue.Sort()
+	PS.stepEvals, PS.stepDiscards = eval_cnt, discards
+
+	if PS.racer != nil {
+		PS.raceCnt += eval_cnt
+		PS.raceDiscard += discards
+		PS.mainLog.Printf("Race: %d  discarded %d / %d  total %d / %d\n",
+			PS.iter, discards, eval_cnt, PS.raceDiscard, PS.raceCnt)
+	}
+	if len(PS.remotes) > 0 {
+		// per worker  addr:up|down:remote/local fits
+		PS.mainLog.Printf("Remote: %d  %s\n", PS.iter, PS.remoteStats())
+	}
 
 }
 
+func (PS *PgeSearch) sendStats(elapsed time.Duration) {
+	if PS.commup.Stats == nil {
+		return
+	}
+	PS.commup.Stats <- &probs.IterStats{
+		ProcID:   PS.id,
+		Iter:     PS.iter,
+		Elapsed:  elapsed,
+		Evals:    PS.stepEvals,
+		Discards: PS.stepDiscards,
+		Queue:    PS.Queue.Len(),
+		Neqns:    PS.neqns,
+		MemoCnt:  PS.Trie.cnt,
+		MemoVst:  PS.Trie.vst,
+		MinError: PS.minError,
+	}
+}
+
 func (PS *PgeSearch) peel() []*probs.ExprReport {
 	es := make([]*probs.ExprReport, PS.cnfg.peelCnt)
 	for p := 0; p < PS.cnfg.peelCnt && PS.Queue.Len() > 0; p++ {
@@ -439,13 +592,13 @@ func (PS *PgeSearch) peel() []*probs.ExprReport {
 
 		bPush := true
 		if len(e.Coeff()) == 1 && math.Abs(e.Coeff()[0]) < PS.cnfg.zeroEpsilon {
-			fmt.Println("No Best Push")
+			Vprintln(VDebug, "No Best Push")
 			p--
 			continue
 		}
 
 		if bPush {
-			fmt.Printf("pop/push(%d,%d): %v\n", p, PS.Best.Len(), e.Expr())
+			Vprintf(VDebug, "pop/push(%d,%d): %v\n", p, PS.Best.Len(), e.Expr())
 			PS.Best.Push(e)
 		}
 
@@ -456,7 +609,7 @@ func (PS *PgeSearch) peel() []*probs.ExprReport {
 		}
 		if e.TestError() < PS.minError {
 			PS.minError = e.TestError()
-			fmt.Printf("Best New Min Error:  %v\n", e)
+			Vprintf(VIter, "Best New Min Error:  %v\n", e)
 		}
 		if e.Size() > PS.maxSize {
 			PS.maxSize = e.Size()
@@ -466,31 +619,74 @@ func (PS *PgeSearch) peel() []*probs.ExprReport {
 	return es
 }
 
-func (PS *PgeSearch) expandPeeled(es []*probs.ExprReport) [][]expr.Expr {
-	eqns := make([][]expr.Expr, PS.cnfg.peelCnt)
+// an expanded expression that passed CheckExpr, with its memo key
+type expandCand struct {
+	e      expr.Expr
+	serial []int
+	known  bool // already in the trie before this step
+}
+
+// expandPeeled expands, simplifies and filters the peeled expressions
+// on a pool of workers. Results are kept per peeled expression in
+// expansion order, so the outcome doesn't depend on the scheduling.
+func (PS *PgeSearch) expandPeeled(es []*probs.ExprReport) [][]expandCand {
+	eqns := make([][]expandCand, PS.cnfg.peelCnt)
+
+	workers := PS.cnfg.expandWorkers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	jobs := make(chan int, len(es))
 	for p := 0; p < PS.cnfg.peelCnt; p++ {
-		if es[p] == nil {
-			continue
+		if es[p] != nil {
+			jobs <- p
 		}
-		// fmt.Printf("expand(%d): %v\n", p, es[p].Expr())
-		if es[p].Expr().ExprType() != expr.ADD {
-			add := expr.NewAdd()
-			add.Insert(es[p].Expr())
-			add.CalcExprStats()
-			es[p].SetExpr(add)
-		}
-		eqns[p] = PS.Expand(es[p].Expr())
-		// fmt.Printf("Results:\n")
-		// for i, e := range eqns[p] {
-		// 	fmt.Printf("%d,%d:  %v\n", p, i, e)
-		// }
-		// fmt.Println()
 	}
-	fmt.Println("\n")
+	close(jobs)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range jobs {
+				eqns[p] = PS.expandOne(es[p])
+			}
+		}()
+	}
+	wg.Wait()
+
+	Vprintf(VDebug, "\n\n")
 	return eqns
 }
 
-func (PS *PgeSearch) reportExpr() {
+func (PS *PgeSearch) expandOne(es *probs.ExprReport) (cands []expandCand) {
+	// fmt.Printf("expand(%d): %v\n", p, es.Expr())
+	if es.Expr().ExprType() != expr.ADD {
+		add := expr.NewAdd()
+		add.Insert(es.Expr())
+		add.CalcExprStats()
+		es.SetExpr(add)
+	}
+	for _, e := range PS.Expand(es.Expr()) {
+		if e == nil {
+			continue
+		}
+		if !PS.cnfg.treecfg.CheckExpr(e) {
+			continue
+		}
+		serial := make([]int, 0, 64)
+		serial = e.Serial(serial)
+		// read only, the trie is not changed until all workers are done
+		known := PS.Trie.Lookup(serial)
+		cands = append(cands, expandCand{e, serial, known})
+	}
+	return cands
+}
+
+// reportExpr sends the best reports up and logs them, final marks
+// the report made after the search stopped
+func (PS *PgeSearch) reportExpr(final bool) {
 
 	cnt := PS.cnfg.pgeRptCount
 	PS.Best.Sort()
@@ -503,10 +699,12 @@ func (PS *PgeSearch) reportExpr() {
 	copy(rpt, PS.Best.GetQueue()[:cnt])
 
 	errSum, errCnt := 0.0, 0
-	PS.eqnsLog.Println("\n\nReport", PS.iter)
 	for i, r := range rpt {
-		PS.eqnsLog.Printf("\n%d:  %v\n", i, r)
 		if r != nil && r.Expr() != nil {
+			R := results.FromExprReport(r, PS.run, PS.iter, i)
+			R.Final = final
+			R.Code = ResultsCode(r.Expr())
+			PS.eqnsOut.WriteReport(R)
 			errSum += r.TestError()
 			errCnt++
 		}
@@ -514,67 +712,92 @@ func (PS *PgeSearch) reportExpr() {
 
 	PS.mainLog.Printf("Iter: %d  %f  %f\n", PS.iter, errSum/float64(errCnt), PS.minError)
 
-	PS.ipreLog.Println(PS.iter, PS.neqns, PS.Trie.cnt, PS.Trie.vst)
-	PS.fitnessLog.Println(PS.iter, PS.neqns, PS.Trie.cnt, PS.Trie.vst, errSum/float64(errCnt), PS.minError)
+	it := &results.Iter{
+		Iter:     PS.iter,
+		Time:     time.Now(),
+		Neqns:    PS.neqns,
+		MemoCnt:  PS.Trie.cnt,
+		MemoVst:  PS.Trie.vst,
+		AveError: results.Float(errSum / float64(errCnt)),
+		MinError: results.Float(PS.minError),
+		Evals:    PS.stepEvals,
+		Discards: PS.stepDiscards,
+	}
+	if PS.cnfg.fit.terms != nil {
+		it.TermCache = PS.cnfg.fit.terms.counts()
+	}
+	PS.itersOut.WriteIter(it)
 
 	PS.commup.Rpts <- &rpt
 
 }
 
+// Clean flushes the logs. Once the search has been told to stop,
+// it also shuts down the evaluators and closes the log files.
 func (PS *PgeSearch) Clean() {
 	// fmt.Printf("Cleaning PGE\n")
 
 	PS.errLogBuf.Flush()
 	PS.mainLogBuf.Flush()
-	PS.eqnsLogBuf.Flush()
-	PS.fitnessLogBuf.Flush()
-	PS.ipreLogBuf.Flush()
+	PS.eqnsOut.Flush()
+	PS.itersOut.Flush()
 
+	if PS.stop {
+		PS.stopEvaluators()
+		for _, f := range PS.logFiles {
+			f.Close()
+		}
+		PS.logFiles = nil
+		PS.eqnsOut.Close()
+		PS.itersOut.Close()
+	}
 }
 
-func (PS *PgeSearch) initLogs(logdir string) {
+// initLogs opens the search's logs, closing what it opened on error
+func (PS *PgeSearch) initLogs(logdir string) (err error) {
+	defer func() {
+		if err != nil {
+			for _, f := range PS.logFiles {
+				f.Close()
+			}
+			PS.logFiles = nil
+			if PS.eqnsOut != nil {
+				PS.eqnsOut.Close()
+			}
+		}
+	}()
+
 	// open logs
 	PS.logDir = logdir + "pge/"
 	os.Mkdir(PS.logDir, os.ModePerm)
 	tmpF0, err5 := os.Create(PS.logDir + "pge:err.log")
 	if err5 != nil {
-		log.Fatal("couldn't create errs log")
+		return fmt.Errorf("couldn't create errs log: %v", err5)
 	}
+	PS.logFiles = append(PS.logFiles, tmpF0)
 	PS.errLogBuf = bufio.NewWriter(tmpF0)
 	PS.errLogBuf.Flush()
 	PS.errLog = log.New(PS.errLogBuf, "", log.LstdFlags)
 
 	tmpF1, err1 := os.Create(PS.logDir + "pge:main.log")
 	if err1 != nil {
-		log.Fatal("couldn't create main log")
+		return fmt.Errorf("couldn't create main log: %v", err1)
 	}
+	PS.logFiles = append(PS.logFiles, tmpF1)
 	PS.mainLogBuf = bufio.NewWriter(tmpF1)
 	PS.mainLogBuf.Flush()
 	PS.mainLog = log.New(PS.mainLogBuf, "", log.LstdFlags)
 
-	tmpF2, err2 := os.Create(PS.logDir + "pge:eqns.log")
-	if err2 != nil {
-		log.Fatal("couldn't create eqns log")
-	}
-	PS.eqnsLogBuf = bufio.NewWriter(tmpF2)
-	PS.eqnsLogBuf.Flush()
-	PS.eqnsLog = log.New(PS.eqnsLogBuf, "", 0)
-
-	tmpF3, err3 := os.Create(PS.logDir + "pge:fitness.log")
-	if err3 != nil {
-		log.Fatal("couldn't create eqns log")
+	PS.run = results.NewRun(PS.prob, "pge", PS.id)
+	PS.eqnsOut, err = results.Create(PS.logDir+"pge:eqns.jsonl", PS.run)
+	if err != nil {
+		return fmt.Errorf("couldn't create eqns log: %v", err)
 	}
-	PS.fitnessLogBuf = bufio.NewWriter(tmpF3)
-	PS.fitnessLogBuf.Flush()
-	PS.fitnessLog = log.New(PS.fitnessLogBuf, "", log.Ltime|log.Lmicroseconds)
-
-	tmpF4, err4 := os.Create(PS.logDir + "pge:ipre.log")
-	if err4 != nil {
-		log.Fatal("couldn't create eqns log")
+	PS.itersOut, err = results.Create(PS.logDir+"pge:iters.jsonl", PS.run)
+	if err != nil {
+		return fmt.Errorf("couldn't create iters log: %v", err)
 	}
-	PS.ipreLogBuf = bufio.NewWriter(tmpF4)
-	PS.ipreLogBuf.Flush()
-	PS.ipreLog = log.New(PS.ipreLogBuf, "", log.Ltime|log.Lmicroseconds)
+	return nil
 }
 
 func (PS *PgeSearch) checkMessages() {
@@ -584,7 +807,7 @@ func (PS *PgeSearch) checkMessages() {
 	case cmd, ok := <-PS.commup.Cmds:
 		if ok {
 			if cmd == -1 {
-				fmt.Println("PGE: stop sig recv'd")
+				Vprintln(VInfo, "PGE: stop sig recv'd")
 				PS.stop = true
 				return
 			}
@@ -596,18 +819,49 @@ func (PS *PgeSearch) checkMessages() {
 
 var c_input, c_ygiven []levmar.C_double
 
-func RegressExpr(E expr.Expr, P *probs.ExprProblem) (R *probs.ExprReport) {
-
+func RegressExpr(E expr.Expr, P *probs.ExprProblem, FP *FitParams) (R *probs.ExprReport) {
 	guess := make([]float64, 0)
 	guess, eqn := E.ConvertToConstants(guess)
 
+	res := regressEqn(eqn, guess, P, FP)
+	return res.report(eqn)
+}
+
+// the fitted coefficients and scores of an expression,
+// all a remote evaluator needs to send back
+type RegressResult struct {
+	Coeff      []float64
+	TrainScore int
+	TrainError float64
+	PredScore  int
+	TestScore  int
+	TestError  float64
+	PredError  float64
+}
+
+// regressEqn fits and scores eqn, the output of ConvertToConstants
+func regressEqn(eqn expr.Expr, guess []float64, P *probs.ExprProblem, FP *FitParams) (res RegressResult) {
+	if FP == nil {
+		FP = new(FitParams)
+		FP.defaults()
+	}
+
+	// compiled once, used by the fitter and for scoring
+	prog, _ := Compile(eqn)
+
 	var coeff []float64
 	if len(guess) > 0 {
 
 		// fmt.Printf("x_dims:  %d  %d\n", x_dim, x_dim2)
 
-		// Callback version
-		coeff = levmar.LevmarExpr(eqn, P.SearchVar, P.SearchType, guess, P.Train, P.Test)
+		fm := newFitModel(prog, P, P.Train)
+		if FP.terms != nil {
+			fm.useTerms(eqn, len(guess), FP.terms)
+		}
+		if FP.Jacobian == "analytic" {
+			fm.useAnalytic(eqn, len(guess))
+		}
+		coeff = fitLevmar(fm, guess, FP)
 
 		// Stack version
 		// x_dim := P.Train[0].NumDim()
@@ -638,37 +892,54 @@ func RegressExpr(E expr.Expr, P *probs.ExprProblem) (R *probs.ExprReport) {
 		// fmt.Printf("%v\n%v\n%v\n\n", eqn, coeff, steff)
 	}
 
+	res.Coeff = coeff
+
+	// hitsL1, hitsL2, evalCnt, nanCnt, infCnt, l1_err, l2_err := scoreExpr(E, P, coeff)
+	_, _, _, res.TrainScore, _, res.TrainError, _ = scoreExpr(prog, P, P.Train, coeff)
+	_, _, res.TestScore, res.PredScore, _, res.TestError, res.PredError = scoreExpr(prog, P, P.Test, coeff)
+
+	return res
+}
+
+func (res *RegressResult) report(eqn expr.Expr) (R *probs.ExprReport) {
 	R = new(probs.ExprReport)
 	R.SetExpr(eqn) /*.ConvertToConstantFs(coeff)*/
-	R.SetCoeff(coeff)
+	R.SetCoeff(res.Coeff)
 	R.Expr().CalcExprStats()
 
-	// hitsL1, hitsL2, evalCnt, nanCnt, infCnt, l1_err, l2_err := scoreExpr(E, P, coeff)
-	_, _, _, trnNanCnt, _, trn_l1_err, _ := scoreExpr(E, P, P.Train, coeff)
-	_, _, tstEvalCnt, tstNanCnt, _, tst_l1_err, tst_l2_err := scoreExpr(E, P, P.Test, coeff)
-
-	R.SetTrainScore(trnNanCnt)
-	R.SetTrainError(trn_l1_err)
+	R.SetTrainScore(res.TrainScore)
+	R.SetTrainError(res.TrainError)
 
-	R.SetPredScore(tstNanCnt)
-	R.SetTestScore(tstEvalCnt)
-	R.SetTestError(tst_l1_err)
-	R.SetPredError(tst_l2_err)
+	R.SetPredScore(res.PredScore)
+	R.SetTestScore(res.TestScore)
+	R.SetTestError(res.TestError)
+	R.SetPredError(res.PredError)
 
 	return R
 }
 
-func scoreExpr(e expr.Expr, P *probs.ExprProblem, dataSets []*probs.PointSet, coeff []float64) (hitsL1, hitsL2, evalCnt, nanCnt, infCnt int, l1_err, l2_err float64) {
-	var l1_sum, l2_sum float64
+func scoreExpr(prog *Program, P *probs.ExprProblem, dataSets []*probs.PointSet, coeff []float64) (hitsL1, hitsL2, evalCnt, nanCnt, infCnt int, l1_err, l2_err float64) {
+	var l1_sum, l2_sum, w_sum float64
+	weighted := false
+	var outs []float64
 	for _, PS := range dataSets {
-		for _, p := range PS.Points() {
-			y := p.Depnd(P.SearchVar)
-			var out float64
-			if P.SearchType == probs.ExprBenchmark {
-				out = e.Eval(0, p.Indeps(), coeff, PS.SysVals())
-			} else if P.SearchType == probs.ExprDiffeq {
-				out = e.Eval(p.Indep(0), p.Indeps()[1:], coeff, PS.SysVals())
+		ys := PS.DepndCol(P.SearchVar)
+		ws := PS.Weights()
+		if ws != nil {
+			weighted = true
+		}
+		if cap(outs) < len(ys) {
+			outs = make([]float64, len(ys))
+		}
+		outs = outs[:len(ys)]
+		evalSet(prog, P, PS, coeff, outs)
+
+		for p, y := range ys {
+			w := 1.0
+			if ws != nil {
+				w = ws[p]
 			}
+			out := outs[p]
 
 			if math.IsNaN(out) {
 				nanCnt++
@@ -683,8 +954,9 @@ func scoreExpr(e expr.Expr, P *probs.ExprProblem, dataSets []*probs.PointSet, co
 			diff := out - y
 			l1_val := math.Abs(diff)
 			l2_val := diff * diff
-			l1_sum += l1_val
-			l2_sum += l2_val
+			l1_sum += w * l1_val
+			l2_sum += w * l2_val
+			w_sum += w
 
 			if l1_val < P.HitRatio {
 				hitsL1++
@@ -700,6 +972,9 @@ func scoreExpr(e expr.Expr, P *probs.ExprProblem, dataSets []*probs.PointSet, co
 		l2_err = math.NaN()
 	} else {
 		fEvalCnt := float64(evalCnt + 1)
+		if weighted {
+			fEvalCnt = w_sum
+		}
 		l1_err = l1_sum / fEvalCnt
 		l2_err = math.Sqrt(l2_sum / fEvalCnt)
 	}
@@ -0,0 +1,90 @@
+package pge
+
+import (
+	"bytes"
+	"io/ioutil"
+	"runtime"
+	"runtime/pprof"
+	"testing"
+	"time"
+
+	probs "github.com/verdverm/go-pge/problems"
+)
+
+// runSearch runs a PgeSearch on P for iters steps, driving it the way
+// MainSearch does, and returns once it has cleaned up
+func runSearch(t *testing.T, P *probs.ExprProblem, iters int) {
+	cfg, err := ioutil.ReadFile("../config/pge/pge_default.cfg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	PS := new(PgeSearch)
+	if err = PS.ParseConfigData(cfg); err != nil {
+		t.Fatal(err)
+	}
+	PS.SetInitMethod("method1")
+	PS.SetGrowMethod("method1")
+	PS.SetEvalrCount(4)
+	PS.cnfg.fit.TermCacheMB = -1
+
+	comm := &probs.ExprProblemComm{
+		Cmds: make(chan int),
+		Rpts: make(chan *probs.ExprReportArray, 64),
+		Gen:  make(chan [2]int, 64),
+	}
+	if err = PS.Init(make(chan int), P, t.TempDir()+"/", comm); err != nil {
+		t.Fatal(err)
+	}
+	go PS.Run()
+
+	// stop after iters steps, then wait for the search to say it's done
+	timeout := time.After(time.Minute)
+	for gen := 0; gen < iters; {
+		select {
+		case g := <-comm.Gen:
+			gen = g[1] + 1
+		case <-comm.Rpts:
+		case <-timeout:
+			t.Fatalf("no step %d within a minute", iters)
+		}
+	}
+	stopped := make(chan struct{})
+	go func() {
+		comm.Cmds <- -1
+		<-comm.Cmds
+		close(stopped)
+	}()
+	for {
+		select {
+		case <-comm.Gen:
+		case <-comm.Rpts:
+		case <-stopped:
+			return
+		case <-timeout:
+			t.Fatal("search didn't stop within a minute")
+		}
+	}
+}
+
+// searches run one after another, as the job server does, must not
+// leave evaluators or anything else running behind them
+func TestSearchNoLeaks(t *testing.T) {
+	defer SetOutput(SetOutput(ioutil.Discard))
+	P := loadDiffeqProblem(t)
+
+	base := runtime.NumGoroutine()
+	for i := 0; i < 3; i++ {
+		runSearch(t, P, 2)
+
+		// goroutines that are done may take a moment to be gone
+		n := runtime.NumGoroutine()
+		for end := time.Now().Add(2 * time.Second); n > base && time.Now().Before(end); n = runtime.NumGoroutine() {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if n > base {
+			var dump bytes.Buffer
+			pprof.Lookup("goroutine").WriteTo(&dump, 1)
+			t.Fatalf("after search %d: %d goroutines, %d before any search\n%s", i, n, base, dump.String())
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package pge
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	probs "github.com/verdverm/go-pge/problems"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+/* Racing
+ *
+ * Before the full fit, a candidate is fit on a small random subsample
+ * of the training data and scored on a subsample of the test data.
+ * If some member of the current Best front is no larger and has an
+ * error Margin times smaller, the candidate is discarded without
+ * ever touching the full data.
+ */
+type RaceParams struct {
+	Enabled   bool
+	Frac      float64 // fraction of each data set in the subsample
+	MinPoints int     // but at least this many points per set
+	Margin    float64 // how much worse than a front member counts as dominated
+	Seed      int64
+}
+
+func (rp *RaceParams) defaults() {
+	if rp.Frac <= 0.0 {
+		rp.Frac = 0.1
+	}
+	if rp.MinPoints <= 0 {
+		rp.MinPoints = 50
+	}
+	if rp.Margin <= 0.0 {
+		rp.Margin = 2.0
+	}
+}
+
+func parseRaceParams(field, value string, rp *RaceParams) (found bool, err error) {
+	found = true
+	switch strings.ToUpper(field) {
+	case "RACE":
+		rp.Enabled, err = strconv.ParseBool(value)
+	case "RACEFRAC":
+		rp.Frac, err = strconv.ParseFloat(value, 64)
+	case "RACEMINPOINTS":
+		rp.MinPoints, err = strconv.Atoi(value)
+	case "RACEMARGIN":
+		rp.Margin, err = strconv.ParseFloat(value, 64)
+	case "RACESEED":
+		rp.Seed, err = strconv.ParseInt(value, 10, 64)
+	default:
+		found = false
+	}
+	return
+}
+
+// a point on the Best front
+type raceMember struct {
+	size int
+	err  float64
+}
+
+type racer struct {
+	prob  *probs.ExprProblem // the problem on the subsamples
+	fit   FitParams
+	param RaceParams
+
+	// set by the search before each round of evaluations
+	front []raceMember
+}
+
+func newRacer(P *probs.ExprProblem, FP *FitParams, RP *RaceParams) *racer {
+	R := &racer{param: *RP}
+	rng := rand.New(rand.NewSource(RP.Seed))
+	sample := func(sets []*probs.PointSet) []*probs.PointSet {
+		out := make([]*probs.PointSet, len(sets))
+		for i, PS := range sets {
+			n := int(math.Ceil(RP.Frac * float64(PS.NumPoints())))
+			if n < RP.MinPoints {
+				n = RP.MinPoints
+			}
+			out[i] = PS.Subsample(n, rng)
+		}
+		return out
+	}
+	sub := *P
+	sub.Train = sample(P.Train)
+	sub.Test = sample(P.Test)
+	R.prob = &sub
+
+	// no term cache, it holds full length columns
+	R.fit = *FP
+	R.fit.terms = nil
+	return R
+}
+
+// setFront keeps the Pareto front (size vs. test error) of the reports
+func (R *racer) setFront(rpts []*probs.ExprReport) {
+	R.front = R.front[:0]
+	for _, r := range rpts {
+		if r == nil || r.Expr() == nil {
+			continue
+		}
+		m := raceMember{r.Size(), r.TestError()}
+		if math.IsNaN(m.err) || math.IsInf(m.err, 0) {
+			continue
+		}
+		dominated := false
+		for _, f := range R.front {
+			if f.size <= m.size && f.err <= m.err {
+				dominated = true
+				break
+			}
+		}
+		if dominated {
+			continue
+		}
+		keep := R.front[:0]
+		for _, f := range R.front {
+			if !(m.size <= f.size && m.err <= f.err) {
+				keep = append(keep, f)
+			}
+		}
+		R.front = append(keep, m)
+	}
+}
+
+// hopeless fits E on the subsample and reports whether it is
+// confidently dominated by the front
+func (R *racer) hopeless(E expr.Expr) bool {
+	if len(R.front) == 0 {
+		return false
+	}
+	guess := make([]float64, 0)
+	guess, eqn := E.Clone().ConvertToConstants(guess)
+	prog, _ := Compile(eqn)
+
+	P := R.prob
+	coeff := guess
+	if len(guess) > 0 {
+		fm := newFitModel(prog, P, P.Train)
+		if R.fit.Jacobian == "analytic" {
+			fm.useAnalytic(eqn, len(guess))
+		}
+		coeff = fitLevmar(fm, guess, &R.fit)
+	}
+	_, _, _, _, _, l1_err, _ := scoreExpr(prog, P, P.Test, coeff)
+	if math.IsNaN(l1_err) || math.IsInf(l1_err, 0) {
+		// the full evaluation would be thrown out as well
+		return true
+	}
+
+	eqn.CalcExprStats()
+	size := eqn.Size()
+	for _, f := range R.front {
+		if f.size <= size && l1_err > R.param.Margin*f.err {
+			return true
+		}
+	}
+	return false
+}
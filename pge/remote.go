@@ -0,0 +1,353 @@
+package pge
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	probs "github.com/verdverm/go-pge/problems"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+/* Remote evaluation
+ *
+ * An evaluator process (pge -worker host:port) loads the same problem
+ * data as the search and serves EvalWorker over net/rpc. For every
+ * address in RemoteWorkers the search runs one more evaluator goroutine,
+ * which reads eval_in like the local ones but ships each expression,
+ * already numbered by ConvertToConstants, to its worker and builds the
+ * report from the coefficients and scores sent back.
+ *
+ * Each call carries the fingerprint of the problem data, and a worker
+ * refuses calls for data other than its own. A heartbeat pings every
+ * worker and redials the ones that went away. A call that fails or
+ * times out is retried on a fresh connection up to RemoteRetries times,
+ * and then, or whenever the worker is down, the expression is fit
+ * locally. A search never waits on a dead worker for longer than one
+ * RemoteTimeout.
+ */
+type RemoteParams struct {
+	Workers   []string // host:port of the evaluator processes
+	Heartbeat time.Duration
+	Timeout   time.Duration // for a single fit
+	Retries   int
+}
+
+func (rp *RemoteParams) defaults() {
+	if rp.Heartbeat <= 0 {
+		rp.Heartbeat = 2 * time.Second
+	}
+	if rp.Timeout <= 0 {
+		rp.Timeout = 60 * time.Second
+	}
+	if rp.Retries < 0 {
+		rp.Retries = 0
+	}
+}
+
+func parseRemoteParams(field, value string, rp *RemoteParams) (found bool, err error) {
+	found = true
+	switch strings.ToUpper(field) {
+	case "REMOTEWORKERS":
+		rp.Workers = strings.Fields(strings.Replace(value, ",", " ", -1))
+	case "REMOTEHEARTBEAT":
+		rp.Heartbeat, err = time.ParseDuration(value)
+	case "REMOTETIMEOUT":
+		rp.Timeout, err = time.ParseDuration(value)
+	case "REMOTERETRIES":
+		rp.Retries, err = strconv.Atoi(value)
+	default:
+		found = false
+	}
+	return
+}
+
+// arguments to EvalWorker.Regress
+type RegressArgs struct {
+	Fingerprint string
+	Fit         FitParams
+	Code        ExprCode  // the expression after ConvertToConstants
+	Guess       []float64 // and its initial coefficients
+}
+
+var errWrongData = errors.New("worker has different problem data")
+
+// EvalWorker is the net/rpc service run by an evaluator process
+type EvalWorker struct {
+	prob        *probs.ExprProblem
+	fingerprint string
+
+	termsOnce sync.Once
+	terms     *termCache
+}
+
+func NewEvalWorker(P *probs.ExprProblem) *EvalWorker {
+	return &EvalWorker{prob: P, fingerprint: P.Fingerprint()}
+}
+
+// Ping replies with the fingerprint of the worker's data
+func (W *EvalWorker) Ping(args int, reply *string) error {
+	*reply = W.fingerprint
+	return nil
+}
+
+func (W *EvalWorker) Regress(args *RegressArgs, res *RegressResult) error {
+	if args.Fingerprint != W.fingerprint {
+		return errWrongData
+	}
+	eqn, err := DecodeExpr(args.Code)
+	if err != nil {
+		return err
+	}
+
+	// the cache is sized by the first search to call in
+	W.termsOnce.Do(func() {
+		if args.Fit.TermCacheMB > 0 {
+			W.terms = newTermCache(int64(args.Fit.TermCacheMB) << 20)
+		}
+	})
+	FP := args.Fit
+	FP.terms = W.terms
+
+	*res = regressEqn(eqn, args.Guess, W.prob, &FP)
+	return nil
+}
+
+// ServeWorker serves an EvalWorker for P on l until l is closed
+func ServeWorker(l net.Listener, P *probs.ExprProblem) error {
+	srv := rpc.NewServer()
+	if err := srv.Register(NewEvalWorker(P)); err != nil {
+		return err
+	}
+	srv.Accept(l)
+	return nil
+}
+
+// the search's end of one worker
+type remoteEvalr struct {
+	addr string
+
+	mu     sync.Mutex  // guards client, never held over the network
+	client *rpc.Client // nil while the worker is down
+
+	remote, local int64 // fits done on each side, atomic
+}
+
+func (rw *remoteEvalr) conn() *rpc.Client {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.client
+}
+
+// drop closes c, unless it was already replaced
+func (rw *remoteEvalr) drop(c *rpc.Client, err error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if rw.client != c || c == nil {
+		return
+	}
+	Vprintf(VQuiet, "Remote %s lost: %v\n", rw.addr, err)
+	c.Close()
+	rw.client = nil
+}
+
+// dial connects and checks the worker has our data
+func (rw *remoteEvalr) dial(fingerprint string, timeout time.Duration) (*rpc.Client, error) {
+	nc, err := net.DialTimeout("tcp", rw.addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	c := rpc.NewClient(nc)
+	var fp string
+	if err = call(c, "EvalWorker.Ping", 0, &fp, timeout); err != nil {
+		c.Close()
+		return nil, err
+	}
+	if fp != fingerprint {
+		c.Close()
+		return nil, errWrongData
+	}
+	return c, nil
+}
+
+// reconnect replaces the connection if the worker is down or c is
+// the stale connection that just failed
+func (rw *remoteEvalr) reconnect(stale *rpc.Client, fingerprint string, timeout time.Duration) *rpc.Client {
+	if c := rw.conn(); c != nil && c != stale {
+		return c
+	}
+	// dial without the lock, so nobody waits on a dead address
+	c, err := rw.dial(fingerprint, timeout)
+	if err != nil {
+		return nil
+	}
+
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if rw.client != nil && rw.client != stale {
+		// someone else got there first
+		c.Close()
+		return rw.client
+	}
+	if rw.client != nil {
+		rw.client.Close()
+	} else {
+		Vprintf(VInfo, "Remote %s connected\n", rw.addr)
+	}
+	rw.client = c
+	return c
+}
+
+func (rw *remoteEvalr) close() {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if rw.client != nil {
+		rw.client.Close()
+		rw.client = nil
+	}
+}
+
+var errTimeout = errors.New("timed out")
+
+// call is rpc.Call with a time limit
+func call(c *rpc.Client, method string, args, reply interface{}, timeout time.Duration) error {
+	cl := c.Go(method, args, reply, make(chan *rpc.Call, 1))
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case <-cl.Done:
+		return cl.Error
+	case <-t.C:
+		return errTimeout
+	}
+}
+
+// startRemotes connects to the workers and starts their evaluators
+// and the heartbeat, both stopped by stopEvaluators
+func (PS *PgeSearch) startRemotes() {
+	RP := &PS.cnfg.remote
+	if len(RP.Workers) == 0 {
+		return
+	}
+	RP.defaults()
+	PS.fingerprint = PS.prob.Fingerprint()
+	PS.remoteStop = make(chan struct{})
+
+	for _, addr := range RP.Workers {
+		rw := &remoteEvalr{addr: addr}
+		if rw.reconnect(nil, PS.fingerprint, RP.Timeout) == nil {
+			Vprintf(VQuiet, "Remote %s not available, fitting its share locally\n", addr)
+		}
+		PS.remotes = append(PS.remotes, rw)
+
+		PS.evalWG.Add(1)
+		go PS.remoteEvaluate(rw)
+	}
+
+	PS.remoteWG.Add(1)
+	go PS.heartbeat()
+}
+
+func (PS *PgeSearch) stopRemotes() {
+	if PS.remoteStop == nil {
+		return
+	}
+	close(PS.remoteStop)
+	PS.remoteWG.Wait()
+	for _, rw := range PS.remotes {
+		rw.close()
+	}
+	PS.remoteStop = nil
+}
+
+func (PS *PgeSearch) heartbeat() {
+	defer PS.remoteWG.Done()
+	RP := &PS.cnfg.remote
+
+	tick := time.NewTicker(RP.Heartbeat)
+	defer tick.Stop()
+	for {
+		select {
+		case <-PS.remoteStop:
+			return
+		case <-tick.C:
+		}
+		for _, rw := range PS.remotes {
+			c := rw.conn()
+			if c == nil {
+				rw.reconnect(nil, PS.fingerprint, RP.Heartbeat)
+				continue
+			}
+			var fp string
+			if err := call(c, "EvalWorker.Ping", 0, &fp, RP.Heartbeat); err != nil {
+				rw.drop(c, err)
+			}
+		}
+	}
+}
+
+// remoteEvaluate is Evaluate, with the fitting done by rw
+func (PS *PgeSearch) remoteEvaluate(rw *remoteEvalr) {
+	defer PS.evalWG.Done()
+
+	for job := range PS.eval_in {
+		if job.e == nil {
+			continue
+		}
+		if PS.racer == nil || !PS.racer.hopeless(job.e) {
+			job.re = PS.regressRemote(rw, job.e)
+		}
+		PS.eval_out <- job
+	}
+}
+
+func (PS *PgeSearch) regressRemote(rw *remoteEvalr, E expr.Expr) *probs.ExprReport {
+	RP := &PS.cnfg.remote
+
+	guess := make([]float64, 0)
+	guess, eqn := E.ConvertToConstants(guess)
+
+	code, err := EncodeExpr(eqn)
+	if err == nil {
+		args := &RegressArgs{PS.fingerprint, PS.cnfg.fit, code, guess}
+		c := rw.conn()
+		for try := 0; c != nil && try <= RP.Retries; try++ {
+			var res RegressResult
+			err = call(c, "EvalWorker.Regress", args, &res, RP.Timeout)
+			if err == nil {
+				atomic.AddInt64(&rw.remote, 1)
+				return res.report(eqn)
+			}
+			if _, ok := err.(rpc.ServerError); ok {
+				// the worker got it and said no, asking again won't help
+				break
+			}
+			rw.drop(c, err)
+			c = rw.reconnect(c, PS.fingerprint, RP.Timeout)
+		}
+	}
+
+	atomic.AddInt64(&rw.local, 1)
+	res := regressEqn(eqn, guess, PS.prob, &PS.cnfg.fit)
+	return res.report(eqn)
+}
+
+// remoteStats is addr:remote/local for each worker, for the main log
+func (PS *PgeSearch) remoteStats() string {
+	strs := make([]string, len(PS.remotes))
+	for i, rw := range PS.remotes {
+		up := "up"
+		if rw.conn() == nil {
+			up = "down"
+		}
+		strs[i] = fmt.Sprintf("%s:%s:%d/%d", rw.addr, up,
+			atomic.LoadInt64(&rw.remote), atomic.LoadInt64(&rw.local))
+	}
+	return strings.Join(strs, " ")
+}
@@ -0,0 +1,155 @@
+package pge
+
+import (
+	"math"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// connListener remembers the connections it accepted, so a test can
+// cut them as if the worker went away
+type connListener struct {
+	net.Listener
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err == nil {
+		l.mu.Lock()
+		l.conns = append(l.conns, c)
+		l.mu.Unlock()
+	}
+	return c, err
+}
+
+func (l *connListener) cut() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, c := range l.conns {
+		c.Close()
+	}
+	l.conns = nil
+}
+
+// startTestWorker serves the diffeq test problem on a loopback port
+// and gives a search set up to use it, without evaluators running
+func startTestWorker(t *testing.T) (*PgeSearch, *connListener) {
+	P := loadDiffeqProblem(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := &connListener{Listener: l}
+	go ServeWorker(cl, P)
+	t.Cleanup(func() {
+		cl.Close()
+		cl.cut()
+	})
+
+	PS := &PgeSearch{prob: P}
+	PS.cnfg.fit.defaults()
+	PS.cnfg.fit.TermCacheMB = -1
+	PS.cnfg.remote = RemoteParams{
+		Workers:   []string{l.Addr().String()},
+		Heartbeat: 10 * time.Millisecond,
+		Timeout:   time.Second,
+		Retries:   1,
+	}
+	PS.fingerprint = P.Fingerprint()
+	return PS, cl
+}
+
+// waitFor polls cond for up to a second
+func waitFor(cond func() bool) bool {
+	for end := time.Now().Add(time.Second); time.Now().Before(end); time.Sleep(5 * time.Millisecond) {
+		if cond() {
+			return true
+		}
+	}
+	return cond()
+}
+
+func checkDampedFit(t *testing.T, coeff []float64) {
+	t.Helper()
+	if len(coeff) != 2 || math.Abs(coeff[0]+1) > 1e-6 || math.Abs(coeff[1]+1) > 1e-6 {
+		t.Errorf("coefficients %v, want [-1 -1]", coeff)
+	}
+}
+
+func TestRemoteHeartbeat(t *testing.T) {
+	PS, cl := startTestWorker(t)
+	RP := &PS.cnfg.remote
+
+	// down at the start, the heartbeat connects
+	rw := &remoteEvalr{addr: RP.Workers[0]}
+	PS.remotes = []*remoteEvalr{rw}
+	PS.remoteStop = make(chan struct{})
+	PS.remoteWG.Add(1)
+	go PS.heartbeat()
+	defer PS.stopRemotes()
+
+	if !waitFor(func() bool { return rw.conn() != nil }) {
+		t.Fatal("heartbeat never connected")
+	}
+	first := rw.conn()
+
+	// lost, the heartbeat notices and redials
+	cl.cut()
+	if !waitFor(func() bool { c := rw.conn(); return c != nil && c != first }) {
+		t.Fatal("heartbeat never reconnected")
+	}
+}
+
+func TestRemoteRetry(t *testing.T) {
+	PS, cl := startTestWorker(t)
+	rw := &remoteEvalr{addr: PS.cnfg.remote.Workers[0]}
+	if rw.reconnect(nil, PS.fingerprint, time.Second) == nil {
+		t.Fatal("couldn't connect")
+	}
+	defer rw.close()
+
+	// the connection dies under the first call, the retry redials
+	cl.cut()
+	R := PS.regressRemote(rw, dampedRHS(true))
+	checkDampedFit(t, R.Coeff())
+	if r, l := atomic.LoadInt64(&rw.remote), atomic.LoadInt64(&rw.local); r != 1 || l != 0 {
+		t.Errorf("%d remote and %d local fits, want 1 and 0", r, l)
+	}
+}
+
+func TestRemoteFallback(t *testing.T) {
+	PS, cl := startTestWorker(t)
+	rw := &remoteEvalr{addr: PS.cnfg.remote.Workers[0]}
+	if rw.reconnect(nil, PS.fingerprint, time.Second) == nil {
+		t.Fatal("couldn't connect")
+	}
+	defer rw.close()
+
+	// the worker is gone for good, the fit is done here
+	cl.Close()
+	cl.cut()
+	R := PS.regressRemote(rw, dampedRHS(true))
+	checkDampedFit(t, R.Coeff())
+	if r, l := atomic.LoadInt64(&rw.remote), atomic.LoadInt64(&rw.local); r != 0 || l != 1 {
+		t.Errorf("%d remote and %d local fits, want 0 and 1", r, l)
+	}
+
+	// and so is a worker with other data
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	P := loadDiffeqProblem(t)
+	P.Train = P.Train[:1]
+	go ServeWorker(l, P)
+	other := &remoteEvalr{addr: l.Addr().String()}
+	if other.reconnect(nil, PS.fingerprint, time.Second) != nil {
+		t.Error("connected to a worker with different data")
+	}
+}
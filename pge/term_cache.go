@@ -0,0 +1,211 @@
+package pge
+
+import (
+	"container/list"
+	"encoding/binary"
+	"sync"
+
+	results "github.com/verdverm/go-pge/results"
+	expr "github.com/verdverm/go-symexpr"
+)
+
+/* Term column cache
+ *
+ * Candidates are mostly a top-level Add of c_k * basis terms, and a child
+ * shares all but one or two of its terms with its parent. The evaluated
+ * basis columns over the training data are kept here, keyed by the basis
+ * serial, so a child only evaluates the terms that are new to it.
+ * Columns are read-only once stored. Least recently used columns are
+ * dropped when the cache grows past maxBytes.
+ */
+type termCache struct {
+	sync.Mutex
+	cols     map[string]*list.Element
+	lru      *list.List
+	bytes    int64
+	maxBytes int64
+
+	hits, misses, evicts int64
+}
+
+type termEntry struct {
+	key string
+	col []float64
+}
+
+func newTermCache(maxBytes int64) *termCache {
+	tc := new(termCache)
+	tc.cols = make(map[string]*list.Element)
+	tc.lru = list.New()
+	tc.maxBytes = maxBytes
+	return tc
+}
+
+// get returns the column for key, calling build on a miss
+func (tc *termCache) get(key string, build func() []float64) []float64 {
+	tc.Lock()
+	if el, ok := tc.cols[key]; ok {
+		tc.lru.MoveToFront(el)
+		tc.hits++
+		tc.Unlock()
+		return el.Value.(*termEntry).col
+	}
+	tc.misses++
+	tc.Unlock()
+
+	// evaluate outside the lock, the other evaluators keep going
+	col := build()
+
+	tc.Lock()
+	defer tc.Unlock()
+	if el, ok := tc.cols[key]; ok {
+		// someone else got there first
+		return el.Value.(*termEntry).col
+	}
+	sz := int64(8 * len(col))
+	if sz > tc.maxBytes {
+		return col
+	}
+	for tc.bytes+sz > tc.maxBytes && tc.lru.Len() > 0 {
+		old := tc.lru.Remove(tc.lru.Back()).(*termEntry)
+		delete(tc.cols, old.key)
+		tc.bytes -= int64(8 * len(old.col))
+		tc.evicts++
+	}
+	tc.cols[key] = tc.lru.PushFront(&termEntry{key, col})
+	tc.bytes += sz
+	return col
+}
+
+// counts for the iters log
+func (tc *termCache) counts() *results.TermCache {
+	tc.Lock()
+	defer tc.Unlock()
+	return &results.TermCache{
+		Hits:    tc.hits,
+		Misses:  tc.misses,
+		Evicts:  tc.evicts,
+		Columns: tc.lru.Len(),
+		MB:      float64(tc.bytes) / (1024 * 1024),
+	}
+}
+
+func serialKey(serial []int) string {
+	buf := make([]byte, binary.MaxVarintLen64*len(serial))
+	n := 0
+	for _, s := range serial {
+		n += binary.PutVarint(buf[n:], int64(s))
+	}
+	return string(buf[:n])
+}
+
+// a c_k * basis term, k is -1 for a term without a coefficient
+// and basis is nil for a lone coefficient
+type splitTerm struct {
+	k     int
+	basis expr.Expr
+}
+
+// splitTerms separates the top-level terms of e that are a single
+// coefficient times a coefficient-free basis from the rest
+func splitTerms(e expr.Expr) (lin []splitTerm, rest []expr.Expr) {
+	terms := []expr.Expr{e}
+	if add, ok := e.(*expr.Add); ok {
+		terms = add.CS
+	}
+	for _, t := range terms {
+		if t == nil {
+			continue
+		}
+		if st, ok := linearTerm(t); ok {
+			lin = append(lin, st)
+		} else {
+			rest = append(rest, t)
+		}
+	}
+	return
+}
+
+func linearTerm(t expr.Expr) (splitTerm, bool) {
+	switch n := t.(type) {
+	case *expr.Constant:
+		return splitTerm{n.P, nil}, true
+	case *expr.Mul:
+		k := -1
+		var fs []expr.Expr
+		for _, c := range n.CS {
+			if c == nil {
+				continue
+			}
+			if cn, ok := c.(*expr.Constant); ok && k < 0 {
+				k = cn.P
+				continue
+			}
+			if numConsts(c) != 0 {
+				return splitTerm{}, false
+			}
+			fs = append(fs, c)
+		}
+		if len(fs) == 0 {
+			return splitTerm{k, nil}, k >= 0
+		}
+		return splitTerm{k, product(fs...)}, true
+	}
+	if numConsts(t) == 0 {
+		return splitTerm{-1, t}, true
+	}
+	return splitTerm{}, false
+}
+
+// number of Constant nodes in e, -1 if e has nodes this doesn't know
+func numConsts(e expr.Expr) int {
+	add := func(cs ...expr.Expr) int {
+		cnt := 0
+		for _, c := range cs {
+			if c == nil {
+				continue
+			}
+			n := numConsts(c)
+			if n < 0 {
+				return -1
+			}
+			cnt += n
+		}
+		return cnt
+	}
+	switch n := e.(type) {
+	case *expr.Constant:
+		return 1
+	case *expr.ConstantF, *expr.Time, *expr.Var, *expr.System:
+		return 0
+	case *expr.Neg:
+		return add(n.C)
+	case *expr.Abs:
+		return add(n.C)
+	case *expr.Sqrt:
+		return add(n.C)
+	case *expr.Sin:
+		return add(n.C)
+	case *expr.Cos:
+		return add(n.C)
+	case *expr.Tan:
+		return add(n.C)
+	case *expr.Exp:
+		return add(n.C)
+	case *expr.Log:
+		return add(n.C)
+	case *expr.PowI:
+		return add(n.Base)
+	case *expr.PowF:
+		return add(n.Base)
+	case *expr.PowE:
+		return add(n.Base, n.Power)
+	case *expr.Div:
+		return add(n.Numer, n.Denom)
+	case *expr.Add:
+		return add(n.CS...)
+	case *expr.Mul:
+		return add(n.CS...)
+	}
+	return -1
+}
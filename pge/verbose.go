@@ -0,0 +1,59 @@
+package pge
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	probs "github.com/verdverm/go-pge/problems"
+)
+
+// Verbosity picks which progress prints reach stdout,
+// warnings and errors are always printed
+var Verbosity = VInfo
+
+const (
+	VQuiet = probs.VQuiet // nothing but warnings and results
+	VInfo  = probs.VInfo  // setup and summaries
+	VIter  = probs.VIter  // a few lines every iteration
+	VDebug = probs.VDebug // every expression popped, pushed or generated
+)
+
+// where Vprintf writes, stdout unless SetOutput says otherwise
+var (
+	voutMu sync.Mutex
+	vout   io.Writer = os.Stdout
+)
+
+func init() {
+	probs.Vprintf = Vprintf
+}
+
+// SetOutput sends the prints of Vprintf and Vprintln to w,
+// giving back where they went before
+func SetOutput(w io.Writer) io.Writer {
+	voutMu.Lock()
+	defer voutMu.Unlock()
+	old := vout
+	vout = w
+	return old
+}
+
+// Vprintf prints when Verbosity is at least level
+func Vprintf(level int, format string, a ...interface{}) {
+	if Verbosity >= level {
+		voutMu.Lock()
+		fmt.Fprintf(vout, format, a...)
+		voutMu.Unlock()
+	}
+}
+
+// Vprintln is Vprintf for fmt.Println
+func Vprintln(level int, a ...interface{}) {
+	if Verbosity >= level {
+		voutMu.Lock()
+		fmt.Fprintln(vout, a...)
+		voutMu.Unlock()
+	}
+}
@@ -0,0 +1,89 @@
+package plot
+
+import (
+	"math"
+)
+
+// axis maps data values to pixels, on a log10 scale if log is set
+type axis struct {
+	lo, hi float64 // data range, as log10 when log
+	log    bool
+	p0, p1 float64 // pixels of lo and hi
+	ticks  []float64
+}
+
+func newAxis(lo, hi float64, log bool, p0, p1 float64) *axis {
+	A := &axis{log: log, p0: p0, p1: p1}
+	if log {
+		lo, hi = math.Log10(lo), math.Log10(hi)
+	}
+	if hi-lo < 1e-12*math.Max(1, math.Abs(lo)) {
+		// a single value, give it some room
+		pad := math.Max(math.Abs(lo)*0.1, 0.5)
+		if log {
+			pad = 0.5
+		}
+		lo, hi = lo-pad, hi+pad
+	}
+
+	if log && hi-lo >= 1 {
+		// whole decades
+		A.lo, A.hi = math.Floor(lo), math.Ceil(hi)
+		step := math.Ceil((A.hi - A.lo) / 8)
+		for e := A.lo; e <= A.hi+1e-9; e += step {
+			A.ticks = append(A.ticks, math.Pow(10, e))
+		}
+		return A
+	}
+
+	if log {
+		// under a decade, nice ticks on the values themselves
+		vlo, vhi := math.Pow(10, lo), math.Pow(10, hi)
+		for _, t := range niceTicks(vlo, vhi) {
+			if t > 0 {
+				A.ticks = append(A.ticks, t)
+			}
+		}
+		A.lo, A.hi = lo, hi
+		return A
+	}
+
+	A.ticks = niceTicks(lo, hi)
+	step := A.ticks[1] - A.ticks[0]
+	A.lo = math.Floor(lo/step+1e-9) * step
+	A.hi = math.Ceil(hi/step-1e-9) * step
+	A.ticks = niceTicks(A.lo, A.hi)
+	return A
+}
+
+func (A *axis) px(v float64) float64 {
+	if A.log {
+		v = math.Log10(v)
+	}
+	return A.p0 + (v-A.lo)/(A.hi-A.lo)*(A.p1-A.p0)
+}
+
+// niceTicks are about 6 round values covering lo to hi,
+// spaced by 1, 2 or 5 times a power of ten
+func niceTicks(lo, hi float64) []float64 {
+	raw := (hi - lo) / 6
+	mag := math.Pow(10, math.Floor(math.Log10(raw)))
+	step := mag
+	for _, m := range []float64{1, 2, 5, 10} {
+		step = m * mag
+		if step >= raw {
+			break
+		}
+	}
+	var ticks []float64
+	for t := math.Ceil(lo/step-1e-9) * step; t <= hi+step*1e-9; t += step {
+		if math.Abs(t) < step*1e-9 {
+			t = 0
+		}
+		ticks = append(ticks, t)
+	}
+	if len(ticks) < 2 {
+		ticks = []float64{lo, hi}
+	}
+	return ticks
+}
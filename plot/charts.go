@@ -0,0 +1,99 @@
+package plot
+
+import (
+	"math"
+	"sort"
+)
+
+// Curve is a median with its quartiles, by iteration
+type Curve struct {
+	Name           string
+	Median, Q1, Q3 []float64
+}
+
+// Convergence plots curves against iteration on a log scale,
+// each a median line over its shaded quartile band
+func Convergence(title, ylabel string, iters []float64, curves ...Curve) *Plot {
+	P := New(title, "iteration", ylabel)
+	P.LogY = true
+	for i, C := range curves {
+		color := Palette[i%len(Palette)]
+		if C.Q1 != nil && C.Q3 != nil {
+			P.Band("", iters, C.Q1, C.Q3, color)
+		}
+		P.Line(C.Name, iters, C.Median, color)
+	}
+	return P
+}
+
+// ParetoFront plots error against size for every equation,
+// with a step line along those no smaller equation beats
+func ParetoFront(title string, sizes, errs []float64) *Plot {
+	P := New(title, "size", "error")
+	P.LogY = true
+	P.Scatter("equations", sizes, errs, Palette[0])
+
+	idx := make([]int, 0, len(sizes))
+	for i := range sizes {
+		if i < len(errs) && P.usable(sizes[i], false) && P.usable(errs[i], true) {
+			idx = append(idx, i)
+		}
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		if sizes[idx[a]] != sizes[idx[b]] {
+			return sizes[idx[a]] < sizes[idx[b]]
+		}
+		return errs[idx[a]] < errs[idx[b]]
+	})
+	var fx, fy []float64
+	best := math.Inf(1)
+	for _, i := range idx {
+		if errs[i] < best {
+			best = errs[i]
+			fx = append(fx, sizes[i])
+			fy = append(fy, errs[i])
+		}
+	}
+	P.Steps("front", fx, fy, Palette[1])
+	return P
+}
+
+// PredActual plots predicted against actual values,
+// with the diagonal a perfect model would fall on
+func PredActual(title string, actual, pred []float64) *Plot {
+	P := New(title, "actual", "predicted")
+	P.Scatter("", actual, pred, Palette[0])
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for i := range actual {
+		if i < len(pred) && P.usable(actual[i], false) && P.usable(pred[i], false) {
+			lo = math.Min(lo, math.Min(actual[i], pred[i]))
+			hi = math.Max(hi, math.Max(actual[i], pred[i]))
+		}
+	}
+	if lo <= hi {
+		P.Line("", []float64{lo, hi}, []float64{lo, hi}, "#888888")
+	}
+	return P
+}
+
+// Residuals plots predicted minus actual against predicted
+func Residuals(title string, actual, pred []float64) *Plot {
+	P := New(title, "predicted", "residual")
+	n := len(actual)
+	if len(pred) < n {
+		n = len(pred)
+	}
+	res := make([]float64, n)
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for i := 0; i < n; i++ {
+		res[i] = pred[i] - actual[i]
+		if P.usable(pred[i], false) && P.usable(res[i], false) {
+			lo, hi = math.Min(lo, pred[i]), math.Max(hi, pred[i])
+		}
+	}
+	P.Scatter("", pred[:n], res, Palette[0])
+	if lo <= hi {
+		P.Line("", []float64{lo, hi}, []float64{0, 0}, "#888888")
+	}
+	return P
+}
@@ -0,0 +1,333 @@
+// Package plot draws the SVG plots of the post-processing and reports,
+// without any programs outside of Go.
+//
+// A Plot is a set of series on one pair of axes:
+//
+//	P := plot.New("Koza_1", "iteration", "test error")
+//	P.LogY = true
+//	P.Band("best q1-q3", iters, q1, q3, "")
+//	P.Line("best median", iters, median, "")
+//	P.WriteFile("error.svg")
+//
+// Points that are NaN, infinite, or not positive on a log axis are left
+// out, and lines are broken around them.
+package plot
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"sync/atomic"
+)
+
+// default size in pixels
+const (
+	DefaultWidth  = 720
+	DefaultHeight = 450
+)
+
+// scatters with more points than this are thinned evenly
+const MaxScatter = 5000
+
+var clipIDs int64
+
+// series colors, in order, for series added without one
+var Palette = []string{
+	"#1f77b4", "#d62728", "#2ca02c", "#ff7f0e",
+	"#9467bd", "#8c564b", "#e377c2", "#17becf",
+}
+
+type seriesKind int
+
+const (
+	lineSeries seriesKind = iota
+	stepSeries
+	scatterSeries
+	bandSeries
+)
+
+type series struct {
+	kind   seriesKind
+	name   string
+	color  string
+	xs, ys []float64
+	hi     []float64 // upper edge of a band, ys is the lower
+}
+
+type Plot struct {
+	Title  string
+	XLabel string
+	YLabel string
+	LogX   bool
+	LogY   bool
+	Width  int
+	Height int
+
+	series []*series
+}
+
+func New(title, xlabel, ylabel string) *Plot {
+	return &Plot{
+		Title:  title,
+		XLabel: xlabel,
+		YLabel: ylabel,
+		Width:  DefaultWidth,
+		Height: DefaultHeight,
+	}
+}
+
+// Line joins the points in order
+func (P *Plot) Line(name string, xs, ys []float64, color string) {
+	P.add(&series{kind: lineSeries, name: name, color: color, xs: xs, ys: ys})
+}
+
+// Steps joins the points in order with horizontal then vertical segments
+func (P *Plot) Steps(name string, xs, ys []float64, color string) {
+	P.add(&series{kind: stepSeries, name: name, color: color, xs: xs, ys: ys})
+}
+
+// Scatter marks each point
+func (P *Plot) Scatter(name string, xs, ys []float64, color string) {
+	if len(xs) > MaxScatter {
+		xs, ys = thin(xs, MaxScatter), thin(ys, MaxScatter)
+	}
+	P.add(&series{kind: scatterSeries, name: name, color: color, xs: xs, ys: ys})
+}
+
+// Band shades between lo and hi
+func (P *Plot) Band(name string, xs, lo, hi []float64, color string) {
+	P.add(&series{kind: bandSeries, name: name, color: color, xs: xs, ys: lo, hi: hi})
+}
+
+func (P *Plot) add(S *series) {
+	if S.color == "" {
+		S.color = Palette[len(P.series)%len(Palette)]
+	}
+	P.series = append(P.series, S)
+}
+
+func thin(vs []float64, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = vs[i*len(vs)/n]
+	}
+	return out
+}
+
+// the data window of the plot
+type window struct {
+	x0, x1, y0, y1 float64
+}
+
+func (P *Plot) usable(v float64, log bool) bool {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return false
+	}
+	return !log || v > 0
+}
+
+func (P *Plot) bounds() (W window, ok bool) {
+	W = window{math.Inf(1), math.Inf(-1), math.Inf(1), math.Inf(-1)}
+	see := func(x, y float64) {
+		if !P.usable(x, P.LogX) || !P.usable(y, P.LogY) {
+			return
+		}
+		W.x0, W.x1 = math.Min(W.x0, x), math.Max(W.x1, x)
+		W.y0, W.y1 = math.Min(W.y0, y), math.Max(W.y1, y)
+		ok = true
+	}
+	for _, S := range P.series {
+		for i := range S.xs {
+			if i < len(S.ys) {
+				see(S.xs[i], S.ys[i])
+			}
+			if i < len(S.hi) {
+				see(S.xs[i], S.hi[i])
+			}
+		}
+	}
+	return
+}
+
+// SVG renders the plot as a standalone svg element
+func (P *Plot) SVG() []byte {
+	var b bytes.Buffer
+	P.WriteSVG(&b)
+	return b.Bytes()
+}
+
+func (P *Plot) WriteFile(filename string) error {
+	return ioutil.WriteFile(filename, P.SVG(), 0644)
+}
+
+func (P *Plot) WriteSVG(w io.Writer) error {
+	width, height := P.Width, P.Height
+	if width <= 0 {
+		width = DefaultWidth
+	}
+	if height <= 0 {
+		height = DefaultHeight
+	}
+	// plot area
+	left, right, top, bottom := 70.0, float64(width)-20, 36.0, float64(height)-50
+	if P.hasLegend() {
+		right -= 150
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="white"/>`+"\n", width, height)
+	fmt.Fprintf(&b, `<text x="%g" y="22" text-anchor="middle" font-size="15">%s</text>`+"\n",
+		(left+right)/2, html.EscapeString(P.Title))
+
+	W, ok := P.bounds()
+	if !ok {
+		fmt.Fprintf(&b, `<text x="%g" y="%g" text-anchor="middle" fill="#888">no data</text>`+"\n",
+			(left+right)/2, (top+bottom)/2)
+		b.WriteString("</svg>\n")
+		_, err := w.Write(b.Bytes())
+		return err
+	}
+
+	xax := newAxis(W.x0, W.x1, P.LogX, left, right)
+	yax := newAxis(W.y0, W.y1, P.LogY, bottom, top)
+
+	// grid and ticks
+	b.WriteString(`<g stroke="#e5e5e5">` + "\n")
+	for _, t := range xax.ticks {
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%g" x2="%.1f" y2="%g"/>`+"\n", xax.px(t), top, xax.px(t), bottom)
+	}
+	for _, t := range yax.ticks {
+		fmt.Fprintf(&b, `<line x1="%g" y1="%.1f" x2="%g" y2="%.1f"/>`+"\n", left, yax.px(t), right, yax.px(t))
+	}
+	b.WriteString("</g>\n")
+	for _, t := range xax.ticks {
+		fmt.Fprintf(&b, `<text x="%.1f" y="%g" text-anchor="middle">%s</text>`+"\n", xax.px(t), bottom+16, tickLabel(t))
+	}
+	for _, t := range yax.ticks {
+		fmt.Fprintf(&b, `<text x="%g" y="%.1f" text-anchor="end">%s</text>`+"\n", left-6, yax.px(t)+4, tickLabel(t))
+	}
+	fmt.Fprintf(&b, `<rect x="%g" y="%g" width="%g" height="%g" fill="none" stroke="#444"/>`+"\n",
+		left, top, right-left, bottom-top)
+	fmt.Fprintf(&b, `<text x="%g" y="%g" text-anchor="middle">%s</text>`+"\n",
+		(left+right)/2, bottom+38, html.EscapeString(P.XLabel))
+	fmt.Fprintf(&b, `<text transform="translate(16 %g) rotate(-90)" text-anchor="middle">%s</text>`+"\n",
+		(top+bottom)/2, html.EscapeString(P.YLabel))
+
+	// series, clipped to the plot area
+	// ids are unique so plots can share an html page
+	clip := atomic.AddInt64(&clipIDs, 1)
+	fmt.Fprintf(&b, `<clipPath id="plotarea%d"><rect x="%g" y="%g" width="%g" height="%g"/></clipPath>`+"\n",
+		clip, left, top, right-left, bottom-top)
+	fmt.Fprintf(&b, `<g clip-path="url(#plotarea%d)">`+"\n", clip)
+	for _, S := range P.series {
+		P.drawSeries(&b, S, xax, yax)
+	}
+	b.WriteString("</g>\n")
+
+	if P.hasLegend() {
+		y := top + 6
+		for _, S := range P.series {
+			if S.name == "" {
+				continue
+			}
+			x := right + 14
+			switch S.kind {
+			case scatterSeries:
+				fmt.Fprintf(&b, `<circle cx="%g" cy="%g" r="3" fill="%s"/>`+"\n", x+10, y, S.color)
+			case bandSeries:
+				fmt.Fprintf(&b, `<rect x="%g" y="%g" width="20" height="10" fill="%s" fill-opacity="0.25"/>`+"\n", x, y-5, S.color)
+			default:
+				fmt.Fprintf(&b, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="%s" stroke-width="2"/>`+"\n", x, y, x+20, y, S.color)
+			}
+			fmt.Fprintf(&b, `<text x="%g" y="%g">%s</text>`+"\n", x+26, y+4, html.EscapeString(S.name))
+			y += 18
+		}
+	}
+
+	b.WriteString("</svg>\n")
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+func (P *Plot) hasLegend() bool {
+	for _, S := range P.series {
+		if S.name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (P *Plot) drawSeries(b *bytes.Buffer, S *series, xax, yax *axis) {
+	ok := func(x, y float64) bool { return P.usable(x, P.LogX) && P.usable(y, P.LogY) }
+
+	switch S.kind {
+	case scatterSeries:
+		fmt.Fprintf(b, `<g fill="%s" fill-opacity="0.7">`+"\n", S.color)
+		for i := range S.xs {
+			if i < len(S.ys) && ok(S.xs[i], S.ys[i]) {
+				fmt.Fprintf(b, `<circle cx="%.1f" cy="%.1f" r="2.5"/>`+"\n", xax.px(S.xs[i]), yax.px(S.ys[i]))
+			}
+		}
+		b.WriteString("</g>\n")
+
+	case bandSeries:
+		// one polygon per unbroken run of points
+		for _, run := range runs(len(S.xs), func(i int) bool {
+			return i < len(S.ys) && i < len(S.hi) && ok(S.xs[i], S.ys[i]) && ok(S.xs[i], S.hi[i])
+		}) {
+			var pts bytes.Buffer
+			for _, i := range run {
+				fmt.Fprintf(&pts, "%.1f,%.1f ", xax.px(S.xs[i]), yax.px(S.hi[i]))
+			}
+			for k := len(run) - 1; k >= 0; k-- {
+				i := run[k]
+				fmt.Fprintf(&pts, "%.1f,%.1f ", xax.px(S.xs[i]), yax.px(S.ys[i]))
+			}
+			fmt.Fprintf(b, `<polygon points="%s" fill="%s" fill-opacity="0.25" stroke="none"/>`+"\n", pts.String(), S.color)
+		}
+
+	default:
+		for _, run := range runs(len(S.xs), func(i int) bool { return i < len(S.ys) && ok(S.xs[i], S.ys[i]) }) {
+			var pts bytes.Buffer
+			for k, i := range run {
+				x, y := xax.px(S.xs[i]), yax.px(S.ys[i])
+				if S.kind == stepSeries && k > 0 {
+					fmt.Fprintf(&pts, "%.1f,%.1f ", x, yax.px(S.ys[run[k-1]]))
+				}
+				fmt.Fprintf(&pts, "%.1f,%.1f ", x, y)
+			}
+			fmt.Fprintf(b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`+"\n", pts.String(), S.color)
+		}
+	}
+}
+
+// runs splits 0..n-1 into the maximal runs where good holds
+func runs(n int, good func(i int) bool) [][]int {
+	var all [][]int
+	var cur []int
+	for i := 0; i < n; i++ {
+		if good(i) {
+			cur = append(cur, i)
+			continue
+		}
+		if len(cur) > 0 {
+			all = append(all, cur)
+			cur = nil
+		}
+	}
+	if len(cur) > 0 {
+		all = append(all, cur)
+	}
+	return all
+}
+
+func tickLabel(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
@@ -0,0 +1,305 @@
+package problems
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+
+	config "github.com/verdverm/go-pge/config"
+)
+
+/* Benchmark definition files
+ *
+ * Benchmarks can be described in config files instead of BenchmarkList,
+ * one or more to a file, each starting at its Name line:
+ *
+ *   Name = Keijzer_05_noisy
+ *   Vars = x y z
+ *   Func = (30*x*z) / ((x-10)*y^2)
+ *   Train = x,z: U[-1,1,1000] y: U[1,2,1000]   # sampling spec, see sampling.go
+ *   TrainSamples = 0                           # 0 takes the counts in the spec
+ *   Test = x,z: U[-1,1,10000] y: U[1,2,10000]  # default the Train spec
+ *   TestSamples = 0
+ *   Functions = Add Mul Div                    # default from the Func text
+ *   NonTrig = Add Mul Div                      # default Functions without trig
+ *   Noise = add=0.05 outliers=0.01             # see noise.go
+ *   Seed = 42                                  # 0 takes -seed
+ *   Units = x,z: m; y: s                       # SI, see units.go
+ *   Unit = m s^-2                              # of the function
+ *
+ * Noise goes on the training data only, the testing data is left clean
+ * so models are judged against the truth. The seed and noise are written
+ * at the top of the data files, so the data can be made again exactly.
+ */
+
+// BenchmarkDef is a benchmark read from a definition file,
+// with how its data is to be generated
+type BenchmarkDef struct {
+	Benchmark
+	Noise Noise
+	Seed  int64
+	Unit  string // SI unit of the function, "" when not given
+}
+
+// the raw lines of one definition, resolved once the whole of it is read
+type benchDefLines struct {
+	def         *BenchmarkDef
+	vars        []string
+	train, test string
+	units       string
+	funcsSet    bool
+	nontrigSet  bool
+}
+
+type benchDefFile struct {
+	defs []*benchDefLines
+}
+
+// ReadBenchmarkDefs parses the definitions of a benchmark file
+func ReadBenchmarkDefs(contents []byte) ([]*BenchmarkDef, error) {
+	var F benchDefFile
+	if err := config.ParseConfig(contents, benchDefParser, &F); err != nil {
+		return nil, err
+	}
+	defs := make([]*BenchmarkDef, 0, len(F.defs))
+	for _, L := range F.defs {
+		if err := L.resolve(); err != nil {
+			return nil, fmt.Errorf("benchmark %s: %v", L.def.Name, err)
+		}
+		defs = append(defs, L.def)
+	}
+	return defs, nil
+}
+
+func benchDefParser(field, value string, cfg interface{}) (err error) {
+	F := cfg.(*benchDefFile)
+
+	if strings.ToUpper(field) == "NAME" {
+		F.defs = append(F.defs, &benchDefLines{def: &BenchmarkDef{Benchmark: Benchmark{Name: value}}})
+		return nil
+	}
+	if len(F.defs) == 0 {
+		return fmt.Errorf("benchmark file: %s before the first Name", field)
+	}
+	L := F.defs[len(F.defs)-1]
+	D := L.def
+
+	switch strings.ToUpper(field) {
+	case "VARS":
+		L.vars = strings.Fields(strings.Replace(value, ",", " ", -1))
+	case "FUNC":
+		D.FuncText = value
+	case "TRAIN":
+		L.train = value
+	case "TEST":
+		L.test = value
+	case "TRAINSAMPLES":
+		D.TrainSamples, err = strconv.Atoi(value)
+	case "TESTSAMPLES":
+		D.TestSamples, err = strconv.Atoi(value)
+	case "FUNCTIONS":
+		D.Functions, L.funcsSet = strings.Fields(value), true
+	case "NONTRIG":
+		D.NonTrig, L.nontrigSet = strings.Fields(value), true
+	case "NOISE":
+		D.Noise, err = ParseNoise(value)
+	case "UNITS":
+		L.units = value
+	case "UNIT":
+		if _, err = ParseUnit(value); err == nil {
+			D.Unit = strings.Join(strings.Fields(value), " ")
+		}
+	case "SEED":
+		D.Seed, err = strconv.ParseInt(value, 10, 64)
+
+	default:
+		return fmt.Errorf("benchmark %s: unknown field %s", D.Name, field)
+	}
+	if err != nil {
+		return fmt.Errorf("benchmark %s: %s: %v", D.Name, field, err)
+	}
+	return nil
+}
+
+func (L *benchDefLines) resolve() (err error) {
+	D := L.def
+	switch {
+	case D.FuncText == "":
+		return fmt.Errorf("no Func")
+	case len(L.vars) == 0:
+		return fmt.Errorf("no Vars")
+	case L.train == "":
+		return fmt.Errorf("no Train sampling")
+	}
+	if L.test == "" {
+		L.test = L.train
+	}
+	if D.TrainVars, err = ParseSampling(L.vars, L.train); err != nil {
+		return fmt.Errorf("Train: %v", err)
+	}
+	if D.TestVars, err = ParseSampling(L.vars, L.test); err != nil {
+		return fmt.Errorf("Test: %v", err)
+	}
+	if L.units != "" {
+		units, err := ParseUnits(L.vars, L.units)
+		if err != nil {
+			return fmt.Errorf("Units: %v", err)
+		}
+		for i, u := range units {
+			D.TrainVars[i].Unit, D.TestVars[i].Unit = u, u
+		}
+	}
+
+	funcs, nontrig := FuncsOf(D.FuncText, L.vars)
+	if !L.funcsSet {
+		D.Functions = funcs
+	}
+	if !L.nontrigSet {
+		D.NonTrig = nontrig
+		if L.funcsSet {
+			D.NonTrig = nonTrig(D.Functions)
+		}
+	}
+	return nil
+}
+
+var funcNameRE = regexp.MustCompile(`[A-Za-z]+`)
+
+// FuncsOf lists the functions the search needs for text in the
+// variables vars, and those of them allowed under a trig function
+func FuncsOf(text string, vars []string) (funcs, nontrig []string) {
+	funcs = []string{"Add", "Mul", "Div"}
+	have := make(map[string]bool)
+	add := func(f string) {
+		if !have[f] {
+			have[f] = true
+			funcs = append(funcs, f)
+		}
+	}
+	for _, w := range funcNameRE.FindAllString(text, -1) {
+		switch strings.ToLower(w) {
+		case "sin":
+			add("Sin")
+		case "cos":
+			add("Cos")
+		case "tan":
+			add("Tan")
+		case "e", "exp":
+			add("Exp")
+		case "ln", "log":
+			add("Log")
+		case "sqrt":
+			add("Sqrt")
+		}
+	}
+	// the search writes x^y as e^(y*ln(x))
+	if varPower(text, vars) {
+		add("Exp")
+		add("Log")
+	}
+	return funcs, nonTrig(funcs)
+}
+
+var (
+	identRE     = regexp.MustCompile(`[A-Za-z_]\w*`)
+	powBaseRE   = regexp.MustCompile(`([A-Za-z_]\w*)\s*$`)
+	powSimpleRE = regexp.MustCompile(`^[-+]?[\w.]+`)
+)
+
+// varPower reports whether text raises anything but e to a power
+// that holds one of vars
+func varPower(text string, vars []string) bool {
+	isVar := make(map[string]bool)
+	for _, v := range vars {
+		isVar[v] = true
+	}
+	for i, c := range text {
+		if c != '^' {
+			continue
+		}
+		if m := powBaseRE.FindStringSubmatch(text[:i]); m != nil && m[1] == "e" {
+			continue
+		}
+
+		rest := strings.TrimLeft(text[i+1:], " ")
+		power := powSimpleRE.FindString(rest)
+		if strings.HasPrefix(rest, "(") {
+			depth, end := 0, len(rest)
+			for k, r := range rest {
+				if r == '(' {
+					depth++
+				} else if r == ')' {
+					if depth--; depth == 0 {
+						end = k + 1
+						break
+					}
+				}
+			}
+			power = rest[:end]
+		}
+		for _, w := range identRE.FindAllString(power, -1) {
+			if isVar[w] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func nonTrig(funcs []string) []string {
+	var nt []string
+	for _, f := range funcs {
+		switch f {
+		case "Sin", "Cos", "Tan":
+		default:
+			nt = append(nt, f)
+		}
+	}
+	return nt
+}
+
+// BuiltinDefs are the benchmarks of BenchmarkList and FeynmanList
+func BuiltinDefs() []*BenchmarkDef {
+	defs := make([]*BenchmarkDef, 0, len(BenchmarkList)+len(FeynmanList))
+	for _, B := range BenchmarkList {
+		defs = append(defs, &BenchmarkDef{Benchmark: B})
+	}
+	for _, D := range FeynmanList {
+		D := D
+		defs = append(defs, &D)
+	}
+	return defs
+}
+
+// Generate makes the benchmark's data from D.Seed, the sampling draws
+// from math/rand, and adds the noise to the training set
+func (D *BenchmarkDef) Generate() *ExprProblem {
+	rand.Seed(D.Seed)
+	p := GenBenchmark(D.Benchmark)
+	if D.Unit != "" {
+		for _, sets := range [][]*PointSet{p.Train, p.Test} {
+			for _, PS := range sets {
+				PS.SetUnit(PS.DepndName(0), D.Unit)
+			}
+		}
+	}
+
+	rng := rand.New(rand.NewSource(D.Seed))
+	for _, PS := range p.Train {
+		D.Noise.Apply(PS, rng)
+		PS.SetComments([]string{
+			D.Name + " training data",
+			fmt.Sprintf("Seed = %d", D.Seed),
+			fmt.Sprintf("Noise = %v", D.Noise),
+		})
+	}
+	for _, PS := range p.Test {
+		PS.SetComments([]string{
+			D.Name + " testing data",
+			fmt.Sprintf("Seed = %d", D.Seed),
+		})
+	}
+	return p
+}
@@ -13,6 +13,7 @@ const (
 	_ RangeType = iota
 	Uniform
 	Equal
+	LogUniform
 )
 
 type BenchmarkVar struct {
@@ -20,12 +21,14 @@ type BenchmarkVar struct {
 	Index   int
 	Rtype   RangeType
 	L, H, S float64 // low,high,step of range
+	N       int     // samples of a random range, 0 for the benchmark's count
+	Unit    string  // SI unit, "" when not given, see units.go
 }
 
 type Benchmark struct {
 	Name         string
 	TrainVars    []BenchmarkVar
-	TrainSamples int
+	TrainSamples int // 0 takes the count from the random ranges
 	TestVars     []BenchmarkVar
 	TestSamples  int
 
@@ -40,18 +43,16 @@ func GenBenchmark(b Benchmark) (p *ExprProblem) {
 	p.Name = b.Name
 
 	// set the function
-	varNames := make([]string, 0)
-	for _, v := range b.TrainVars {
-		// fmt.Printf("  %v\n", v)
-		varNames = append(varNames, v.Name)
-	}
-	eqn := expr.ParseFunc(b.FuncText, varNames)
-	sort := eqn.Clone()
-	rules := expr.DefaultRules()
-	rules.GroupAddTerms = false
-	sort = sort.Simplify(rules)
+	varNames := b.VarNames()
 	p.VarNames = varNames
-	p.FuncTree = sort
+	if eqn := b.Func(); eqn != nil {
+		sort := eqn.Clone()
+		rules := expr.DefaultRules()
+		rules.GroupAddTerms = false
+		sort = sort.Simplify(rules)
+		p.FuncTree = sort
+	}
+	f := b.Evaluator()
 
 	trn := new(PointSet)
 	trn.SetFN(b.Name + "_train")
@@ -59,7 +60,8 @@ func GenBenchmark(b Benchmark) (p *ExprProblem) {
 	trn.SetNumDim(len(varNames))
 	trn.SetIndepNames(varNames)
 	trn.SetDepndNames([]string{"f(xs)"})
-	trn.SetPoints(GenBenchData(eqn, b.TrainVars, b.TrainSamples))
+	trn.SetPoints(genBenchData(f, b.TrainVars, b.TrainSamples))
+	setUnits(trn, b.TrainVars)
 	p.Train = make([]*PointSet, 1)
 	p.Train[0] = trn
 
@@ -69,94 +71,183 @@ func GenBenchmark(b Benchmark) (p *ExprProblem) {
 	tst.SetNumDim(len(varNames))
 	tst.SetIndepNames(varNames)
 	tst.SetDepndNames([]string{"f(xs)"})
-	tst.SetPoints(GenBenchData(eqn, b.TestVars, b.TestSamples))
+	tst.SetPoints(genBenchData(f, b.TestVars, b.TestSamples))
+	setUnits(tst, b.TestVars)
 	p.Test = make([]*PointSet, 1)
 	p.Test[0] = tst
 
 	return p
 }
 
+func setUnits(PS *PointSet, vars []BenchmarkVar) {
+	for _, v := range vars {
+		if v.Unit != "" {
+			PS.SetUnit(v.Name, v.Unit)
+		}
+	}
+}
+
+func (b *Benchmark) VarNames() []string {
+	varNames := make([]string, 0, len(b.TrainVars))
+	for _, v := range b.TrainVars {
+		varNames = append(varNames, v.Name)
+	}
+	return varNames
+}
+
+// Func parses the function text with its sums expanded,
+// nil when a sum runs up to a variable
+func (b *Benchmark) Func() expr.Expr {
+	text, err := expandSums(b.FuncText, b.VarNames(), nil)
+	if err != nil {
+		return nil
+	}
+	return expr.ParseFunc(text, b.VarNames())
+}
+
+// Evaluator returns the function as a Go func, expanding the sums
+// that run up to a variable at each point
+func (b *Benchmark) Evaluator() func(x []float64) float64 {
+	varNames := b.VarNames()
+	if eqn := b.Func(); eqn != nil {
+		return func(x []float64) float64 { return eqn.Eval(0, x, nil, nil) }
+	}
+	cache := make(map[string]expr.Expr)
+	return func(x []float64) float64 {
+		text, err := expandSums(b.FuncText, varNames, x)
+		if err != nil {
+			return math.NaN()
+		}
+		eqn, ok := cache[text]
+		if !ok {
+			eqn = expr.ParseFunc(text, varNames)
+			cache[text] = eqn
+		}
+		return eqn.Eval(0, x, nil, nil)
+	}
+}
+
+// GenBenchData samples e over vars, see genBenchData
 func GenBenchData(e expr.Expr, vars []BenchmarkVar, samples int) (pts []Point) {
+	return genBenchData(func(x []float64) float64 { return e.Eval(0, x, nil, nil) }, vars, samples)
+}
+
+// genBenchData makes the points of a data set. Grid variables take every
+// combination of their values, the first varying fastest, and the random
+// variables are drawn anew at each grid point. Without grid variables
+// there are samples points, or the largest count of the random ranges.
+// Points where f is not finite or over 100000 are drawn again, or
+// dropped on a grid.
+func genBenchData(f func(x []float64) float64, vars []BenchmarkVar, samples int) (pts []Point) {
 	pts = make([]Point, 0)
-	if vars[0].Rtype == Uniform {
-		for i := 0; i < samples; i++ {
-			input := make([]float64, len(vars))
-		retry:
-			for j, v := range vars {
-				r := rand.Float64()
-				input[j] = (r * (v.H - v.L)) + v.L
-			}
-			out := e.Eval(0, input, nil, nil)
+	var grid, random []int
+	for j, v := range vars {
+		if v.Rtype == Equal {
+			grid = append(grid, j)
+		} else {
+			random = append(random, j)
+		}
+	}
 
-			if math.IsNaN(out) || math.IsInf(out, 0) || math.Abs(out) > 100000.0 {
-				goto retry
+	if len(grid) == 0 {
+		if samples == 0 {
+			for _, j := range random {
+				if vars[j].N > samples {
+					samples = vars[j].N
+				}
 			}
+		}
+		for i := 0; i < samples; i++ {
+			if pnt, ok := benchPoint(f, vars, random, make([]float64, len(vars))); ok {
+				pts = append(pts, pnt)
+			}
+		}
+		return
+	}
 
-			var pnt Point
-			pnt.SetIndeps(input)
-			pnt.SetDepnds([]float64{out})
-			pts = append(pts, pnt)
+	axes := make([][]float64, len(grid))
+	for k, j := range grid {
+		axes[k] = vars[j].gridValues()
+		if len(axes[k]) == 0 {
+			return
+		}
+	}
+	counter := make([]int, len(grid))
+	for {
+		input := make([]float64, len(vars))
+		for k, j := range grid {
+			input[j] = axes[k][counter[k]]
 		}
-	} else { // RangeType == Equal
-		counter := make([]float64, len(vars))
-		for j, v := range vars {
-			counter[j] = v.L
+		if pnt, ok := benchPoint(f, vars, random, input); ok {
+			pts = append(pts, pnt)
 		}
-		L1, L2 := len(vars)-1, vars[len(vars)-1].L
 
-		for counter[L1] <= L2 {
-			input := make([]float64, len(vars))
-			copy(input, counter)
+		// increment counter
+		k := 0
+		for ; k < len(grid); k++ {
+			counter[k]++
+			if counter[k] < len(axes[k]) {
+				break
+			}
+			counter[k] = 0
+		}
+		if k == len(grid) {
+			break
+		}
+	}
+	return
+}
 
-			out := e.Eval(0, input, nil, nil)
-			var pnt Point
-			pnt.SetIndeps(input)
-			pnt.SetDepnds([]float64{out})
-			pts = append(pts, pnt)
+// tries at drawing the random variables for one point
+const benchRetries = 1000
 
-			// increment counter
-			for j, v := range vars {
-				counter[j] += v.S
-				if counter[j] > v.H {
-					counter[j] = v.L
-				} else {
-					break
-				}
+// benchPoint fills in the random variables of input and evaluates f there
+func benchPoint(f func(x []float64) float64, vars []BenchmarkVar, random []int, input []float64) (pnt Point, ok bool) {
+	for try := 0; try < benchRetries; try++ {
+		for _, j := range random {
+			input[j] = vars[j].draw()
+		}
+		out := f(input)
+		if math.IsNaN(out) || math.IsInf(out, 0) || math.Abs(out) > 100000.0 {
+			if len(random) == 0 {
+				return pnt, false
 			}
+			continue
 		}
+		pnt.SetIndeps(input)
+		pnt.SetDepnds([]float64{out})
+		return pnt, true
+	}
+	return pnt, false
+}
 
+func (v BenchmarkVar) draw() float64 {
+	r := rand.Float64()
+	if v.Rtype == LogUniform {
+		return math.Exp(math.Log(v.L) + r*(math.Log(v.H)-math.Log(v.L)))
 	}
+	return (r * (v.H - v.L)) + v.L
+}
 
-	return
+// gridValues are L, L+S, ... up to H, computed from L so steps don't accumulate error
+func (v BenchmarkVar) gridValues() []float64 {
+	if v.S <= 0 || v.H < v.L {
+		return nil
+	}
+	n := int(math.Floor((v.H-v.L)/v.S+1e-6)) + 1
+	vals := make([]float64, n)
+	for k := range vals {
+		vals[k] = v.L + float64(k)*v.S
+	}
+	return vals
 }
 
-var xU11 = BenchmarkVar{"x", 0, Uniform, -1.0, 1.0, 0.0}
-var xU22 = BenchmarkVar{"x", 0, Uniform, -2.0, 2.0, 0.0}
-var xU33 = BenchmarkVar{"x", 0, Uniform, -3.0, 3.0, 0.0}
-var xU01 = BenchmarkVar{"x", 0, Uniform, 0.0, 1.0, 0.0}
-var xU02 = BenchmarkVar{"x", 0, Uniform, 0.0, 2.0, 0.0}
-var xU04 = BenchmarkVar{"x", 0, Uniform, 0.0, 4.0, 0.0}
-var yU01 = BenchmarkVar{"y", 1, Uniform, 0.0, 1.0, 0.0}
-
-var xU5050 = BenchmarkVar{"x", 0, Uniform, -50.0, 50.0, 0.0}
-var yU5050 = BenchmarkVar{"y", 1, Uniform, -50.0, 50.0, 0.0}
-var zU5050 = BenchmarkVar{"z", 2, Uniform, -50.0, 50.0, 0.0}
-var vU5050 = BenchmarkVar{"v", 3, Uniform, -50.0, 50.0, 0.0}
-var wU5050 = BenchmarkVar{"w", 4, Uniform, -50.0, 50.0, 0.0}
-
-var korns5 = []BenchmarkVar{xU5050, yU5050, zU5050, vU5050, wU5050}
-var xyU01 = []BenchmarkVar{xU01, yU01}
-
-var xE11_01 = BenchmarkVar{"x", 0, Equal, -1.0, 1.0, 0.01}
-var xE22_01 = BenchmarkVar{"x", 0, Equal, -2.0, 2.0, 0.01}
-var xE33_01 = BenchmarkVar{"x", 0, Equal, -3.0, 3.0, 0.01}
-var xE11_001 = BenchmarkVar{"x", 0, Equal, -1.0, 1.0, 0.001}
-var xE22_001 = BenchmarkVar{"x", 0, Equal, -2.0, 2.0, 0.001}
-var xE33_001 = BenchmarkVar{"x", 0, Equal, -3.0, 3.0, 0.001}
-
-var xE55_4 = BenchmarkVar{"x", 0, Equal, -5.0, 5.0, 0.4}
-var yE55_4 = BenchmarkVar{"y", 1, Equal, -5.0, 5.0, 0.4}
-var xyE55_4 = []BenchmarkVar{xE55_4, yE55_4}
+var xU11 = sampling("x", "U[-1,1]")
+var xU02 = sampling("x", "U[0,2]")
+var xU04 = sampling("x", "U[0,4]")
+var xyU01 = sampling("x,y", "U[0,1]")
+var korns5 = sampling("x,y,z,v,w", "U[-50,50]")
+var xyE55_4 = sampling("x,y", "E[-5,5,0.4]")
 
 var kozaFuncs = []string{"Add", "Mul", "Div", "Sin", "Cos", "Exp", "Log"}
 var kornsFuncs = []string{"Add", "Mul", "Div", "Sin", "Cos", "Exp", "Log", "Sqrt"}
@@ -171,18 +262,18 @@ var vladiFuncsB_NT = []string{"Add", "Mul", "Div", "Exp"}
 var vladiFuncsC_NT = []string{"Add", "Mul", "Div", "Exp"}
 
 var BenchmarkList = []Benchmark{
-	Benchmark{"Koza_1", []BenchmarkVar{xU11}, 200, []BenchmarkVar{xU11}, 2000, vladiFuncsA, vladiFuncsA_NT, "x^4 + x^3 + x^2 + x"},
-	Benchmark{"Koza_2", []BenchmarkVar{xU11}, 200, []BenchmarkVar{xU11}, 2000, vladiFuncsA, vladiFuncsA_NT, "x^5 - 2x^3 + x"},
-	Benchmark{"Koza_3", []BenchmarkVar{xU11}, 200, []BenchmarkVar{xU11}, 2000, vladiFuncsA, vladiFuncsA_NT, "x^6 - 2x^4 + x^2"},
-
-	Benchmark{"Nguyen_01", []BenchmarkVar{xU11}, 200, []BenchmarkVar{xU11}, 2000, vladiFuncsA, vladiFuncsA_NT, "x^3 + x^2 + x"},
-	Benchmark{"Nguyen_02", []BenchmarkVar{xU11}, 200, []BenchmarkVar{xU11}, 2000, vladiFuncsA, vladiFuncsA_NT, "x^4 + x^3 + x^2 + x"},
-	Benchmark{"Nguyen_03", []BenchmarkVar{xU11}, 200, []BenchmarkVar{xU11}, 2000, vladiFuncsA, vladiFuncsA_NT, "x^5 + x^4 + x^3 + x^2 + x"},
-	Benchmark{"Nguyen_04", []BenchmarkVar{xU11}, 200, []BenchmarkVar{xU11}, 2000, vladiFuncsA, vladiFuncsA_NT, "x^6 + x^5 + x^4 + x^3 + x^2 + x"},
-	Benchmark{"Nguyen_05", []BenchmarkVar{xU11}, 200, []BenchmarkVar{xU11}, 2000, kozaFuncs, kozaFuncs_NT, "sin(x^2)*cos(x) - 1"},
-	Benchmark{"Nguyen_06", []BenchmarkVar{xU11}, 200, []BenchmarkVar{xU11}, 2000, kozaFuncs, kozaFuncs_NT, "sin(x) + sin(x + x^2)"},
-	Benchmark{"Nguyen_07", []BenchmarkVar{xU02}, 200, []BenchmarkVar{xU02}, 2000, kozaFuncs, kozaFuncs_NT, "ln(x+1) + ln(x^2 + 1)"},
-	Benchmark{"Nguyen_08", []BenchmarkVar{xU04}, 200, []BenchmarkVar{xU04}, 2000, kozaFuncs, kozaFuncs_NT, "sqrt(x)"},
+	Benchmark{"Koza_1", xU11, 200, xU11, 2000, vladiFuncsA, vladiFuncsA_NT, "x^4 + x^3 + x^2 + x"},
+	Benchmark{"Koza_2", xU11, 200, xU11, 2000, vladiFuncsA, vladiFuncsA_NT, "x^5 - 2x^3 + x"},
+	Benchmark{"Koza_3", xU11, 200, xU11, 2000, vladiFuncsA, vladiFuncsA_NT, "x^6 - 2x^4 + x^2"},
+
+	Benchmark{"Nguyen_01", xU11, 200, xU11, 2000, vladiFuncsA, vladiFuncsA_NT, "x^3 + x^2 + x"},
+	Benchmark{"Nguyen_02", xU11, 200, xU11, 2000, vladiFuncsA, vladiFuncsA_NT, "x^4 + x^3 + x^2 + x"},
+	Benchmark{"Nguyen_03", xU11, 200, xU11, 2000, vladiFuncsA, vladiFuncsA_NT, "x^5 + x^4 + x^3 + x^2 + x"},
+	Benchmark{"Nguyen_04", xU11, 200, xU11, 2000, vladiFuncsA, vladiFuncsA_NT, "x^6 + x^5 + x^4 + x^3 + x^2 + x"},
+	Benchmark{"Nguyen_05", xU11, 200, xU11, 2000, kozaFuncs, kozaFuncs_NT, "sin(x^2)*cos(x) - 1"},
+	Benchmark{"Nguyen_06", xU11, 200, xU11, 2000, kozaFuncs, kozaFuncs_NT, "sin(x) + sin(x + x^2)"},
+	Benchmark{"Nguyen_07", xU02, 200, xU02, 2000, kozaFuncs, kozaFuncs_NT, "ln(x+1) + ln(x^2 + 1)"},
+	Benchmark{"Nguyen_08", xU04, 200, xU04, 2000, kozaFuncs, kozaFuncs_NT, "sqrt(x)"},
 	Benchmark{"Nguyen_09", xyU01, 200, xyU01, 2000, kozaFuncs, kozaFuncs_NT, "sin(x) + sin(y^2)"},
 	Benchmark{"Nguyen_10", xyU01, 200, xyU01, 2000, kozaFuncs, kozaFuncs_NT, "2*sin(x)*cos(y)"},
 	Benchmark{"Nguyen_11", xyU01, 200, xyU01, 2000, kozaFuncs, kozaFuncs_NT, "x^y"},
@@ -207,31 +298,32 @@ var BenchmarkList = []Benchmark{
 	Benchmark{"Korns_14", korns5, 200, korns5, 2000, kornsFuncs, kornsFuncs_NT, "22 - 4.2*(cos(x)-tan(y))*(tanh(z)/sin(v))"},
 	Benchmark{"Korns_15", korns5, 200, korns5, 2000, kornsFuncs, kornsFuncs_NT, "12 - 6*(tan(x)/e^y)(ln(z)-tan(v))"},
 
-	Benchmark{"Keijzer_01", []BenchmarkVar{xE11_01}, 0, []BenchmarkVar{xE11_001}, 0, vladiFuncsA, vladiFuncsA_NT, "0.3*x*sin(2*PI*x)"},
-	Benchmark{"Keijzer_02", []BenchmarkVar{xE22_01}, 0, []BenchmarkVar{xE22_001}, 0, vladiFuncsA, vladiFuncsA_NT, "0.3*x*sin(2*PI*x)"},
-	Benchmark{"Keijzer_03", []BenchmarkVar{xE33_01}, 0, []BenchmarkVar{xE33_001}, 0, vladiFuncsA, vladiFuncsA_NT, "0.3*x*sin(2*PI*x)"},
-	// Benchmark{"Keijzer_04", "x", "E[0,10,0.05]", "x^3*e^-x*cos(x)*sin(x)*((sin(x))^2*cos(x) - 1)"},
-	// Benchmark{"Keijzer_05", "x,y,z", "x,z: U[-1,1,1000] y: U[1,2,1000]", "(30*x*z) / ((x-10)*y^2)"},
-	// // Benchmark{"Keijzer_06", "x", "E[1,50,1]", "\\SUM_i^x (1/i)"},
-	// Benchmark{"Keijzer_07", "x", "E[1,100,1]", "ln(x)"},
-	// Benchmark{"Keijzer_08", "x", "E[0,100,1]", "sqrt(x)"},
-	// // arcsinh(x) == ln(x+sqrt(x^2+1))
-	// Benchmark{"Keijzer_09", "x", "E[0,100,1]", "ln(x+sqrt(x^2+1))"},
-	// Benchmark{"Keijzer_10", "x,y", "U[0,1,100]", "x^y"},
-	// // xy ? sin((x-1)(y-1))
-	// // Benchmark{"Keijzer_11", "x,y", "U[-3,3,20]", "xy ? sin((x-1)*(y-1))"},
-	// Benchmark{"Keijzer_12", "x,y", "U[-3,3,20]", "x^4 - x^3 + 0.5*y^2 - y "},
-	// Benchmark{"Keijzer_13", "x,y", "U[-3,3,20]", "6*sin(x)*cos(y)"},
-	// Benchmark{"Keijzer_14", "x,y", "U[-3,3,20]", "8 / (2 + x^2 + y^2) "},
-	// Benchmark{"Keijzer_15", "x,y", "U[-3,3,20]", "0.2*x^3 + 0.5*y^2 - y - x "},
-
-	// // (e^{-(x-1)^2}) / (1.2 + (y-2.5)^2)
-	// Benchmark{"Vladislavleva_1", "x,y", "?U[0.3,4,10]?", "(e^{-(x-1)^2}) / (1.2 + (y-2.5)^2)"},
-	// Benchmark{"Vladislavleva_2", "x", "E[0.05,10,0.1]", "e^-x* x^3 * (cos(x)*sin(x)) * (cos(x)*(sin(x))^2-1)"},
-	// Benchmark{"Vladislavleva_3", "x,y", "x: E[0.05,10,0.1]  y: E[0.05,10.05,2]", "e^-y * x^3 * (cos(x)*sin(x)) * (cos(x)*(sin(x))^2-1)"},
-	// // Benchmark{"Vladislavleva_4", "x_i", " U[0.05, 6.05, 1024]", "10 / (5 + \\SUM_1^5 (x_i - 3)^2)"},
-	// Benchmark{"Vladislavleva_5", "x,y,z", "x,z: U[0.05,2,300]  y: U[1,2,300]", "(30*(x-1)*(z-1)) / (y^2*(x-10))  "},
-	// Benchmark{"Vladislavleva_6", "x,y", "U[0.1,5.9,30]", "6*sin(x)*cos(y)"},
-	// Benchmark{"Vladislavleva_7", "x,y", "U[0.05,6.05,300]", "(x-3)*(y-3) + 2sin((x-4)*(y-4))"},
-	// Benchmark{"Vladislavleva_8", "x,y", "U[0.05,6.05,50]", "((x-3)^4 + (y-3)^3 - (y-3)) / ((y-2)^4 + 10)"},
+	// Keijzer, "Improving symbolic regression with interval arithmetic and linear scaling" (2003)
+	Benchmark{"Keijzer_01", sampling("x", "E[-1,1,0.1]"), 0, sampling("x", "E[-1,1,0.001]"), 0, kozaFuncs, kozaFuncs_NT, "0.3*x*sin(2*PI*x)"},
+	Benchmark{"Keijzer_02", sampling("x", "E[-2,2,0.1]"), 0, sampling("x", "E[-2,2,0.001]"), 0, kozaFuncs, kozaFuncs_NT, "0.3*x*sin(2*PI*x)"},
+	Benchmark{"Keijzer_03", sampling("x", "E[-3,3,0.1]"), 0, sampling("x", "E[-3,3,0.001]"), 0, kozaFuncs, kozaFuncs_NT, "0.3*x*sin(2*PI*x)"},
+	Benchmark{"Keijzer_04", sampling("x", "E[0,10,0.05]"), 0, sampling("x", "E[0.05,10.05,0.05]"), 0, kozaFuncs, kozaFuncs_NT, "x^3*e^(-x)*cos(x)*sin(x)*((sin(x))^2*cos(x) - 1)"},
+	Benchmark{"Keijzer_05", sampling("x,y,z", "x,z: U[-1,1,1000] y: U[1,2,1000]"), 0, sampling("x,y,z", "x,z: U[-1,1,10000] y: U[1,2,10000]"), 0, vladiFuncsA, vladiFuncsA_NT, "(30*x*z) / ((x-10)*y^2)"},
+	Benchmark{"Keijzer_06", sampling("x", "E[1,50,1]"), 0, sampling("x", "E[1,120,1]"), 0, vladiFuncsA, vladiFuncsA_NT, "sum(i=1..x: 1/{i})"},
+	Benchmark{"Keijzer_07", sampling("x", "E[1,100,1]"), 0, sampling("x", "E[1,100,0.1]"), 0, kozaFuncs, kozaFuncs_NT, "ln(x)"},
+	Benchmark{"Keijzer_08", sampling("x", "E[0,100,1]"), 0, sampling("x", "E[0,100,0.1]"), 0, kornsFuncs, kornsFuncs_NT, "sqrt(x)"},
+	// arcsinh(x) == ln(x+sqrt(x^2+1))
+	Benchmark{"Keijzer_09", sampling("x", "E[0,100,1]"), 0, sampling("x", "E[0,100,0.1]"), 0, kornsFuncs, kornsFuncs_NT, "ln(x+sqrt(x^2+1))"},
+	Benchmark{"Keijzer_10", sampling("x,y", "U[0,1,100]"), 0, sampling("x,y", "E[0,1,0.01]"), 0, kozaFuncs, kozaFuncs_NT, "x^y"},
+	Benchmark{"Keijzer_11", sampling("x,y", "U[-3,3,20]"), 0, sampling("x,y", "E[-3,3,0.01]"), 0, kozaFuncs, kozaFuncs_NT, "x*y + sin((x-1)*(y-1))"},
+	Benchmark{"Keijzer_12", sampling("x,y", "U[-3,3,20]"), 0, sampling("x,y", "E[-3,3,0.01]"), 0, vladiFuncsA, vladiFuncsA_NT, "x^4 - x^3 + 0.5*y^2 - y"},
+	Benchmark{"Keijzer_13", sampling("x,y", "U[-3,3,20]"), 0, sampling("x,y", "E[-3,3,0.01]"), 0, kozaFuncs, kozaFuncs_NT, "6*sin(x)*cos(y)"},
+	Benchmark{"Keijzer_14", sampling("x,y", "U[-3,3,20]"), 0, sampling("x,y", "E[-3,3,0.01]"), 0, vladiFuncsA, vladiFuncsA_NT, "8 / (2 + x^2 + y^2)"},
+	Benchmark{"Keijzer_15", sampling("x,y", "U[-3,3,20]"), 0, sampling("x,y", "E[-3,3,0.01]"), 0, vladiFuncsA, vladiFuncsA_NT, "0.2*x^3 + 0.5*y^3 - y - x"},
+
+	// Vladislavleva, Smits & den Hertog, "Order of nonlinearity as a complexity measure" (2009)
+	Benchmark{"Vladislavleva_1", sampling("x,y", "U[0.3,4,100]"), 0, sampling("x,y", "E[-0.2,4.2,0.1]"), 0, vladiFuncsB, vladiFuncsB_NT, "e^(-(x-1)^2) / (1.2 + (y-2.5)^2)"},
+	Benchmark{"Vladislavleva_2", sampling("x", "E[0.05,10,0.1]"), 0, sampling("x", "E[-0.5,10.5,0.05]"), 0, vladiFuncsC, vladiFuncsC_NT, "e^(-x)*x^3*cos(x)*sin(x)*(cos(x)*(sin(x))^2 - 1)"},
+	Benchmark{"Vladislavleva_3", sampling("x,y", "x: E[0.05,10,0.1] y: E[0.05,10.05,2]"), 0, sampling("x,y", "x: E[-0.5,10.5,0.05] y: E[-0.5,10.5,0.5]"), 0, vladiFuncsC, vladiFuncsC_NT, "e^(-x)*x^3*cos(x)*sin(x)*(cos(x)*(sin(x))^2 - 1)*(y-5)"},
+	// 10 / (5 + sum_i (x_i - 3)^2), written out for the 5 inputs
+	Benchmark{"Vladislavleva_4", sampling("x,y,z,v,w", "U[0.05,6.05,1024]"), 0, sampling("x,y,z,v,w", "U[-0.25,6.35,5000]"), 0, vladiFuncsA, vladiFuncsA_NT, "10 / (5 + (x-3)^2 + (y-3)^2 + (z-3)^2 + (v-3)^2 + (w-3)^2)"},
+	Benchmark{"Vladislavleva_5", sampling("x,y,z", "x,z: U[0.05,2,300] y: U[1,2,300]"), 0, sampling("x,y,z", "x,z: E[-0.05,2.1,0.15] y: E[0.95,2.05,0.1]"), 0, vladiFuncsA, vladiFuncsA_NT, "(30*(x-1)*(z-1)) / (y^2*(x-10))"},
+	Benchmark{"Vladislavleva_6", sampling("x,y", "U[0.1,5.9,30]"), 0, sampling("x,y", "E[-0.05,6.05,0.02]"), 0, vladiFuncsC, vladiFuncsC_NT, "6*sin(x)*cos(y)"},
+	Benchmark{"Vladislavleva_7", sampling("x,y", "U[0.05,6.05,300]"), 0, sampling("x,y", "U[-0.25,6.35,1000]"), 0, vladiFuncsC, vladiFuncsC_NT, "(x-3)*(y-3) + 2*sin((x-4)*(y-4))"},
+	Benchmark{"Vladislavleva_8", sampling("x,y", "U[0.05,6.05,50]"), 0, sampling("x,y", "E[-0.25,6.35,0.2]"), 0, vladiFuncsA, vladiFuncsA_NT, "((x-3)^4 + (y-3)^3 - (y-3)) / ((y-2)^4 + 10)"},
 }
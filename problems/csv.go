@@ -0,0 +1,404 @@
+package problems
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type MissingPolicy int
+
+const (
+	MissingDrop MissingPolicy = iota
+	MissingError
+	MissingImpute
+)
+
+// options for reading delimited (CSV/TSV) data files
+type CsvParams struct {
+	Delim rune // 0 picks ',' or '\t' from the file extension
+
+	// column roles by header name
+	// if Indep is empty, every column not otherwise mapped is independent
+	Indep  []string
+	Depnd  []string
+	Weight string
+	Ignore []string
+
+	// handling of missing values
+	Missing MissingPolicy
+	Impute  string   // mean, median, zero
+	NA      []string // tokens treated as missing (besides the empty string)
+}
+
+func NewCsvParams() *CsvParams {
+	cp := new(CsvParams)
+	cp.Missing = MissingDrop
+	cp.Impute = "mean"
+	cp.NA = []string{"NA", "N/A", "NaN", "nan", "null", "?"}
+	return cp
+}
+
+func ParseCsvParams(field, value string, config interface{}) (found bool, err error) {
+
+	CP := config.(*CsvParams)
+	found = true
+
+	switch strings.ToUpper(field) {
+	case "CSVDELIM":
+		switch strings.ToLower(value) {
+		case "comma":
+			CP.Delim = ','
+		case "tab":
+			CP.Delim = '\t'
+		case "semicolon":
+			CP.Delim = ';'
+		case "space":
+			CP.Delim = ' '
+		default:
+			r, sz := utf8.DecodeRuneInString(value)
+			if sz != len(value) {
+				return found, fmt.Errorf("CsvDelim must be a single character, got %q", value)
+			}
+			CP.Delim = r
+		}
+	case "CSVINDEP":
+		CP.Indep = strings.Fields(value)
+	case "CSVDEPND":
+		CP.Depnd = strings.Fields(value)
+	case "CSVWEIGHT":
+		CP.Weight = value
+	case "CSVIGNORE":
+		CP.Ignore = strings.Fields(value)
+	case "CSVMISSING":
+		switch strings.ToLower(value) {
+		case "drop":
+			CP.Missing = MissingDrop
+		case "error":
+			CP.Missing = MissingError
+		case "impute":
+			CP.Missing = MissingImpute
+		default:
+			return found, fmt.Errorf("unknown CsvMissing policy %q", value)
+		}
+	case "CSVIMPUTE":
+		switch strings.ToLower(value) {
+		case "mean", "median", "zero":
+			CP.Impute = strings.ToLower(value)
+		default:
+			return found, fmt.Errorf("unknown CsvImpute method %q", value)
+		}
+	case "CSVNA":
+		CP.NA = strings.Fields(value)
+	default:
+		found = false
+	}
+	if err != nil {
+		log.Printf("Error parsing %s: %v\n", field, err)
+	}
+	return
+}
+
+type csvRole int
+
+const (
+	csvIgnore csvRole = iota
+	csvIndep
+	csvDepnd
+	csvWeight
+)
+
+// ReadCSV reads a delimited file with a header row, mapping the columns
+// to independent, dependent and weight values according to cp
+func ReadCSV(filename string, cp *CsvParams) (d *PointSet, err error) {
+	if cp == nil {
+		cp = NewCsvParams()
+	}
+	ftotal, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer ftotal.Close()
+
+	r := csv.NewReader(ftotal)
+	r.Comma = cp.Delim
+	if r.Comma == 0 {
+		r.Comma = ','
+		if strings.HasSuffix(strings.ToLower(filename), ".tsv") {
+			r.Comma = '\t'
+		}
+	}
+	r.Comment = '#'
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, csvError(filename, err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	roles, indepCols, depndCols, weightCol, err := cp.columnRoles(filename, header)
+	if err != nil {
+		return nil, err
+	}
+
+	na := make(map[string]bool)
+	for _, s := range cp.NA {
+		na[s] = true
+	}
+
+	d = new(PointSet)
+	d.SetFN(filename)
+	for _, c := range indepCols {
+		d.indepNames = append(d.indepNames, header[c])
+	}
+	for _, c := range depndCols {
+		d.depndNames = append(d.depndNames, header[c])
+	}
+	d.numDim = len(d.indepNames)
+
+	// entries to impute, as (point, column) pairs
+	var holes [][2]int
+	dropped := 0
+
+	indep := make([][]float64, len(indepCols))
+	depnd := make([][]float64, len(depndCols))
+	var weights []float64
+	ival := make([]float64, len(indepCols))
+	dval := make([]float64, len(depndCols))
+	NP := 0
+
+	for {
+		rec, rerr := r.Read()
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			return nil, csvError(filename, rerr)
+		}
+
+		wval := 1.0
+		missing := false
+		depMissing := false
+		var rowHoles [][2]int
+		for c, tok := range rec {
+			if roles[c] == csvIgnore {
+				continue
+			}
+			tok = strings.TrimSpace(tok)
+
+			val := math.NaN()
+			if tok != "" && !na[tok] {
+				v, perr := strconv.ParseFloat(tok, 64)
+				if perr != nil {
+					l, col := r.FieldPos(c)
+					return nil, fmt.Errorf("%s:%d:%d: column %q: cannot parse %q as a number",
+						filename, l, col, header[c], tok)
+				}
+				val = v
+			}
+
+			if math.IsNaN(val) {
+				if cp.Missing == MissingError {
+					l, col := r.FieldPos(c)
+					return nil, fmt.Errorf("%s:%d:%d: column %q: missing value",
+						filename, l, col, header[c])
+				}
+				missing = true
+				if roles[c] == csvDepnd {
+					depMissing = true
+				}
+			}
+
+			switch roles[c] {
+			case csvIndep:
+				pos := indexOf(indepCols, c)
+				ival[pos] = val
+				if math.IsNaN(val) {
+					rowHoles = append(rowHoles, [2]int{NP, pos})
+				}
+			case csvDepnd:
+				dval[indexOf(depndCols, c)] = val
+			case csvWeight:
+				if !math.IsNaN(val) {
+					wval = val
+				}
+			}
+		}
+		// targets are never imputed
+		if missing && (cp.Missing == MissingDrop || depMissing) {
+			dropped++
+			continue
+		}
+		holes = append(holes, rowHoles...)
+		for i, v := range ival {
+			indep[i] = append(indep[i], v)
+		}
+		for j, v := range dval {
+			depnd[j] = append(depnd[j], v)
+		}
+		if weightCol >= 0 {
+			weights = append(weights, wval)
+		}
+		NP++
+	}
+
+	d.SetColumns(indep, depnd)
+	d.numPoints = NP
+	if weightCol >= 0 {
+		if weights == nil {
+			weights = []float64{}
+		}
+		d.SetWeights(weights)
+	}
+
+	if cp.Missing == MissingImpute && len(holes) > 0 {
+		d.imputeIndeps(holes, cp.Impute)
+	}
+
+	Vprintf(VInfo, "Read %s: %d points, %d dropped, %d imputed  %v | %v\n",
+		filename, d.NumPoints(), dropped, len(holes), d.indepNames, d.depndNames)
+	return d, nil
+}
+
+func (cp *CsvParams) columnRoles(filename string, header []string) (roles []csvRole, indep, depnd []int, weight int, err error) {
+	col := make(map[string]int)
+	for i, h := range header {
+		col[h] = i
+	}
+	lookup := func(name string) (int, error) {
+		c, ok := col[name]
+		if !ok {
+			return -1, fmt.Errorf("%s: column %q not found in header %v", filename, name, header)
+		}
+		return c, nil
+	}
+
+	roles = make([]csvRole, len(header))
+	assigned := make([]bool, len(header))
+	assign := func(name string, role csvRole) error {
+		c, lerr := lookup(name)
+		if lerr != nil {
+			return lerr
+		}
+		if assigned[c] {
+			return fmt.Errorf("%s: column %q mapped more than once", filename, name)
+		}
+		roles[c], assigned[c] = role, true
+		return nil
+	}
+
+	weight = -1
+	for _, n := range cp.Ignore {
+		if err = assign(n, csvIgnore); err != nil {
+			return
+		}
+	}
+	for _, n := range cp.Depnd {
+		if err = assign(n, csvDepnd); err != nil {
+			return
+		}
+	}
+	if cp.Weight != "" {
+		if err = assign(cp.Weight, csvWeight); err != nil {
+			return
+		}
+		weight = col[cp.Weight]
+	}
+	for _, n := range cp.Indep {
+		if err = assign(n, csvIndep); err != nil {
+			return
+		}
+	}
+	if len(cp.Indep) == 0 {
+		for c := range header {
+			if !assigned[c] {
+				roles[c] = csvIndep
+			}
+		}
+	}
+
+	// keep the order given in the config, falling back to file order
+	if len(cp.Indep) > 0 {
+		for _, n := range cp.Indep {
+			indep = append(indep, col[n])
+		}
+	} else {
+		for c := range header {
+			if roles[c] == csvIndep {
+				indep = append(indep, c)
+			}
+		}
+	}
+	for _, n := range cp.Depnd {
+		depnd = append(depnd, col[n])
+	}
+	if len(indep) == 0 {
+		err = fmt.Errorf("%s: no independent columns", filename)
+	}
+	return
+}
+
+// fill missing independent values column-wise
+func (d *PointSet) imputeIndeps(holes [][2]int, method string) {
+	isHole := make(map[[2]int]bool)
+	for _, h := range holes {
+		isHole[h] = true
+	}
+	fill := make([]float64, d.NumIndep())
+	for i := range fill {
+		var vals []float64
+		for p, v := range d.indepCols[i] {
+			if !isHole[[2]int{p, i}] {
+				vals = append(vals, v)
+			}
+		}
+		if len(vals) == 0 {
+			continue
+		}
+		switch method {
+		case "median":
+			sort.Float64s(vals)
+			fill[i] = vals[len(vals)/2]
+			if len(vals)%2 == 0 {
+				fill[i] = (vals[len(vals)/2-1] + vals[len(vals)/2]) / 2.0
+			}
+		case "zero":
+			fill[i] = 0.0
+		default:
+			sum := 0.0
+			for _, v := range vals {
+				sum += v
+			}
+			fill[i] = sum / float64(len(vals))
+		}
+	}
+	for _, h := range holes {
+		d.indepCols[h[1]][h[0]] = fill[h[1]]
+	}
+}
+
+func csvError(filename string, err error) error {
+	if pe, ok := err.(*csv.ParseError); ok {
+		return fmt.Errorf("%s:%d:%d: %v", filename, pe.Line, pe.Column, pe.Err)
+	}
+	return fmt.Errorf("%s: %v", filename, err)
+}
+
+func indexOf(list []int, v int) int {
+	for i, x := range list {
+		if x == v {
+			return i
+		}
+	}
+	return -1
+}
@@ -4,14 +4,18 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
-	"math"
+	"io"
 	"math/rand"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 type Point struct {
-	indep []float64
-	depnd []float64
+	indep  []float64
+	depnd  []float64
+	weight float64
 }
 
 func (d *Point) NumIndep() int             { return len(d.indep) }
@@ -26,7 +30,11 @@ func (d *Point) Depnd(p int) float64       { return d.depnd[p] }
 func (d *Point) SetDepnd(p int, v float64) { d.depnd[p] = v }
 func (d *Point) Depnds() []float64         { return d.depnd }
 func (d *Point) SetDepnds(v []float64)     { d.depnd = v }
+func (d *Point) Weight() float64           { return d.weight }
+func (d *Point) SetWeight(w float64)       { d.weight = w }
 
+// PointSet stores its data column-wise, one contiguous slice per variable.
+// The row oriented Point accessors are a read-only view built on demand.
 type PointSet struct {
 	filename string
 	id       int
@@ -36,22 +44,29 @@ type PointSet struct {
 	depndNames []string
 	sysNames   []string
 
-	dataPoints []Point
-	sysVals    []float64
+	numPoints int
+	indepCols [][]float64
+	depndCols [][]float64
+	weights   []float64 // nil when unweighted
+	sysVals   []float64
+
+	comments []string          // '#' lines of the file, how it was made
+	units    map[string]string // SI unit of each variable that has one, see units.go
+
+	rows []Point // cached row view
 }
 
-func (d *PointSet) FN() string           { return d.filename }
-func (d *PointSet) SetFN(fn string)      { d.filename = fn }
-func (d *PointSet) ID() int              { return d.id }
-func (d *PointSet) SetID(id int)         { d.id = id }
-func (d *PointSet) SetNumPoints(cnt int) { d.dataPoints = make([]Point, cnt) }
+func (d *PointSet) FN() string      { return d.filename }
+func (d *PointSet) SetFN(fn string) { d.filename = fn }
+func (d *PointSet) ID() int         { return d.id }
+func (d *PointSet) SetID(id int)    { d.id = id }
 
 func (d *PointSet) NumIndep() int     { return len(d.indepNames) }
 func (d *PointSet) NumDepnd() int     { return len(d.depndNames) }
 func (d *PointSet) NumDim() int       { return d.numDim } // TODO check to see if TIME is a variable
 func (d *PointSet) SetNumDim(dim int) { d.numDim = dim }  // TODO check to see if TIME is a variable
 func (d *PointSet) NumSys() int       { return len(d.sysNames) }
-func (d *PointSet) NumPoints() int    { return len(d.dataPoints) }
+func (d *PointSet) NumPoints() int    { return d.numPoints }
 
 func (d *PointSet) IndepName(xi int) string      { return d.indepNames[xi] }
 func (d *PointSet) GetIndepNames() []string      { return d.indepNames }
@@ -64,12 +79,120 @@ func (d *PointSet) SysName(si int) string      { return d.sysNames[si] }
 func (d *PointSet) GetSysNames() []string      { return d.sysNames }
 func (d *PointSet) SetSysNames(names []string) { d.sysNames = names }
 func (d *PointSet) SetSysVals(sv []float64)    { d.sysVals = sv }
+func (d *PointSet) SysVal(p int) float64       { return d.sysVals[p] }
+func (d *PointSet) SysVals() []float64         { return d.sysVals }
+
+// column access
+func (d *PointSet) NumIndepCols() int          { return len(d.indepCols) }
+func (d *PointSet) NumDepndCols() int          { return len(d.depndCols) }
+func (d *PointSet) IndepCol(xi int) []float64  { return d.indepCols[xi] }
+func (d *PointSet) IndepCols() [][]float64     { return d.indepCols }
+func (d *PointSet) DepndCol(yi int) []float64  { return d.depndCols[yi] }
+func (d *PointSet) DepndCols() [][]float64     { return d.depndCols }
+func (d *PointSet) Weights() []float64         { return d.weights }
+func (d *PointSet) Weighted() bool             { return d.weights != nil }
+func (d *PointSet) SetWeights(w []float64)     { d.weights = w; d.rows = nil }
+func (d *PointSet) SetDepndCols(c [][]float64) { d.depndCols = c; d.rows = nil }
+func (d *PointSet) SetIndepCols(c [][]float64) { d.indepCols = c; d.rows = nil }
+func (d *PointSet) Comments() []string         { return d.comments }
+func (d *PointSet) SetComments(c []string)     { d.comments = c }
+func (d *PointSet) Unit(name string) string    { return d.units[name] }
+
+func (d *PointSet) SetUnit(name, unit string) {
+	if d.units == nil {
+		d.units = make(map[string]string)
+	}
+	d.units[name] = unit
+}
+
+// SetColumns replaces the data, all columns must have the same length
+func (d *PointSet) SetColumns(indep, depnd [][]float64) {
+	d.indepCols, d.depndCols = indep, depnd
+	d.numPoints = 0
+	if len(indep) > 0 {
+		d.numPoints = len(indep[0])
+	} else if len(depnd) > 0 {
+		d.numPoints = len(depnd[0])
+	}
+	d.rows = nil
+}
+
+func (d *PointSet) SetNumPoints(cnt int) {
+	indep := make([][]float64, d.NumIndep())
+	for i := range indep {
+		indep[i] = make([]float64, cnt)
+	}
+	depnd := make([][]float64, d.NumDepnd())
+	for i := range depnd {
+		depnd[i] = make([]float64, cnt)
+	}
+	d.SetColumns(indep, depnd)
+	d.numPoints = cnt
+}
+
+// Row gathers the independent values of point p into buf
+func (d *PointSet) Row(p int, buf []float64) []float64 {
+	buf = buf[:0]
+	for _, c := range d.indepCols {
+		buf = append(buf, c[p])
+	}
+	return buf
+}
+
+func (d *PointSet) Point(p int) *Point { return &(d.Points()[p]) }
+
+// Points returns a row view of the data, changes to it are not stored
+func (d *PointSet) Points() []Point {
+	if d.rows != nil || d.numPoints == 0 {
+		return d.rows
+	}
+	NI, ND := len(d.indepCols), len(d.depndCols)
+	W := NI + ND
+	back := make([]float64, d.numPoints*W)
+	d.rows = make([]Point, d.numPoints)
+	for p := range d.rows {
+		row := back[p*W : (p+1)*W : (p+1)*W]
+		for i, c := range d.indepCols {
+			row[i] = c[p]
+		}
+		for j, c := range d.depndCols {
+			row[NI+j] = c[p]
+		}
+		d.rows[p].indep = row[:NI:NI]
+		d.rows[p].depnd = row[NI:]
+		d.rows[p].weight = 1.0
+		if d.weights != nil {
+			d.rows[p].weight = d.weights[p]
+		}
+	}
+	return d.rows
+}
 
-func (d *PointSet) Point(p int) *Point    { return &(d.dataPoints[p]) }
-func (d *PointSet) Points() []Point       { return d.dataPoints }
-func (d *PointSet) SetPoints(pts []Point) { d.dataPoints = pts }
-func (d *PointSet) SysVal(p int) float64  { return d.sysVals[p] }
-func (d *PointSet) SysVals() []float64    { return d.sysVals }
+// SetPoints copies row oriented data into the columns
+func (d *PointSet) SetPoints(pts []Point) {
+	NI, ND := 0, 0
+	if len(pts) > 0 {
+		NI, ND = len(pts[0].indep), len(pts[0].depnd)
+	}
+	indep := make([][]float64, NI)
+	for i := range indep {
+		indep[i] = make([]float64, len(pts))
+	}
+	depnd := make([][]float64, ND)
+	for i := range depnd {
+		depnd[i] = make([]float64, len(pts))
+	}
+	for p := range pts {
+		for i := 0; i < NI; i++ {
+			indep[i][p] = pts[p].indep[i]
+		}
+		for j := 0; j < ND && j < len(pts[p].depnd); j++ {
+			depnd[j][p] = pts[p].depnd[j]
+		}
+	}
+	d.SetColumns(indep, depnd)
+	d.numPoints = len(pts)
+}
 
 // read function at end of file  [ func (d *PointSet) Read(filename string) ]
 
@@ -125,380 +248,270 @@ func (s *PntSubset) Refresh() {
 	}
 }
 
-func (d *PointSet) ReadPointSet(filename string) {
+// ReadPointSet reads a point set text file, d holds what was read
+// before any error
+func (d *PointSet) ReadPointSet(filename string) error {
 	ftotal, err := os.OpenFile(filename, os.O_RDONLY, 0)
 	if err != nil {
-		fmt.Printf("err: %v\n", err)
-		return
+		return err
 	}
 	defer ftotal.Close()
-	file := bufio.NewReader(ftotal)
-
-	var word string
 
-	// get independent variables (x_i...)
-	for i := 0; ; i++ {
-		_, err := fmt.Fscanf(file, "%s", &word)
-		if err != nil {
-			break
-		}
-		d.indepNames = append(d.indepNames, word)
+	d.filename = filename
+	if err = d.readPointSet(ftotal, filename); err != nil {
+		return err
 	}
-	d.numDim = len(d.indepNames)
+	Vprintf(VInfo, "Num Points: %v\n", d.NumPoints())
+	return nil
+}
 
-	// get dependent variables (y_j...)
-	for i := 0; ; i++ {
-		_, err := fmt.Fscanf(file, "%s", &word)
-		if err != nil {
-			break
-		}
-		d.depndNames = append(d.depndNames, word)
+func ReadBytesPointSet(ftotal []byte) (d *PointSet, err error) {
+	d = new(PointSet)
+	if err = d.readPointSet(bytes.NewReader(ftotal), "<bytes>"); err != nil {
+		return nil, err
 	}
+	Vprintf(VInfo, "Num Points: %v\n", d.NumPoints())
+	return d, nil
+}
 
-	fmt.Printf("Var Names = %v | %v\n", d.depndNames, d.indepNames)
-
-	for i := 0; ; i++ {
-		var pnt Point
-		var dval, ival float64
-		if err != nil {
-			break
-		}
-
-		for j := 0; j < len(d.indepNames); j++ {
-			_, err = fmt.Fscanf(file, "%f", &ival)
-			if err != nil {
-				break
+/* Streaming parser for the whitespace separated format
+ *
+ *   # comment        any number, anywhere, kept in Comments
+ *   $ name value     a system value, before the names
+ *   % name unit      the SI unit of a variable, before the names
+ *   x_0 x_1 ...      independent names
+ *   y_0 ...          dependent names
+ *   values, one point per line (independents then dependents)
+ *
+ * Values are appended straight into the columns.
+ */
+func (d *PointSet) readPointSet(r io.Reader, name string) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+
+	lineNum := 0
+	nextLine := func() (string, bool) {
+		for scanner.Scan() {
+			lineNum++
+			line := strings.TrimSpace(scanner.Text())
+			if strings.HasPrefix(line, "#") {
+				d.comments = append(d.comments, strings.TrimSpace(line[1:]))
+				continue
+			}
+			if len(line) > 0 {
+				return line, true
 			}
-			pnt.indep = append(pnt.indep, ival)
 		}
+		return "", false
+	}
 
-		for j := 0; j < len(d.depndNames); j++ {
-			_, err = fmt.Fscanf(file, "%f\n", &dval)
-			if err != nil {
-				break
+	line, ok := nextLine()
+	for ok && (line[0] == '$' || line[0] == '%') {
+		fields := strings.Fields(line[1:])
+		if line[0] == '%' {
+			if len(fields) < 2 {
+				return fmt.Errorf("%s:%d: want %% name unit", name, lineNum)
 			}
-			pnt.depnd = append(pnt.depnd, dval)
+			d.SetUnit(fields[0], strings.Join(fields[1:], " "))
+			line, ok = nextLine()
+			continue
 		}
-
-		if len(pnt.indep) > 0 {
-			d.dataPoints = append(d.dataPoints, pnt)
+		if len(fields) != 2 {
+			return fmt.Errorf("%s:%d: want $ name value", name, lineNum)
 		}
-		if i%100 == 0 {
-			fmt.Println("Point(%d): %v\n", i, pnt)
+		val, perr := strconv.ParseFloat(fields[1], 64)
+		if perr != nil {
+			return fmt.Errorf("%s:%d: bad system value %q", name, lineNum, fields[1])
 		}
+		d.sysNames = append(d.sysNames, fields[0])
+		d.sysVals = append(d.sysVals, val)
+		line, ok = nextLine()
 	}
-	fmt.Printf("Num Points: %v\n", len(d.dataPoints))
-}
-
-func ReadBytesPointSet(ftotal []byte) (d *PointSet) {
-	d = new(PointSet)
-	// ftotal, err := os.OpenFile(filename, os.O_RDONLY, 0)
-	// if err != nil {
-	// 	fmt.Printf("err: %v\n", err)
-	// 	return
-	// }
-	// defer ftotal.Close()
-	var err error
-	file := bytes.NewReader(ftotal)
-
-	var word string
-
-	// get independent variables (x_i...)
-	for i := 0; ; i++ {
-		_, err := fmt.Fscanf(file, "%s", &word)
-		if err != nil {
-			break
-		}
-		d.indepNames = append(d.indepNames, word)
+	if !ok {
+		return fmt.Errorf("%s: missing header", name)
 	}
+	d.indepNames = strings.Fields(line)
 	d.numDim = len(d.indepNames)
-
-	// get dependent variables (y_j...)
-	for i := 0; ; i++ {
-		_, err := fmt.Fscanf(file, "%s", &word)
-		if err != nil {
-			break
-		}
-		d.depndNames = append(d.depndNames, word)
+	line, ok = nextLine()
+	if !ok {
+		return fmt.Errorf("%s: missing dependent names", name)
 	}
+	d.depndNames = strings.Fields(line)
 
-	fmt.Printf("Var Names = %v | %v\n", d.depndNames, d.indepNames)
+	Vprintf(VIter, "Var Names = %v | %v\n", d.depndNames, d.indepNames)
 
-	for i := 0; ; i++ {
-		var pnt Point
-		var dval, ival float64
-		if err != nil {
+	NI, ND := len(d.indepNames), len(d.depndNames)
+	indep := make([][]float64, NI)
+	depnd := make([][]float64, ND)
+	for {
+		line, ok = nextLine()
+		if !ok {
 			break
 		}
-
-		for j := 0; j < len(d.indepNames); j++ {
-			_, err = fmt.Fscanf(file, "%f", &ival)
-			if err != nil {
-				break
+		col := 0
+		for len(line) > 0 && col < NI+ND {
+			// split off the next token without allocating
+			end := strings.IndexAny(line, " \t")
+			tok := line
+			if end >= 0 {
+				tok, line = line[:end], strings.TrimLeft(line[end:], " \t")
+			} else {
+				line = ""
 			}
-			pnt.indep = append(pnt.indep, ival)
-		}
-
-		for j := 0; j < len(d.depndNames); j++ {
-			_, err = fmt.Fscanf(file, "%f\n", &dval)
-			if err != nil {
-				break
+			val, perr := strconv.ParseFloat(tok, 64)
+			if perr != nil {
+				d.SetColumns(indep, depnd)
+				return fmt.Errorf("%s:%d:%d: bad value %q", name, lineNum, col+1, tok)
 			}
-			pnt.depnd = append(pnt.depnd, dval)
-		}
-
-		if len(pnt.indep) > 0 {
-			d.dataPoints = append(d.dataPoints, pnt)
+			if col < NI {
+				indep[col] = append(indep[col], val)
+			} else {
+				depnd[col-NI] = append(depnd[col-NI], val)
+			}
+			col++
 		}
-		if i%100 == 0 {
-			fmt.Println("Point(%d): %v\n", i, pnt)
+		if col != NI+ND {
+			// keep the columns rectangular
+			for c := 0; c < col; c++ {
+				if c < NI {
+					indep[c] = indep[c][:len(indep[c])-1]
+				} else {
+					depnd[c-NI] = depnd[c-NI][:len(depnd[c-NI])-1]
+				}
+			}
+			d.SetColumns(indep, depnd)
+			return fmt.Errorf("%s:%d: expected %d values, found %d", name, lineNum, NI+ND, col)
 		}
 	}
-	fmt.Printf("Num Points: %v\n", len(d.dataPoints))
-	return d
+	d.SetColumns(indep, depnd)
+	return scanner.Err()
 }
 
-func (d *PointSet) WritePointSet(filename string) {
-	fmt.Printf("Writing file: %s\n", filename)
+func (d *PointSet) WritePointSet(filename string) (err error) {
+	Vprintf(VIter, "Writing file: %s\n", filename)
 	ftotal, err := os.Create(filename)
 	if err != nil {
-		fmt.Printf("Error creating file: %s  %v\n", filename, err)
-		return
+		return err
 	}
-	defer ftotal.Close()
+	defer func() {
+		if cerr := ftotal.Close(); err == nil {
+			err = cerr
+		}
+	}()
 	file := bufio.NewWriter(ftotal)
-	defer file.Flush()
+
+	for _, c := range d.comments {
+		fmt.Fprintf(file, "# %s\n", c)
+	}
+	for i, n := range d.sysNames {
+		fmt.Fprintf(file, "$ %s %s\n", n, strconv.FormatFloat(d.sysVals[i], 'g', -1, 64))
+	}
+	for _, n := range d.unitNames() {
+		fmt.Fprintf(file, "%% %s %s\n", n, d.units[n])
+	}
 
 	// write independent variable names (x_i...)
 	for i := 0; i < d.NumIndep(); i++ {
-		_, err := fmt.Fprintf(file, "%s ", d.IndepName(i))
-		if err != nil {
-			fmt.Errorf("error writing pointset to file: %v\n", err)
-			break
-		}
+		fmt.Fprintf(file, "%s ", d.IndepName(i))
 	}
 	fmt.Fprintln(file)
 
-	// trite dependent variable names (y_j...)
+	// write dependent variable names (y_j...)
 	for i := 0; i < d.NumDepnd(); i++ {
-		_, err := fmt.Fprintf(file, "%s ", d.DepndName(i))
-		if err != nil {
-			break
-		}
+		fmt.Fprintf(file, "%s ", d.DepndName(i))
 	}
 	fmt.Fprintln(file)
 
-	// write points 
-	points := d.Points()
-	for i := 0; i < d.NumPoints(); i++ {
-		indep := points[i].Indeps()
-		depnd := points[i].Depnds()
-		for j := 0; j < len(indep); j++ {
-			_, err = fmt.Fprintf(file, "%f ", indep[j])
-			if err != nil {
-				break
-			}
+	// write points, in the shortest form that reads back exactly
+	buf := make([]byte, 0, 64)
+	for p := 0; p < d.NumPoints(); p++ {
+		for _, c := range d.indepCols {
+			buf = strconv.AppendFloat(buf[:0], c[p], 'g', -1, 64)
+			file.Write(buf)
+			file.WriteByte(' ')
 		}
-		for j := 0; j < len(depnd); j++ {
-			_, err = fmt.Fprintf(file, "%f ", depnd[j])
-			if err != nil {
-				break
-			}
+		for _, c := range d.depndCols {
+			buf = strconv.AppendFloat(buf[:0], c[p], 'g', -1, 64)
+			file.Write(buf)
+			file.WriteByte(' ')
 		}
-		fmt.Fprintln(file)
-	}
-}
-
-func SplitPointSetTrainTest(pnts *PointSet, pcnt_train float64, seed int) (train, test *PointSet) {
-
-	train = new(PointSet)
-	test = new(PointSet)
-
-	train.filename, test.filename = pnts.filename, pnts.filename
-	train.id, test.id = pnts.id, pnts.id
-	train.indepNames, test.indepNames = pnts.indepNames, pnts.indepNames
-	train.depndNames, test.depndNames = pnts.depndNames, pnts.depndNames
-	train.sysNames, test.sysNames = pnts.sysNames, pnts.sysNames
-	train.sysVals, test.sysVals = pnts.sysVals, pnts.sysVals
-
-	L := len(pnts.dataPoints)
-	Tst := int(float64(L) * (1.0 - pcnt_train))
-
-	tmp := make([]Point, L)
-	copy(tmp, pnts.dataPoints)
-
-	rand.Seed(int64(seed))
-
-	for i := 0; i < Tst; i++ {
-		p := rand.Intn(L - i)
-		tmp[i], tmp[p] = tmp[p], tmp[i]
-	}
-
-	test.dataPoints = tmp[:Tst]
-	train.dataPoints = tmp[Tst:]
-
-	return
-}
-
-func (d *PointSet) ReadLakeFile(filename string) {
-	ftotal, err := os.OpenFile(filename, os.O_RDONLY, 0)
-	if err != nil {
-		fmt.Printf("err: %v\n", err)
-		return
-	}
-	defer ftotal.Close()
-	file := bufio.NewReader(ftotal)
-
-	var word string
-
-	// get independent variables (x_i...)
-	for i := 0; ; i++ {
-		_, err := fmt.Fscanf(file, "%s", &word)
-		if err != nil {
-			break
+		if _, err = file.WriteString("\n"); err != nil {
+			return fmt.Errorf("writing %s: %v", filename, err)
 		}
-		d.indepNames = append(d.indepNames, word)
 	}
-	d.numDim = len(d.indepNames)
-
-	// // get dependent variables (y_j...)
-	// for i := 0; ; i++ {
-	// 	_, err := fmt.Fscanf(file, "%s", &word)
-	// 	if err != nil {
-	// 		break
-	// 	}
-	// 	d.depndNames = append(d.depndNames, word)
-	// }
-
-	// remove time names from indepNames
-	d.indepNames = d.indepNames[2:]
-
-	// fmt.Printf("Var Names = %v | %v\n", d.depndNames, d.indepNames)
-
-	for i := 0; ; i++ {
-		var pnt Point
-		var ival float64
-		if err != nil {
-			break
-		}
-
-		// read time values and disgaurd
-		var dummy string
-		for j := 0; j < 2; j++ {
-			_, err = fmt.Fscanf(file, "%s", &dummy)
-			// fmt.Println(i, dummy)
-			if err != nil {
-				// fmt.Println("err:", err)
-				break
-			}
-		}
-
-		// append dummy time value
-		pnt.indep = append(pnt.indep, -0.1)
+	return file.Flush()
+}
 
-		// read real data
-		for j := 0; j < len(d.indepNames); j++ {
-			_, err = fmt.Fscanf(file, "%f\n", &ival)
-			if err != nil {
-				// fmt.Println("err:", err)
-				break
+// the variables with units, in column order
+func (d *PointSet) unitNames() []string {
+	var names []string
+	for _, list := range [][]string{d.indepNames, d.depndNames} {
+		for _, n := range list {
+			if _, ok := d.units[n]; ok {
+				names = append(names, n)
 			}
-			// hack for PAR
-			// if j == 1 {
-			// 	ival /= 2000.0 * (math.Pi / 2.0)
-			// }
-
-			pnt.indep = append(pnt.indep, ival)
 		}
+	}
+	return names
+}
 
-		// fmt.Println(i, pnt)
-
-		// for j := 0; j < len(d.depndNames); j++ {
-		// 	_, err = fmt.Fscanf(file, "%f\n", &dval)
-		// 	if err != nil {
-		// 		break
-		// 	}
-		// 	pnt.depnd = append(pnt.depnd, dval)
-		// }
-
-		if len(pnt.indep) > 1 {
-			for p := 0; p < len(pnt.indep); p++ {
-				if math.IsNaN(pnt.indep[p]) {
-					// fmt.Println("NaN @ ", i, p)
-					goto skip
-				}
+// gather the points at idx into a new set sharing the metadata
+func (d *PointSet) subset(idx []int) *PointSet {
+	s := new(PointSet)
+	s.filename, s.id, s.numDim = d.filename, d.id, d.numDim
+	s.comments, s.units = d.comments, d.units
+	s.indepNames, s.depndNames = d.indepNames, d.depndNames
+	s.sysNames, s.sysVals = d.sysNames, d.sysVals
+
+	gather := func(cols [][]float64) [][]float64 {
+		out := make([][]float64, len(cols))
+		for i, c := range cols {
+			out[i] = make([]float64, len(idx))
+			for k, p := range idx {
+				out[i][k] = c[p]
 			}
-			d.dataPoints = append(d.dataPoints, pnt)
 		}
-	skip:
+		return out
 	}
-
-	// calculate numerical derivatives
-	calcDerivs(d.dataPoints)
-
-	for i := 0; i < len(d.dataPoints); i++ {
-		p := d.dataPoints[i]
-		if len(p.Indeps()) == 0 || len(p.Depnds()) == 0 {
-			fmt.Println("Bad Point @", i)
-		}
-		// if i%100 == 0 {
-		// 	fmt.Printf("Point(%d): %v\n", i, d.dataPoints[i])
-		// }
+	s.SetColumns(gather(d.indepCols), gather(d.depndCols))
+	s.numPoints = len(idx)
+	if d.weights != nil {
+		s.weights = gather([][]float64{d.weights})[0]
 	}
+	return s
+}
 
-	// fmt.Printf("Num Points: %v\n", len(d.dataPoints))
+// Subsample draws n points at random without replacement, keeping
+// their original order. Returns d itself when n covers the whole set.
+func (d *PointSet) Subsample(n int, rng *rand.Rand) *PointSet {
+	L := d.NumPoints()
+	if n >= L {
+		return d
+	}
+	idx := rng.Perm(L)[:n]
+	sort.Ints(idx)
+	return d.subset(idx)
 }
 
-/* Calculate the first derivative of four points with: h = 0.25
- * (from: http://www.trentfguidry.net/post/2010/09/04/Numerical-differentiation-formulas.aspx)
- *
- * xF0 = ( -3.0*xF4 + 16.0*xF3 - 36.0*xF2 + 48.0*xF1 - 25.0*xF0) / (12.0*h)
- * xF1 = (      xF4 -  6.0-xF3 + 18.0*xF2 - 10.0*xF1 -  3.0*xF0) / (12.0*h)
- * xF2 = (     -xF4 +  8.0*xF3           -   8.0*xF1 +      xF0) / (12.0*h)
- * xF3 = (  3.0*xF4 + 10.0*xF3 - 18.0*xF2 +  6.0*xF1 -      xF0) / (12.0*h)
- * xF4 = ( 25.0*xF4 - 48.0*xF3 + 36.0*xF2 - 16.0*xF1 +  3.0*xF0) / (12.0*h)
- */
-func calcDerivs(pts []Point) {
-	h := 24.0 // / (24.0 * 60.0)
+func SplitPointSetTrainTest(pnts *PointSet, pcnt_train float64, seed int) (train, test *PointSet) {
 
-	NP := len(pts)
-	ND := pts[0].NumIndep()
+	L := pnts.NumPoints()
+	Tst := int(float64(L) * (1.0 - pcnt_train))
 
-	// for summing and averaging on a point/variable~wise basis
-	cnts := make([][]int, NP)
-	vals := make([][]float64, NP)
-	for p := 0; p < NP; p++ {
-		cnts[p] = make([]int, ND)
-		vals[p] = make([]float64, ND)
+	tmp := make([]int, L)
+	for i := range tmp {
+		tmp[i] = i
 	}
 
-	for p := 0; p < NP-5; p++ {
-		for i := 0; i < ND; i++ {
-			var F [5]float64
-			var dF [5]float64
-
-			for j := 0; j < 5; j++ {
-				F[j] = pts[p+j].Indep(i)
-			}
-			dF[0] = (-3.0*F[4] + 16.0*F[3] - 36.0*F[2] + 48.0*F[1] - 25.0*F[0]) / (12.0 * h)
-			dF[1] = (F[4] - 6.0 - F[3] + 18.0*F[2] - 10.0*F[1] - 3.0*F[0]) / (12.0 * h)
-			dF[2] = (-F[4] + 8.0*F[3] - 8.0*F[1] + F[0]) / (12.0 * h)
-			dF[3] = (3.0*F[4] + 10.0*F[3] - 18.0*F[2] + 6.0*F[1] - F[0]) / (12.0 * h)
-			dF[4] = (25.0*F[4] - 48.0*F[3] + 36.0*F[2] - 16.0*F[1] + 3.0*F[0]) / (12.0 * h)
-
-			for j := 0; j < 5; j++ {
+	rand.Seed(int64(seed))
 
-				vals[p+j][i] += dF[j]
-				cnts[p+j][i]++
-			}
-		}
+	for i := 0; i < Tst; i++ {
+		p := rand.Intn(L - i)
+		tmp[i], tmp[p] = tmp[p], tmp[i]
 	}
 
-	for p := 0; p < NP; p++ {
-		depnds := make([]float64, ND)
-		for i := 0; i < ND; i++ {
-			depnds[i] = vals[p][i] / float64(cnts[p][i])
-		}
-		pts[p].SetDepnds(depnds)
-	}
+	test = pnts.subset(tmp[:Tst])
+	train = pnts.subset(tmp[Tst:])
+
+	return
 }
@@ -0,0 +1,301 @@
+package problems
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+)
+
+/* Binary point set cache
+ *
+ * Written next to the text file as <filename>.pgec and reused while
+ * the size and modification time of the text file are unchanged.
+ *
+ *   "PGEC" version:u32
+ *   srcSize:i64 srcModTime:i64 (unix nanoseconds)
+ *   numDim:u32 numPoints:u64 weighted:u8
+ *   names: count:u32 then (len:u32 bytes) for indep, depnd, sys
+ *   system values: one float64 per sys name
+ *   units: names, then units, of the variables with units
+ *   comments: the '#' lines of the text file, as names
+ *   columns: numPoints float64 each, indep then depnd then weights
+ *
+ * All values are little-endian.
+ */
+
+const (
+	cacheMagic   = "PGEC"
+	cacheVersion = 4
+	CacheSuffix  = ".pgec"
+)
+
+var errStaleCache = errors.New("stale cache")
+
+// LoadPointSet reads a point set text file, going through the binary
+// cache when useCache is set. A missing or stale cache is rebuilt.
+func LoadPointSet(filename string, useCache bool) (*PointSet, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	cfn := filename + CacheSuffix
+
+	if useCache {
+		d, cerr := readPointSetCache(cfn, info)
+		if cerr == nil {
+			d.filename = filename
+			Vprintf(VInfo, "Read cache %s: %d points\n", cfn, d.NumPoints())
+			return d, nil
+		}
+		if !os.IsNotExist(cerr) {
+			Vprintf(VQuiet, "ignoring cache %s: %v\n", cfn, cerr)
+		}
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	d := new(PointSet)
+	if err = d.readPointSet(f, filename); err != nil {
+		return nil, err
+	}
+	d.filename = filename
+	Vprintf(VInfo, "Num Points: %v\n", d.NumPoints())
+
+	if useCache {
+		if werr := d.writePointSetCache(cfn, info); werr != nil {
+			Vprintf(VQuiet, "error writing cache %s: %v\n", cfn, werr)
+		}
+	}
+	return d, nil
+}
+
+func (d *PointSet) writePointSetCache(cfn string, src os.FileInfo) (err error) {
+	// write to a temporary name so a concurrent reader never sees half a file
+	tmp := cfn + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	le := binary.LittleEndian
+
+	put := func(v interface{}) {
+		if err == nil {
+			err = binary.Write(w, le, v)
+		}
+	}
+	putNames := func(names []string) {
+		put(uint32(len(names)))
+		for _, n := range names {
+			put(uint32(len(n)))
+			if err == nil {
+				_, err = w.WriteString(n)
+			}
+		}
+	}
+	putCol := func(c []float64) {
+		var buf [8]byte
+		for _, v := range c {
+			if err != nil {
+				return
+			}
+			le.PutUint64(buf[:], math.Float64bits(v))
+			_, err = w.Write(buf[:])
+		}
+	}
+
+	w.WriteString(cacheMagic)
+	put(uint32(cacheVersion))
+	put(src.Size())
+	put(src.ModTime().UnixNano())
+	put(uint32(d.numDim))
+	put(uint64(d.numPoints))
+	weighted := uint8(0)
+	if d.Weighted() {
+		weighted = 1
+	}
+	put(weighted)
+	putNames(d.indepNames)
+	putNames(d.depndNames)
+	putNames(d.sysNames)
+	putCol(d.sysVals)
+	unitNames := d.unitNames()
+	units := make([]string, len(unitNames))
+	for i, n := range unitNames {
+		units[i] = d.units[n]
+	}
+	putNames(unitNames)
+	putNames(units)
+	putNames(d.comments)
+	for _, c := range d.indepCols {
+		putCol(c)
+	}
+	for _, c := range d.depndCols {
+		putCol(c)
+	}
+	if d.Weighted() {
+		putCol(d.weights)
+	}
+
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, cfn)
+}
+
+func readPointSetCache(cfn string, src os.FileInfo) (d *PointSet, err error) {
+	f, err := os.Open(cfn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	r := bufio.NewReaderSize(f, 1024*1024)
+	le := binary.LittleEndian
+
+	// a count is checked against what is left of the file before anything
+	// is allocated for it, so a corrupt cache can't ask for gigabytes
+	left := uint64(info.Size())
+	need := func(n uint64) bool {
+		if err == nil && n > left {
+			err = fmt.Errorf("corrupt cache: %d bytes wanted, %d left", n, left)
+		}
+		if err != nil {
+			return false
+		}
+		left -= n
+		return true
+	}
+
+	get := func(v interface{}) {
+		if need(uint64(binary.Size(v))) {
+			err = binary.Read(r, le, v)
+		}
+	}
+	getNames := func() []string {
+		var cnt uint32
+		get(&cnt)
+		// every name takes at least its length
+		if err != nil || uint64(cnt)*4 > left {
+			need(uint64(cnt) * 4)
+			return nil
+		}
+		names := make([]string, cnt)
+		for i := range names {
+			var l uint32
+			get(&l)
+			if !need(uint64(l)) {
+				return nil
+			}
+			b := make([]byte, l)
+			if _, err = io.ReadFull(r, b); err != nil {
+				return nil
+			}
+			names[i] = string(b)
+		}
+		return names
+	}
+	getCol := func(n int) []float64 {
+		if !need(uint64(n) * 8) {
+			return nil
+		}
+		c := make([]float64, n)
+		var buf [8]byte
+		for i := range c {
+			if _, err = io.ReadFull(r, buf[:]); err != nil {
+				return nil
+			}
+			c[i] = math.Float64frombits(le.Uint64(buf[:]))
+		}
+		return c
+	}
+
+	magic := make([]byte, len(cacheMagic))
+	if !need(uint64(len(magic))) {
+		return nil, err
+	}
+	if _, err = io.ReadFull(r, magic); err != nil {
+		return nil, err
+	}
+	if string(magic) != cacheMagic {
+		return nil, fmt.Errorf("bad magic %q", magic)
+	}
+	var version uint32
+	var size, mtime int64
+	get(&version)
+	get(&size)
+	get(&mtime)
+	if err != nil {
+		return nil, err
+	}
+	if version != cacheVersion {
+		return nil, fmt.Errorf("unsupported cache version %d", version)
+	}
+	if size != src.Size() || mtime != src.ModTime().UnixNano() {
+		return nil, errStaleCache
+	}
+
+	var numDim uint32
+	var numPoints uint64
+	var weighted uint8
+	get(&numDim)
+	get(&numPoints)
+	get(&weighted)
+	if err == nil && numPoints > left/8 {
+		err = fmt.Errorf("corrupt cache: %d points in %d bytes", numPoints, left)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d = new(PointSet)
+	d.numDim = int(numDim)
+	d.indepNames = getNames()
+	d.depndNames = getNames()
+	d.sysNames = getNames()
+	d.sysVals = getCol(len(d.sysNames))
+	unitNames, units := getNames(), getNames()
+	d.comments = getNames()
+	for i, n := range unitNames {
+		if i < len(units) {
+			d.SetUnit(n, units[i])
+		}
+	}
+	NP := int(numPoints)
+	indep := make([][]float64, len(d.indepNames))
+	for i := range indep {
+		indep[i] = getCol(NP)
+	}
+	depnd := make([][]float64, len(d.depndNames))
+	for i := range depnd {
+		depnd[i] = getCol(NP)
+	}
+	var weights []float64
+	if weighted != 0 {
+		weights = getCol(NP)
+	}
+	if err != nil {
+		return nil, err
+	}
+	d.SetColumns(indep, depnd)
+	d.numPoints = NP
+	d.weights = weights
+	return d, nil
+}
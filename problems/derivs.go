@@ -0,0 +1,704 @@
+package problems
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type DerivMethod int
+
+const (
+	DerivNone DerivMethod = iota
+	DerivFiniteDiff
+	DerivSavGol
+	DerivTotalVar
+	DerivSpline
+)
+
+func (dm DerivMethod) String() string {
+	switch dm {
+	case DerivNone:
+		return "none"
+	case DerivFiniteDiff:
+		return "fd"
+	case DerivSavGol:
+		return "savgol"
+	case DerivTotalVar:
+		return "tvr"
+	case DerivSpline:
+		return "spline"
+	}
+	return "unknown"
+}
+
+func DerivMethodFromString(method string) (DerivMethod, bool) {
+	switch strings.ToLower(method) {
+	case "none":
+		return DerivNone, true
+	case "fd", "finitediff":
+		return DerivFiniteDiff, true
+	case "savgol", "savitzkygolay":
+		return DerivSavGol, true
+	case "tvr", "totalvariation":
+		return DerivTotalVar, true
+	case "spline":
+		return DerivSpline, true
+	}
+	return DerivNone, false
+}
+
+// options for estimating time derivatives from sampled data
+type DerivParams struct {
+	Method DerivMethod
+
+	// finite differences: order of accuracy (stencil uses Order+1 points)
+	Order int
+
+	// Savitzky-Golay: window length (points) and polynomial order
+	Window    int
+	PolyOrder int
+
+	// total variation regularization: weight and outer iterations
+	Alpha float64
+	Iters int
+
+	// smoothing spline: roughness penalty (0 is the interpolating spline)
+	Smooth float64
+
+	// index of the time column among the independents (-1 for none)
+	// and the fixed spacing used when there is no usable time column,
+	// DefaultTimeStep unless the config sets DerivTimeStep
+	TimeVar  int
+	TimeStep float64
+}
+
+// DefaultTimeStep is the spacing of points without a usable time column,
+// the 24.0 the derivatives always used before DerivTimeStep existed
+const DefaultTimeStep = 24.0
+
+func NewDerivParams() *DerivParams {
+	dp := new(DerivParams)
+	dp.Method = DerivFiniteDiff
+	dp.Order = 4
+	dp.Window = 7
+	dp.PolyOrder = 2
+	dp.Alpha = 0.1
+	dp.Iters = 20
+	dp.TimeVar = 0
+	dp.TimeStep = DefaultTimeStep
+	return dp
+}
+
+func ParseDerivParams(field, value string, config interface{}) (found bool, err error) {
+
+	DP := config.(*DerivParams)
+	found = true
+
+	switch strings.ToUpper(field) {
+	case "DERIVMETHOD":
+		method, ok := DerivMethodFromString(value)
+		if !ok {
+			log.Printf("Unknown DerivMethod: %s\n", value)
+			return found, fmt.Errorf("unknown DerivMethod %q", value)
+		}
+		DP.Method = method
+	case "DERIVORDER":
+		DP.Order, err = strconv.Atoi(value)
+		if err == nil && (DP.Order < 1 || DP.Order > 8) {
+			err = fmt.Errorf("DerivOrder must be in [1,8], got %d", DP.Order)
+		}
+	case "DERIVWINDOW":
+		DP.Window, err = strconv.Atoi(value)
+	case "DERIVPOLYORDER":
+		DP.PolyOrder, err = strconv.Atoi(value)
+	case "DERIVALPHA":
+		DP.Alpha, err = strconv.ParseFloat(value, 64)
+	case "DERIVITERS":
+		DP.Iters, err = strconv.Atoi(value)
+	case "DERIVSMOOTH":
+		DP.Smooth, err = strconv.ParseFloat(value, 64)
+	case "DERIVTIMEVAR":
+		DP.TimeVar, err = strconv.Atoi(value)
+	case "DERIVTIMESTEP": // spacing without a time column, default DefaultTimeStep
+		DP.TimeStep, err = strconv.ParseFloat(value, 64)
+		if err == nil && DP.TimeStep <= 0.0 {
+			err = fmt.Errorf("DerivTimeStep must be positive, got %f", DP.TimeStep)
+		}
+	default:
+		found = false
+	}
+	if err != nil {
+		log.Printf("Error parsing %s: %v\n", field, err)
+	}
+	return
+}
+
+// diagnostics from estimating the derivative of a single variable
+type DerivDiag struct {
+	Name   string
+	Method DerivMethod
+	Points int
+
+	// sample spacing and where it came from
+	TimeFromData              bool
+	MinStep, MaxStep, AveStep float64
+
+	// rms of (smoothed - raw) signal, zero for pure differencing
+	FitRMS float64
+	// rms of the second difference of the derivative (noise indicator)
+	Roughness float64
+	NaNs      int
+}
+
+func (dd DerivDiag) String() string {
+	src := "fixed"
+	if dd.TimeFromData {
+		src = "data"
+	}
+	return fmt.Sprintf("%-12s %-6s pts: %5d  dt(%s): [%g, %g] ave %g  fitRMS: %g  rough: %g  NaNs: %d",
+		dd.Name, dd.Method, dd.Points, src, dd.MinStep, dd.MaxStep, dd.AveStep,
+		dd.FitRMS, dd.Roughness, dd.NaNs)
+}
+
+// CalcDerivs estimates d(x_i)/dt for every independent variable and stores
+// the results as the dependent values of each point
+func (d *PointSet) CalcDerivs(dp *DerivParams) (diags []DerivDiag) {
+	if dp == nil {
+		dp = NewDerivParams()
+	}
+	NP := d.NumPoints()
+	if NP == 0 || dp.Method == DerivNone {
+		return nil
+	}
+	ND := d.NumIndepCols()
+
+	times, fromData := d.derivTimes(dp)
+
+	derivs := make([][]float64, ND)
+	diags = make([]DerivDiag, ND)
+	for i := 0; i < ND; i++ {
+		col := d.IndepCol(i)
+
+		var dx, smooth []float64
+		switch dp.Method {
+		case DerivFiniteDiff:
+			dx = derivFiniteDiff(times, col, dp.Order)
+		case DerivSavGol:
+			dx, smooth = derivSavGol(times, col, dp.Window, dp.PolyOrder)
+		case DerivTotalVar:
+			dx, smooth = derivTotalVar(times, col, dp.Alpha, dp.Iters)
+		case DerivSpline:
+			dx, smooth = derivSpline(times, col, dp.Smooth)
+		}
+		derivs[i] = dx
+
+		name := fmt.Sprintf("x_%d", i)
+		if i < len(d.indepNames) {
+			name = d.indepNames[i]
+		}
+		diags[i] = derivDiagnostics(name, dp.Method, times, col, dx, smooth)
+		diags[i].TimeFromData = fromData
+	}
+
+	d.SetDepndCols(derivs)
+
+	if len(d.depndNames) == 0 {
+		d.depndNames = make([]string, ND)
+		for i := 0; i < ND; i++ {
+			d.depndNames[i] = "d(" + diags[i].Name + ")"
+		}
+	}
+	return diags
+}
+
+// use the time column if it is strictly increasing, otherwise a uniform grid
+func (d *PointSet) derivTimes(dp *DerivParams) (times []float64, fromData bool) {
+	NP := d.NumPoints()
+	times = make([]float64, NP)
+	if dp.TimeVar >= 0 && dp.TimeVar < d.NumIndepCols() {
+		fromData = true
+		tcol := d.IndepCol(dp.TimeVar)
+		for p := 0; p < NP; p++ {
+			times[p] = tcol[p]
+			if p > 0 && !(times[p] > times[p-1]) {
+				fromData = false
+				break
+			}
+		}
+	}
+	if !fromData {
+		for p := 0; p < NP; p++ {
+			times[p] = float64(p) * dp.TimeStep
+		}
+	}
+	return
+}
+
+func derivDiagnostics(name string, method DerivMethod, t, x, dx, smooth []float64) (dd DerivDiag) {
+	dd.Name = name
+	dd.Method = method
+	dd.Points = len(t)
+	dd.MinStep = math.Inf(1)
+	for p := 1; p < len(t); p++ {
+		h := t[p] - t[p-1]
+		dd.MinStep = math.Min(dd.MinStep, h)
+		dd.MaxStep = math.Max(dd.MaxStep, h)
+	}
+	if len(t) > 1 {
+		dd.AveStep = (t[len(t)-1] - t[0]) / float64(len(t)-1)
+	} else {
+		dd.MinStep = 0.0
+	}
+
+	if smooth != nil {
+		sum := 0.0
+		for p := range x {
+			r := smooth[p] - x[p]
+			sum += r * r
+		}
+		dd.FitRMS = math.Sqrt(sum / float64(len(x)))
+	}
+
+	sum, cnt := 0.0, 0
+	for p := range dx {
+		if math.IsNaN(dx[p]) || math.IsInf(dx[p], 0) {
+			dd.NaNs++
+			continue
+		}
+		if p > 0 && p < len(dx)-1 {
+			r := dx[p+1] - 2.0*dx[p] + dx[p-1]
+			if !math.IsNaN(r) && !math.IsInf(r, 0) {
+				sum += r * r
+				cnt++
+			}
+		}
+	}
+	if cnt > 0 {
+		dd.Roughness = math.Sqrt(sum / float64(cnt))
+	}
+	return
+}
+
+/* Finite differences of arbitrary order on (possibly) non-uniform samples.
+ * Weights come from Fornberg's algorithm over a stencil of order+1 points
+ * centered on the current point where possible (one-sided at the ends).
+ * On a uniform grid with order 4 this reproduces the classic five point
+ * formulas, e.g. the centered one:
+ *
+ * xF2 = ( -xF4 + 8.0*xF3 - 8.0*xF1 + xF0 ) / (12.0*h)
+ */
+func derivFiniteDiff(t, x []float64, order int) []float64 {
+	N := len(t)
+	dx := make([]float64, N)
+	S := order + 1
+	if S > N {
+		S = N
+	}
+	if S < 2 {
+		return dx
+	}
+	w := make([]float64, S)
+	for p := 0; p < N; p++ {
+		lo := p - S/2
+		if lo < 0 {
+			lo = 0
+		}
+		if lo+S > N {
+			lo = N - S
+		}
+		fornbergWeights(t[p], t[lo:lo+S], w)
+		sum := 0.0
+		for j := 0; j < S; j++ {
+			sum += w[j] * x[lo+j]
+		}
+		dx[p] = sum
+	}
+	return dx
+}
+
+// first derivative weights at z for nodes xs (Fornberg 1988)
+func fornbergWeights(z float64, xs []float64, w []float64) {
+	n := len(xs)
+	// c[j][k]: weight of node j for the k-th derivative, k in {0,1}
+	c := make([][2]float64, n)
+	c1 := 1.0
+	c4 := xs[0] - z
+	c[0][0] = 1.0
+	for i := 1; i < n; i++ {
+		mn := i
+		if mn > 1 {
+			mn = 1
+		}
+		c2 := 1.0
+		c5 := c4
+		c4 = xs[i] - z
+		for j := 0; j < i; j++ {
+			c3 := xs[i] - xs[j]
+			c2 *= c3
+			if j == i-1 {
+				for k := mn; k >= 1; k-- {
+					c[i][k] = c1 * (float64(k)*c[i-1][k-1] - c5*c[i-1][k]) / c2
+				}
+				c[i][0] = -c1 * c5 * c[i-1][0] / c2
+			}
+			for k := mn; k >= 1; k-- {
+				c[j][k] = (c4*c[j][k] - float64(k)*c[j][k-1]) / c3
+			}
+			c[j][0] = c4 * c[j][0] / c3
+		}
+		c1 = c2
+	}
+	for j := 0; j < n; j++ {
+		w[j] = c[j][1]
+	}
+}
+
+// Savitzky-Golay: least squares polynomial over a sliding window,
+// fit in the real sample times so irregular spacing is handled
+func derivSavGol(t, x []float64, window, poly int) (dx, smooth []float64) {
+	N := len(t)
+	dx = make([]float64, N)
+	smooth = make([]float64, N)
+	if window > N {
+		window = N
+	}
+	if poly >= window {
+		poly = window - 1
+	}
+	if poly < 1 || window < 2 {
+		copy(smooth, x)
+		return
+	}
+
+	M := poly + 1
+	ata := make([][]float64, M)
+	for i := range ata {
+		ata[i] = make([]float64, M)
+	}
+	atb := make([]float64, M)
+	pw := make([]float64, 2*M)
+
+	for p := 0; p < N; p++ {
+		lo := p - window/2
+		if lo < 0 {
+			lo = 0
+		}
+		if lo+window > N {
+			lo = N - window
+		}
+		// scale times for conditioning
+		scale := (t[lo+window-1] - t[lo]) / 2.0
+		if scale <= 0.0 {
+			scale = 1.0
+		}
+
+		for i := 0; i < M; i++ {
+			atb[i] = 0.0
+			for j := 0; j < M; j++ {
+				ata[i][j] = 0.0
+			}
+		}
+		for j := lo; j < lo+window; j++ {
+			s := (t[j] - t[p]) / scale
+			pw[0] = 1.0
+			for k := 1; k < 2*M; k++ {
+				pw[k] = pw[k-1] * s
+			}
+			for r := 0; r < M; r++ {
+				atb[r] += pw[r] * x[j]
+				for c := 0; c < M; c++ {
+					ata[r][c] += pw[r+c]
+				}
+			}
+		}
+		coeff := solveDense(ata, atb)
+		smooth[p] = coeff[0]
+		dx[p] = coeff[1] / scale
+	}
+	return
+}
+
+// Gaussian elimination with partial pivoting, A and b are overwritten
+func solveDense(A [][]float64, b []float64) []float64 {
+	n := len(b)
+	for c := 0; c < n; c++ {
+		piv := c
+		for r := c + 1; r < n; r++ {
+			if math.Abs(A[r][c]) > math.Abs(A[piv][c]) {
+				piv = r
+			}
+		}
+		A[c], A[piv] = A[piv], A[c]
+		b[c], b[piv] = b[piv], b[c]
+		if A[c][c] == 0.0 {
+			continue
+		}
+		for r := c + 1; r < n; r++ {
+			f := A[r][c] / A[c][c]
+			for k := c; k < n; k++ {
+				A[r][k] -= f * A[c][k]
+			}
+			b[r] -= f * b[c]
+		}
+	}
+	x := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		sum := b[r]
+		for k := r + 1; k < n; k++ {
+			sum -= A[r][k] * x[k]
+		}
+		if A[r][r] != 0.0 {
+			x[r] = sum / A[r][r]
+		}
+	}
+	return x
+}
+
+/* Total variation regularized differentiation (Chartrand 2011).
+ * Find u minimizing  alpha*TV(u) + 0.5*|| A u - (x - x0) ||^2
+ * where A is trapezoidal integration over the sample times, using lagged
+ * diffusivity iterations with a conjugate gradient inner solve.
+ */
+func derivTotalVar(t, x []float64, alpha float64, iters int) (dx, smooth []float64) {
+	N := len(t)
+	if N < 3 {
+		return derivFiniteDiff(t, x, 1), append([]float64(nil), x...)
+	}
+	const eps = 1e-8
+
+	dt := make([]float64, N-1)
+	for j := range dt {
+		dt[j] = t[j+1] - t[j]
+	}
+	b := make([]float64, N)
+	for i := range b {
+		b[i] = x[i] - x[0]
+	}
+
+	integ := func(u, out []float64) {
+		out[0] = 0.0
+		for i := 1; i < N; i++ {
+			out[i] = out[i-1] + 0.5*dt[i-1]*(u[i-1]+u[i])
+		}
+	}
+	integT := func(v, out []float64) {
+		suffix := 0.0
+		for k := N - 1; k >= 0; k-- {
+			// suffix holds sum(v[k+1:])
+			out[k] = 0.0
+			if k < N-1 {
+				out[k] += 0.5 * dt[k] * suffix
+			}
+			suffix += v[k]
+			if k > 0 {
+				out[k] += 0.5 * dt[k-1] * suffix
+			}
+		}
+	}
+
+	u := derivFiniteDiff(t, x, 2)
+	E := make([]float64, N-1)
+	Au := make([]float64, N)
+	tmp := make([]float64, N)
+	g := make([]float64, N)
+
+	// applies H = alpha*D'ED + A'A
+	hess := func(v, out []float64) {
+		integ(v, tmp)
+		integT(tmp, out)
+		for j := 0; j < N-1; j++ {
+			f := alpha * E[j] * (v[j+1] - v[j])
+			out[j] -= f
+			out[j+1] += f
+		}
+	}
+
+	for it := 0; it < iters; it++ {
+		for j := 0; j < N-1; j++ {
+			du := u[j+1] - u[j]
+			E[j] = 1.0 / math.Sqrt(du*du+eps)
+		}
+		integ(u, Au)
+		for i := range Au {
+			Au[i] -= b[i]
+		}
+		integT(Au, g)
+		for j := 0; j < N-1; j++ {
+			f := alpha * E[j] * (u[j+1] - u[j])
+			g[j] -= f
+			g[j+1] += f
+		}
+
+		s := conjGrad(hess, g, 100, 1e-6)
+		for i := range u {
+			u[i] -= s[i]
+		}
+	}
+
+	smooth = make([]float64, N)
+	integ(u, smooth)
+	for i := range smooth {
+		smooth[i] += x[0]
+	}
+	return u, smooth
+}
+
+// conjugate gradients for the SPD operator H
+func conjGrad(H func(v, out []float64), b []float64, maxit int, tol float64) []float64 {
+	N := len(b)
+	x := make([]float64, N)
+	r := make([]float64, N)
+	p := make([]float64, N)
+	Hp := make([]float64, N)
+	copy(r, b)
+	copy(p, b)
+	rr := dot(r, r)
+	stop := tol * tol * rr
+	for it := 0; it < maxit && rr > stop; it++ {
+		H(p, Hp)
+		pHp := dot(p, Hp)
+		if pHp <= 0.0 {
+			break
+		}
+		a := rr / pHp
+		for i := 0; i < N; i++ {
+			x[i] += a * p[i]
+			r[i] -= a * Hp[i]
+		}
+		rrNew := dot(r, r)
+		beta := rrNew / rr
+		rr = rrNew
+		for i := 0; i < N; i++ {
+			p[i] = r[i] + beta*p[i]
+		}
+	}
+	return x
+}
+
+func dot(a, b []float64) (sum float64) {
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return
+}
+
+/* Cubic smoothing spline (Reinsch), differentiated analytically.
+ * Minimizes  sum (x_i - g_i)^2 + lambda * int g''^2  which reduces to the
+ * banded system (R + lambda Q'Q) gamma = Q'x for the second derivatives
+ * gamma at the interior knots, then g = x - lambda Q gamma.
+ * lambda = 0 gives the natural interpolating spline.
+ */
+func derivSpline(t, x []float64, lambda float64) (dx, smooth []float64) {
+	N := len(t)
+	if N < 3 {
+		return derivFiniteDiff(t, x, 1), append([]float64(nil), x...)
+	}
+	h := make([]float64, N-1)
+	for i := range h {
+		h[i] = t[i+1] - t[i]
+	}
+
+	// Q is N x (N-2); column j (interior knot j+1) has entries at rows j,j+1,j+2
+	M := N - 2
+	q := func(j int) (a, b, c float64) {
+		a = 1.0 / h[j]
+		b = -1.0/h[j] - 1.0/h[j+1]
+		c = 1.0 / h[j+1]
+		return
+	}
+
+	// symmetric pentadiagonal matrix stored as three diagonals
+	d0 := make([]float64, M)
+	d1 := make([]float64, M)
+	d2 := make([]float64, M)
+	rhs := make([]float64, M)
+	for j := 0; j < M; j++ {
+		a, b, c := q(j)
+		d0[j] = (h[j]+h[j+1])/3.0 + lambda*(a*a+b*b+c*c)
+		rhs[j] = a*x[j] + b*x[j+1] + c*x[j+2]
+		if j+1 < M {
+			a1, b1, _ := q(j + 1)
+			d1[j] = h[j+1]/6.0 + lambda*(b*a1+c*b1)
+		}
+		if j+2 < M {
+			a2, _, _ := q(j + 2)
+			d2[j] = lambda * c * a2
+		}
+	}
+	gamma := solvePentaSPD(d0, d1, d2, rhs)
+
+	smooth = make([]float64, N)
+	copy(smooth, x)
+	if lambda > 0.0 {
+		for j := 0; j < M; j++ {
+			a, b, c := q(j)
+			smooth[j] -= lambda * a * gamma[j]
+			smooth[j+1] -= lambda * b * gamma[j]
+			smooth[j+2] -= lambda * c * gamma[j]
+		}
+	}
+
+	// second derivatives at all knots (natural ends)
+	G := make([]float64, N)
+	copy(G[1:N-1], gamma)
+
+	dx = make([]float64, N)
+	for i := 0; i < N-1; i++ {
+		dx[i] = (smooth[i+1]-smooth[i])/h[i] - h[i]/6.0*(2.0*G[i]+G[i+1])
+	}
+	dx[N-1] = (smooth[N-1]-smooth[N-2])/h[N-2] + h[N-2]/6.0*(G[N-2]+2.0*G[N-1])
+	return
+}
+
+// banded Cholesky (LDL') for a symmetric positive definite pentadiagonal system
+func solvePentaSPD(d0, d1, d2, b []float64) []float64 {
+	M := len(d0)
+	D := make([]float64, M)
+	L1 := make([]float64, M)
+	L2 := make([]float64, M)
+	for i := 0; i < M; i++ {
+		D[i] = d0[i]
+		if i >= 1 {
+			D[i] -= L1[i-1] * L1[i-1] * D[i-1]
+		}
+		if i >= 2 {
+			D[i] -= L2[i-2] * L2[i-2] * D[i-2]
+		}
+		if i+1 < M {
+			L1[i] = d1[i]
+			if i >= 1 {
+				L1[i] -= L2[i-1] * L1[i-1] * D[i-1]
+			}
+			L1[i] /= D[i]
+		}
+		if i+2 < M {
+			L2[i] = d2[i] / D[i]
+		}
+	}
+
+	y := make([]float64, M)
+	for i := 0; i < M; i++ {
+		y[i] = b[i]
+		if i >= 1 {
+			y[i] -= L1[i-1] * y[i-1]
+		}
+		if i >= 2 {
+			y[i] -= L2[i-2] * y[i-2]
+		}
+	}
+	for i := 0; i < M; i++ {
+		y[i] /= D[i]
+	}
+	for i := M - 1; i >= 0; i-- {
+		if i+1 < M {
+			y[i] -= L1[i] * y[i+1]
+		}
+		if i+2 < M {
+			y[i] -= L2[i] * y[i+2]
+		}
+	}
+	return y
+}
@@ -0,0 +1,252 @@
+package problems
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	expr "github.com/verdverm/go-symexpr"
+)
+
+/* Dynamical system benchmarks
+ *
+ * Each system is integrated with RK4 from several initial conditions, for
+ * each of its parameter sets. The derivatives written with the data are
+ * the right hand sides evaluated at the integrated states, so they are
+ * exact whatever the integration error. A parameter set makes one point
+ * set, its parameters the system values:
+ *
+ *   $ a 1.5          the parameters, the System leaves
+ *   Time x y ...     independents, Time then the states
+ *   d(x) d(y) ...    dependents, the derivative of each state
+ *
+ * The trajectories of the initial conditions follow each other in the
+ * set, Time starting again at 0 for each. The search evaluates the states
+ * as Var 0, 1, ..., and SearchVar picks the derivative to find.
+ */
+
+// DiffeqSystem is a system of ODEs and how to sample its trajectories
+type DiffeqSystem struct {
+	Name   string
+	Vars   []string // the states
+	Params []string // the system values
+	Funcs  []string // the derivative of each state, in the states and params
+
+	ParamSets  [][]float64
+	Init       string // sampling spec of the initial conditions, counts unused
+	TrainInits int    // initial conditions per parameter set
+	TestInits  int
+
+	Step     float64 // time between points
+	Points   int     // per trajectory
+	Substeps int     // RK4 steps per Step
+
+	Functions []string
+	NonTrig   []string
+}
+
+var diffeqFuncs = []string{"Add", "Mul", "Div"}
+
+var DiffeqList = []DiffeqSystem{
+	DiffeqSystem{
+		Name: "LotkaVolterra", Vars: []string{"x", "y"}, Params: []string{"a", "b", "c", "d"},
+		Funcs:     []string{"a*x - b*x*y", "c*x*y - d*y"},
+		ParamSets: [][]float64{{1.5, 1, 1, 3}, {0.67, 1.33, 1, 1}, {1, 0.5, 0.5, 2}},
+		Init:      "U[0.5,3]", TrainInits: 4, TestInits: 2,
+		Step: 0.1, Points: 200, Substeps: 10,
+		Functions: diffeqFuncs, NonTrig: diffeqFuncs,
+	},
+	DiffeqSystem{
+		Name: "DampedOscillator", Vars: []string{"x", "v"}, Params: []string{"k", "c"},
+		Funcs:     []string{"v", "-k*x - c*v"},
+		ParamSets: [][]float64{{1, 0.1}, {4, 0.4}, {2, 1}},
+		Init:      "x: U[-2,2] v: U[-1,1]", TrainInits: 4, TestInits: 2,
+		Step: 0.1, Points: 200, Substeps: 10,
+		Functions: diffeqFuncs, NonTrig: diffeqFuncs,
+	},
+	DiffeqSystem{
+		Name: "Lorenz", Vars: []string{"x", "y", "z"}, Params: []string{"s", "r", "b"},
+		Funcs:     []string{"s*(y - x)", "x*(r - z) - y", "x*y - b*z"},
+		ParamSets: [][]float64{{10, 28, 8.0 / 3.0}, {10, 20, 8.0 / 3.0}, {16, 45, 4}},
+		Init:      "x,y: U[-10,10] z: U[10,30]", TrainInits: 3, TestInits: 2,
+		Step: 0.01, Points: 1000, Substeps: 4,
+		Functions: diffeqFuncs, NonTrig: diffeqFuncs,
+	},
+	// fractions of the population, S + I + R near 1
+	DiffeqSystem{
+		Name: "SIR", Vars: []string{"S", "I", "R"}, Params: []string{"b", "g"},
+		Funcs:     []string{"-b*S*I", "b*S*I - g*I", "g*I"},
+		ParamSets: [][]float64{{0.5, 0.1}, {0.3, 0.1}, {0.8, 0.25}},
+		Init:      "S: U[0.9,1] I: U[0.001,0.05] R: U[0,0.05]", TrainInits: 4, TestInits: 2,
+		Step: 1, Points: 150, Substeps: 10,
+		Functions: diffeqFuncs, NonTrig: diffeqFuncs,
+	},
+	// first order consecutive reactions A -> B -> C, mass action
+	DiffeqSystem{
+		Name: "Kinetics", Vars: []string{"A", "B", "C"}, Params: []string{"k1", "k2"},
+		Funcs:     []string{"-k1*A", "k1*A - k2*B", "k2*B"},
+		ParamSets: [][]float64{{1, 0.5}, {0.5, 1}, {2, 0.2}},
+		Init:      "A: U[0.5,2] B,C: U[0,0.5]", TrainInits: 4, TestInits: 2,
+		Step: 0.05, Points: 200, Substeps: 10,
+		Functions: diffeqFuncs, NonTrig: diffeqFuncs,
+	},
+	// autocatalytic reaction, a limit cycle when b > 1 + a^2
+	DiffeqSystem{
+		Name: "Brusselator", Vars: []string{"x", "y"}, Params: []string{"a", "b"},
+		Funcs:     []string{"a - (b+1)*x + x^2*y", "b*x - x^2*y"},
+		ParamSets: [][]float64{{1, 3}, {1, 2.5}, {1.5, 4}},
+		Init:      "U[0,3]", TrainInits: 4, TestInits: 2,
+		Step: 0.05, Points: 400, Substeps: 10,
+		Functions: diffeqFuncs, NonTrig: diffeqFuncs,
+	},
+}
+
+// Generate integrates the system, one training and testing point set for
+// each parameter set. The initial conditions are drawn from math/rand,
+// seeded with seed.
+func (S *DiffeqSystem) Generate(seed int64) (train, test []*PointSet, err error) {
+	init, err := ParseSampling(S.Vars, S.Init)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s Init: %v", S.Name, err)
+	}
+	f, err := S.rhs()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rand.Seed(seed)
+	for k, params := range S.ParamSets {
+		rhs := func(x, dx []float64) { f(x, params, dx) }
+		comment := func(which string) []string {
+			return []string{
+				fmt.Sprintf("%s %s data, parameter set %d", S.Name, which, k),
+				fmt.Sprintf("Seed = %d", seed),
+			}
+		}
+		trn, err := S.pointSet(rhs, init, params, S.TrainInits)
+		if err != nil {
+			return nil, nil, err
+		}
+		trn.SetFN(fmt.Sprintf("%s_%d_train", S.Name, k))
+		trn.SetComments(comment("training"))
+		tst, err := S.pointSet(rhs, init, params, S.TestInits)
+		if err != nil {
+			return nil, nil, err
+		}
+		tst.SetFN(fmt.Sprintf("%s_%d_test", S.Name, k))
+		tst.SetComments(comment("testing"))
+		train = append(train, trn)
+		test = append(test, tst)
+	}
+	return train, test, nil
+}
+
+// rhs parses the derivatives, the params following the states as variables
+func (S *DiffeqSystem) rhs() (func(x, params, dx []float64), error) {
+	if len(S.Funcs) != len(S.Vars) {
+		return nil, fmt.Errorf("%s: %d states but %d derivatives", S.Name, len(S.Vars), len(S.Funcs))
+	}
+	names := append(append([]string{}, S.Vars...), S.Params...)
+	eqns := make([]expr.Expr, len(S.Funcs))
+	for i, text := range S.Funcs {
+		if eqns[i] = expr.ParseFunc(text, names); eqns[i] == nil {
+			return nil, fmt.Errorf("%s: can't parse d(%s) = %s", S.Name, S.Vars[i], text)
+		}
+	}
+	in := make([]float64, len(names))
+	return func(x, params, dx []float64) {
+		copy(in, x)
+		copy(in[len(x):], params)
+		for i, e := range eqns {
+			dx[i] = e.Eval(0, in, nil, nil)
+		}
+	}, nil
+}
+
+// pointSet integrates from inits initial conditions, drawing again those
+// whose trajectory leaves the finite or goes over 100000
+func (S *DiffeqSystem) pointSet(rhs func(x, dx []float64), init []BenchmarkVar, params []float64, inits int) (*PointSet, error) {
+	NV := len(S.Vars)
+	indep := make([][]float64, NV+1)
+	depnd := make([][]float64, NV)
+
+	x0 := make([]float64, NV)
+	for n := 0; n < inits; n++ {
+		var traj [][]float64
+		for try := 0; try < benchRetries && traj == nil; try++ {
+			for j, v := range init {
+				x0[j] = v.draw()
+			}
+			traj = rk4(rhs, x0, S.Step, S.Points, S.Substeps)
+		}
+		if traj == nil {
+			return nil, fmt.Errorf("%s: no bounded trajectory for parameters %v", S.Name, params)
+		}
+
+		dx := make([]float64, NV)
+		for p, x := range traj {
+			rhs(x, dx)
+			indep[0] = append(indep[0], float64(p)*S.Step)
+			for j := 0; j < NV; j++ {
+				indep[j+1] = append(indep[j+1], x[j])
+				depnd[j] = append(depnd[j], dx[j])
+			}
+		}
+	}
+
+	dnames := make([]string, NV)
+	for j, v := range S.Vars {
+		dnames[j] = "d(" + v + ")"
+	}
+	PS := new(PointSet)
+	PS.SetIndepNames(append([]string{"Time"}, S.Vars...))
+	PS.SetNumDim(NV + 1)
+	PS.SetDepndNames(dnames)
+	PS.SetSysNames(S.Params)
+	PS.SetSysVals(params)
+	PS.SetColumns(indep, depnd)
+	return PS, nil
+}
+
+// rk4 integrates dx/dt = f(x) from x0, giving the state every step,
+// nil when it stops being finite or goes over 100000
+func rk4(f func(x, dx []float64), x0 []float64, step float64, points, substeps int) [][]float64 {
+	if substeps < 1 {
+		substeps = 1
+	}
+	N := len(x0)
+	h := step / float64(substeps)
+	k1, k2, k3, k4 := make([]float64, N), make([]float64, N), make([]float64, N), make([]float64, N)
+	tmp := make([]float64, N)
+
+	x := append([]float64{}, x0...)
+	traj := make([][]float64, 0, points)
+	for p := 0; p < points; p++ {
+		for _, v := range x {
+			if math.IsNaN(v) || math.IsInf(v, 0) || math.Abs(v) > 100000.0 {
+				return nil
+			}
+		}
+		traj = append(traj, append([]float64{}, x...))
+
+		for s := 0; s < substeps; s++ {
+			f(x, k1)
+			for i := range x {
+				tmp[i] = x[i] + 0.5*h*k1[i]
+			}
+			f(tmp, k2)
+			for i := range x {
+				tmp[i] = x[i] + 0.5*h*k2[i]
+			}
+			f(tmp, k3)
+			for i := range x {
+				tmp[i] = x[i] + h*k3[i]
+			}
+			f(tmp, k4)
+			for i := range x {
+				x[i] += h / 6 * (k1[i] + 2*k2[i] + 2*k3[i] + k4[i])
+			}
+		}
+	}
+	return traj
+}
@@ -0,0 +1,124 @@
+package problems
+
+import "strings"
+
+/* Feynman suite
+ *
+ * Physics equations in the style of the AI Feynman dataset
+ * (Udrescu & Tegmark, "AI Feynman", 2020), named after their place in
+ * the Feynman Lectures. Every variable and the function have SI units,
+ * which are written with the data.
+ */
+
+const (
+	feynmanTrain = 1000
+	feynmanTest  = 10000
+)
+
+// feynman defines a benchmark of the suite, the same ranges
+// for training and testing
+func feynman(name, vars, ranges, units, unit, text string) BenchmarkDef {
+	V := withUnits(sampling(vars, ranges), units)
+	funcs, nontrig := FuncsOf(text, strings.Split(vars, ","))
+	return BenchmarkDef{
+		Benchmark: Benchmark{
+			Name:      name,
+			TrainVars: V, TrainSamples: feynmanTrain,
+			TestVars: V, TestSamples: feynmanTest,
+			Functions: funcs, NonTrig: nontrig,
+			FuncText: text,
+		},
+		Unit: unit,
+	}
+}
+
+var FeynmanList = []BenchmarkDef{
+	// Gaussian distributions
+	feynman("Feynman_I_6_2a", "theta", "U[1,3]", "theta: 1", "1",
+		"e^(-theta^2/2) / sqrt(2*PI)"),
+	feynman("Feynman_I_6_2", "sigma,theta", "U[1,3]", "sigma,theta: 1", "1",
+		"e^(-(theta/sigma)^2/2) / (sqrt(2*PI)*sigma)"),
+
+	// gravitation between two masses
+	feynman("Feynman_I_9_18", "G,m1,m2,x1,x2,y1,y2,z1,z2", "x1,y1,z1: U[3,4] U[1,2]",
+		"G: kg^-1 m^3 s^-2; m1,m2: kg; x1,x2,y1,y2,z1,z2: m", "kg m s^-2",
+		"G*m1*m2 / ((x2-x1)^2 + (y2-y1)^2 + (z2-z1)^2)"),
+
+	// relativistic mass
+	feynman("Feynman_I_10_7", "m0,v,c", "m0: U[1,5] v: U[1,2] c: U[3,10]",
+		"m0: kg; v,c: m s^-1", "kg",
+		"m0 / sqrt(1 - v^2/c^2)"),
+
+	// friction
+	feynman("Feynman_I_12_1", "mu,Nn", "U[1,5]", "mu: 1; Nn: kg m s^-2", "kg m s^-2",
+		"mu*Nn"),
+
+	// Coulomb's law
+	feynman("Feynman_I_12_2", "q1,q2,epsilon,r", "U[1,5]",
+		"q1,q2: A s; epsilon: A^2 s^4 kg^-1 m^-3; r: m", "kg m s^-2",
+		"q1*q2 / (4*PI*epsilon*r^2)"),
+
+	// Lorentz force
+	feynman("Feynman_I_12_11", "q,Ef,B,v,theta", "U[1,5]",
+		"q: A s; Ef: kg m s^-3 A^-1; B: kg s^-2 A^-1; v: m s^-1; theta: 1", "kg m s^-2",
+		"q*(Ef + B*v*sin(theta))"),
+
+	// kinetic energy
+	feynman("Feynman_I_13_4", "m,v,u,w", "U[1,5]", "m: kg; v,u,w: m s^-1", "kg m^2 s^-2",
+		"0.5*m*(v^2 + u^2 + w^2)"),
+
+	// potential energy
+	feynman("Feynman_I_14_3", "m,g,z", "U[1,5]", "m: kg; g: m s^-2; z: m", "kg m^2 s^-2",
+		"m*g*z"),
+
+	// Lorentz transformation
+	feynman("Feynman_I_15_3x", "x,u,c,t", "x: U[5,10] u: U[1,2] c: U[3,20] t: U[1,2]",
+		"x: m; u,c: m s^-1; t: s", "m",
+		"(x - u*t) / sqrt(1 - u^2/c^2)"),
+
+	// center of mass
+	feynman("Feynman_I_18_4", "m1,m2,r1,r2", "U[1,5]", "m1,m2: kg; r1,r2: m", "m",
+		"(m1*r1 + m2*r2) / (m1 + m2)"),
+
+	// energy of a driven oscillator
+	feynman("Feynman_I_24_6", "m,omega,omega0,x", "U[1,5]", "m: kg; omega,omega0: s^-1; x: m", "kg m^2 s^-2",
+		"0.25*m*(omega^2 + omega0^2)*x^2"),
+
+	// focal length of two lenses
+	feynman("Feynman_I_27_6", "d1,d2,n", "U[1,5]", "d1,d2: m; n: 1", "m",
+		"1 / (1/d1 + n/d2)"),
+
+	// wave number
+	feynman("Feynman_I_29_4", "omega,c", "U[1,10]", "omega: s^-1; c: m s^-1", "m^-1",
+		"omega/c"),
+
+	// cyclotron frequency
+	feynman("Feynman_I_34_8", "q,v,B,p", "U[1,5]", "q: A s; v: m s^-1; B: kg s^-2 A^-1; p: kg m s^-1", "s^-1",
+		"q*v*B/p"),
+
+	// energy of an ideal gas
+	feynman("Feynman_I_39_10", "pr,V", "U[1,5]", "pr: kg m^-1 s^-2; V: m^3", "kg m^2 s^-2",
+		"1.5*pr*V"),
+
+	// diffusion constant, Einstein relation
+	feynman("Feynman_I_43_31", "mob,kb,T", "U[1,5]", "mob: s kg^-1; kb: kg m^2 s^-2 K^-1; T: K", "m^2 s^-1",
+		"mob*kb*T"),
+
+	// relativistic energy
+	feynman("Feynman_I_48_2", "m,v,c", "m: U[1,5] v: U[1,2] c: U[3,10]", "m: kg; v,c: m s^-1", "kg m^2 s^-2",
+		"m*c^2 / sqrt(1 - v^2/c^2)"),
+
+	// driven electron displacement
+	feynman("Feynman_II_11_3", "q,Ef,m,omega0,omega", "q,Ef,m: U[1,3] omega0: U[3,5] omega: U[1,2]",
+		"q: A s; Ef: kg m s^-3 A^-1; m: kg; omega0,omega: s^-1", "m",
+		"q*Ef / (m*(omega0^2 - omega^2))"),
+
+	// Hooke's law for a bar
+	feynman("Feynman_II_38_3", "Y,A,x,d", "U[1,5]", "Y: kg m^-1 s^-2; A: m^2; x,d: m", "kg m s^-2",
+		"Y*A*x/d"),
+
+	// mean energy of a quantum oscillator
+	feynman("Feynman_III_4_33", "h,omega,kb,T", "U[1,5]",
+		"h: kg m^2 s^-1; omega: s^-1; kb: kg m^2 s^-2 K^-1; T: K", "kg m^2 s^-2",
+		"(h/(2*PI))*omega / (e^((h/(2*PI))*omega/(kb*T)) - 1)"),
+}
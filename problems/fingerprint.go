@@ -0,0 +1,71 @@
+package problems
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"math"
+)
+
+// Fingerprint is a SHA-256 over what the fitter sees of the problem:
+// the search type and variable, and every name, column, weight and
+// system value of the training and testing sets. Two processes that
+// agree on it will fit and score an expression the same way.
+func (P *ExprProblem) Fingerprint() string {
+	h := sha256.New()
+	putInt(h, int64(P.SearchType))
+	putInt(h, int64(P.SearchVar))
+	for _, sets := range [][]*PointSet{P.Train, P.Test} {
+		putInt(h, int64(len(sets)))
+		for _, PS := range sets {
+			PS.hashInto(h)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Fingerprint of a single point set, same encoding as in the problem's
+func (d *PointSet) Fingerprint() string {
+	h := sha256.New()
+	d.hashInto(h)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (d *PointSet) hashInto(h hash.Hash) {
+	for _, names := range [][]string{d.indepNames, d.depndNames, d.sysNames} {
+		putInt(h, int64(len(names)))
+		for _, n := range names {
+			putInt(h, int64(len(n)))
+			h.Write([]byte(n))
+		}
+	}
+	putInt(h, int64(d.numPoints))
+	cols := append(append([][]float64{}, d.indepCols...), d.depndCols...)
+	cols = append(cols, d.weights, d.sysVals)
+	for _, col := range cols {
+		putInt(h, int64(len(col)))
+		putFloats(h, col)
+	}
+}
+
+func putInt(h hash.Hash, v int64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(v))
+	h.Write(buf[:])
+}
+
+func putFloats(h hash.Hash, vs []float64) {
+	buf := make([]byte, 8*256)
+	for len(vs) > 0 {
+		n := len(vs)
+		if n > 256 {
+			n = 256
+		}
+		for i, v := range vs[:n] {
+			binary.LittleEndian.PutUint64(buf[8*i:], math.Float64bits(v))
+		}
+		h.Write(buf[:8*n])
+		vs = vs[n:]
+	}
+}
@@ -0,0 +1,177 @@
+package problems
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+/* Noise
+ *
+ * Generated data is clean unless given noise. A noise spec is a list of
+ * kind=level, separated by spaces or commas, a lone number for additive
+ * noise, or none:
+ *
+ *   add=0.05        Gaussian, std the level times the target's std
+ *   mul=0.02        the target times 1 + N(0,level)
+ *   tail=0.05       Student-t with 3 degrees of freedom, scale as add
+ *   outliers=0.01   the fraction of points whose target is thrown
+ *                   3 to 6 stds away, in either direction
+ *   input=0.01      Gaussian on the inputs, std the level times the
+ *                   input's std, applied after the targets are computed
+ *
+ * The stds are those of the clean data, so the kinds add up
+ * independently of the order they are given in.
+ */
+
+// Noise is the noise put on generated training data
+type Noise struct {
+	Additive       float64
+	Multiplicative float64
+	HeavyTailed    float64
+	Outliers       float64
+	Input          float64
+}
+
+// ParseNoise reads a noise spec
+func ParseNoise(spec string) (N Noise, err error) {
+	fields := strings.Fields(strings.Replace(spec, ",", " ", -1))
+	if len(fields) == 1 && strings.ToLower(fields[0]) == "none" {
+		return N, nil
+	}
+	if len(fields) == 1 && !strings.Contains(fields[0], "=") {
+		N.Additive, err = strconv.ParseFloat(fields[0], 64)
+		if err == nil && N.Additive < 0 {
+			err = fmt.Errorf("noise level below 0")
+		}
+		return N, err
+	}
+
+	for _, f := range fields {
+		kv := strings.SplitN(f, "=", 2)
+		if len(kv) != 2 {
+			return N, fmt.Errorf("bad noise %q, want kind=level", f)
+		}
+		level, err := strconv.ParseFloat(kv[1], 64)
+		if err != nil {
+			return N, fmt.Errorf("noise %s: %v", kv[0], err)
+		}
+		if level < 0 {
+			return N, fmt.Errorf("noise %s below 0", kv[0])
+		}
+		switch strings.ToUpper(kv[0]) {
+		case "ADD":
+			N.Additive = level
+		case "MUL":
+			N.Multiplicative = level
+		case "TAIL":
+			N.HeavyTailed = level
+		case "OUTLIERS":
+			if level > 1 {
+				return N, fmt.Errorf("noise outliers is a fraction, above 1")
+			}
+			N.Outliers = level
+		case "INPUT":
+			N.Input = level
+		default:
+			return N, fmt.Errorf("unknown noise %q", kv[0])
+		}
+	}
+	return N, nil
+}
+
+func (N Noise) IsZero() bool { return N == Noise{} }
+
+// String is the spec of N, "none" when clean
+func (N Noise) String() string {
+	var parts []string
+	add := func(kind string, level float64) {
+		if level > 0 {
+			parts = append(parts, kind+"="+strconv.FormatFloat(level, 'g', -1, 64))
+		}
+	}
+	add("add", N.Additive)
+	add("mul", N.Multiplicative)
+	add("tail", N.HeavyTailed)
+	add("outliers", N.Outliers)
+	add("input", N.Input)
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, " ")
+}
+
+// Apply adds the noise to the data, drawing from rng
+func (N Noise) Apply(PS *PointSet, rng *rand.Rand) {
+	if N.IsZero() {
+		return
+	}
+
+	depnd := make([][]float64, PS.NumDepndCols())
+	for j, col := range PS.DepndCols() {
+		sd := stdDev(col)
+		depnd[j] = make([]float64, len(col))
+		for i, y := range col {
+			v := y
+			if N.Additive > 0 {
+				v += rng.NormFloat64() * N.Additive * sd
+			}
+			if N.Multiplicative > 0 {
+				v += y * rng.NormFloat64() * N.Multiplicative
+			}
+			if N.HeavyTailed > 0 {
+				v += studentT3(rng) * N.HeavyTailed * sd
+			}
+			if N.Outliers > 0 && rng.Float64() < N.Outliers {
+				off := (3 + 3*rng.Float64()) * sd
+				if rng.Intn(2) == 0 {
+					off = -off
+				}
+				v += off
+			}
+			depnd[j][i] = v
+		}
+	}
+	PS.SetDepndCols(depnd)
+
+	if N.Input > 0 {
+		indep := make([][]float64, PS.NumIndepCols())
+		for j, col := range PS.IndepCols() {
+			sd := stdDev(col)
+			indep[j] = make([]float64, len(col))
+			for i, x := range col {
+				indep[j][i] = x + rng.NormFloat64()*N.Input*sd
+			}
+		}
+		PS.SetIndepCols(indep)
+	}
+}
+
+// a draw from Student's t with 3 degrees of freedom
+func studentT3(rng *rand.Rand) float64 {
+	chi := 0.0
+	for k := 0; k < 3; k++ {
+		z := rng.NormFloat64()
+		chi += z * z
+	}
+	return rng.NormFloat64() / math.Sqrt(chi/3)
+}
+
+// the sample standard deviation, 0 for fewer than two values
+func stdDev(col []float64) float64 {
+	if len(col) < 2 {
+		return 0
+	}
+	mean := 0.0
+	for _, v := range col {
+		mean += v
+	}
+	mean /= float64(len(col))
+	sumsq := 0.0
+	for _, v := range col {
+		sumsq += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(sumsq / float64(len(col)-1))
+}
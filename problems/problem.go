@@ -1,10 +1,12 @@
 package problems
 
 import (
+	"fmt"
 	"log"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	expr "github.com/verdverm/go-symexpr"
 )
@@ -56,11 +58,13 @@ type ExprProblem struct {
 	SearchType ExprProblemType
 
 	// data
-	TrainFns []string
-	TestFns  []string
-	Train    []*PointSet
-	Test     []*PointSet
-	HitRatio float64
+	DataFormat  string
+	NoDataCache bool // don't read or write binary .pgec caches
+	TrainFns    []string
+	TestFns     []string
+	Train       []*PointSet
+	Test        []*PointSet
+	HitRatio    float64
 
 	// variable information
 	SearchVar  int
@@ -71,6 +75,13 @@ type ExprProblem struct {
 
 	// tree gen/restrict information
 	TreeCfg *TreeParams
+
+	// time-series reading and derivative estimation
+	TimeSeriesCfg *TimeSeriesParams
+	DerivCfg      *DerivParams
+
+	// delimited file reading
+	CsvCfg *CsvParams
 }
 
 type ExprProblemComm struct {
@@ -78,8 +89,23 @@ type ExprProblemComm struct {
 	Cmds chan int
 
 	// outgoing channels
-	Rpts chan *ExprReportArray
-	Gen  chan [2]int
+	Rpts  chan *ExprReportArray
+	Gen   chan [2]int
+	Stats chan *IterStats // optional, nil when nobody listens
+}
+
+// IterStats is what a search reports about each of its iterations
+type IterStats struct {
+	ProcID   int
+	Iter     int
+	Elapsed  time.Duration // wall time of the iteration
+	Evals    int           // expressions sent to the evaluators
+	Discards int           // of those, dropped by racing
+	Queue    int           // size of the priority queue after the iteration
+	Neqns    int           // reports accepted so far
+	MemoCnt  int           // expressions in the memo
+	MemoVst  int           // memo lookups that found a known expression
+	MinError float64
 }
 
 func ProbConfigParser(field, value string, config interface{}) (err error) {
@@ -88,6 +114,15 @@ func ProbConfigParser(field, value string, config interface{}) (err error) {
 	if EP.TreeCfg == nil {
 		EP.TreeCfg = new(TreeParams)
 	}
+	if EP.CsvCfg == nil {
+		EP.CsvCfg = NewCsvParams()
+	}
+	if EP.TimeSeriesCfg == nil {
+		EP.TimeSeriesCfg = NewTimeSeriesParams()
+	}
+	if EP.DerivCfg == nil {
+		EP.DerivCfg = NewDerivParams()
+	}
 
 	switch strings.ToUpper(field) {
 	case "NAME":
@@ -95,7 +130,7 @@ func ProbConfigParser(field, value string, config interface{}) (err error) {
 	case "PROBLEMTYPE":
 		typ := ProblemTypeFromString(strings.ToLower(value))
 		if typ == UnknownPType {
-			log.Fatalf("Unknown ProblemType in Problem config file\n")
+			return fmt.Errorf("unknown ProblemType in Problem config file: %s", value)
 		} else {
 			EP.SearchType = typ
 		}
@@ -110,6 +145,20 @@ func ProbConfigParser(field, value string, config interface{}) (err error) {
 		}
 		EP.HitRatio = fval
 
+	case "DATAFORMAT":
+		switch strings.ToLower(value) {
+		case "pointset", "timeseries", "csv", "tsv":
+			EP.DataFormat = strings.ToLower(value)
+		default:
+			return fmt.Errorf("unknown DataFormat in Problem config file: %s", value)
+		}
+	case "DATACACHE":
+		bval, cerr := strconv.ParseBool(value)
+		if cerr != nil {
+			log.Printf("Expected bool for DataCache\n")
+			return cerr
+		}
+		EP.NoDataCache = !bval
 	case "TRAINDATA":
 		EP.TrainFns = strings.Fields(value)
 	case "TESTDATA":
@@ -138,11 +187,31 @@ func ProbConfigParser(field, value string, config interface{}) (err error) {
 		EP.SearchVar = ival
 
 	default:
-		// check augillary parsable structures [only TreeParams for now]
-		found, ferr := ParseTreeParams(field, value, EP.TreeCfg)
+		// check augillary parsable structures [CsvParams, TimeSeriesParams, DerivParams, TreeParams]
+		found, ferr := ParseCsvParams(field, value, EP.CsvCfg)
+		if ferr != nil {
+			return fmt.Errorf("error parsing Problem Config %s: %v", field, ferr)
+		}
+		if found {
+			return
+		}
+		found, ferr = ParseTimeSeriesParams(field, value, EP.TimeSeriesCfg)
+		if ferr != nil {
+			return fmt.Errorf("error parsing Problem Config %s: %v", field, ferr)
+		}
+		if found {
+			return
+		}
+		found, ferr = ParseDerivParams(field, value, EP.DerivCfg)
+		if ferr != nil {
+			return fmt.Errorf("error parsing Problem Config %s: %v", field, ferr)
+		}
+		if found {
+			return
+		}
+		found, ferr = ParseTreeParams(field, value, EP.TreeCfg)
 		if ferr != nil {
-			log.Fatalf("error parsing Problem Config\n")
-			return ferr
+			return fmt.Errorf("error parsing Problem Config %s: %v", field, ferr)
 		}
 		if !found {
 			log.Printf("Problem Config Not Implemented: %s, %s\n\n", field, value)
@@ -0,0 +1,212 @@
+package problems
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/* Sampling specs
+ *
+ * How the variables of a benchmark are sampled, in the notation of the
+ * benchmark papers:
+ *
+ *   U[a,b,n]    n uniform random values in [a,b]
+ *   LU[a,b,n]   n log-uniform random values in [a,b], 0 < a < b
+ *   E[a,b,s]    the grid a, a+s, ... up to b
+ *
+ * n may be left out when the benchmark gives the sample count. A range
+ * can be prefixed by the variables it is for, and one without a prefix
+ * is for all the variables not yet given one:
+ *
+ *   x,z: U[-1,1,1000]  y: U[1,2,1000]
+ *   x: E[0.05,10,0.1]  y: E[0.05,10.05,2]
+ *   U[-3,3,20]
+ */
+
+var samplingRE = regexp.MustCompile(`^\s*(?:([A-Za-z_]\w*(?:\s*,\s*[A-Za-z_]\w*)*)\s*:)?\s*(LU|U|E)\[([^\]]*)\]`)
+
+// ParseSampling reads a sampling spec for the variables names, in order
+func ParseSampling(names []string, spec string) ([]BenchmarkVar, error) {
+	vars := make([]BenchmarkVar, len(names))
+	set := make([]bool, len(names))
+	index := make(map[string]int)
+	for i, name := range names {
+		index[name] = i
+	}
+
+	rest := spec
+	for strings.TrimSpace(rest) != "" {
+		m := samplingRE.FindStringSubmatch(rest)
+		if m == nil {
+			return nil, fmt.Errorf("bad sampling spec at %q", strings.TrimSpace(rest))
+		}
+		rest = rest[len(m[0]):]
+
+		V, err := parseRange(m[2], m[3])
+		if err != nil {
+			return nil, fmt.Errorf("%s[%s]: %v", m[2], m[3], err)
+		}
+
+		var which []int
+		if m[1] == "" {
+			for i := range names {
+				if !set[i] {
+					which = append(which, i)
+				}
+			}
+		} else {
+			for _, name := range strings.Split(m[1], ",") {
+				name = strings.TrimSpace(name)
+				i, ok := index[name]
+				if !ok {
+					return nil, fmt.Errorf("unknown variable %q", name)
+				}
+				which = append(which, i)
+			}
+		}
+		for _, i := range which {
+			if set[i] {
+				return nil, fmt.Errorf("variable %q given twice", names[i])
+			}
+			vars[i] = V
+			vars[i].Name, vars[i].Index = names[i], i
+			set[i] = true
+		}
+	}
+
+	for i, ok := range set {
+		if !ok {
+			return nil, fmt.Errorf("no range for variable %q", names[i])
+		}
+	}
+	return vars, nil
+}
+
+func parseRange(kind, args string) (V BenchmarkVar, err error) {
+	fields := strings.Split(args, ",")
+	if len(fields) < 2 || len(fields) > 3 {
+		return V, fmt.Errorf("want low,high and a count or step")
+	}
+	var nums [3]float64
+	for i, f := range fields {
+		if nums[i], err = strconv.ParseFloat(strings.TrimSpace(f), 64); err != nil {
+			return V, err
+		}
+	}
+	V.L, V.H = nums[0], nums[1]
+	if V.H < V.L {
+		return V, fmt.Errorf("high below low")
+	}
+
+	switch kind {
+	case "E":
+		V.Rtype, V.S = Equal, nums[2]
+		if V.S <= 0 {
+			return V, fmt.Errorf("grid needs a step above 0")
+		}
+		return V, nil
+	case "LU":
+		V.Rtype = LogUniform
+		if V.L <= 0 {
+			return V, fmt.Errorf("log-uniform needs low above 0")
+		}
+	default:
+		V.Rtype = Uniform
+	}
+	if nums[2] < 0 || nums[2] != math.Trunc(nums[2]) {
+		return V, fmt.Errorf("count must be a whole number")
+	}
+	V.N = int(nums[2])
+	return V, nil
+}
+
+// sampling is ParseSampling for the benchmark list,
+// names separated by commas
+func sampling(names, spec string) []BenchmarkVar {
+	vars, err := ParseSampling(strings.Split(names, ","), spec)
+	if err != nil {
+		panic(fmt.Sprintf("sampling %q: %v", spec, err))
+	}
+	return vars
+}
+
+/* Sums
+ *
+ * A function text can hold sums, sum(i=a..b: body), with {i} in the
+ * body standing for the index. The bounds are whole numbers or one of
+ * the variables, which is floored at each point. Sums with numbers for
+ * bounds are expanded once; sums up to a variable can only be expanded
+ * with the point's values, so such benchmarks have no single expression.
+ *
+ *   sum(i=1..x: 1/{i})     the harmonic number of x
+ */
+
+var sumRE = regexp.MustCompile(`sum\(\s*([A-Za-z_]\w*)\s*=\s*([\w.+-]+)\s*\.\.\s*([\w.+-]+)\s*:`)
+
+// expandSums writes out the sums of text, x gives the variable values
+// for variable bounds, when nil those are an error
+func expandSums(text string, names []string, x []float64) (string, error) {
+	for {
+		loc := sumRE.FindStringSubmatchIndex(text)
+		if loc == nil {
+			return text, nil
+		}
+		idx := text[loc[2]:loc[3]]
+		lo, err := sumBound(text[loc[4]:loc[5]], names, x)
+		if err != nil {
+			return "", err
+		}
+		hi, err := sumBound(text[loc[6]:loc[7]], names, x)
+		if err != nil {
+			return "", err
+		}
+
+		// the body runs to the paren closing sum(
+		depth, end := 1, -1
+		for i := loc[1]; i < len(text) && end < 0; i++ {
+			switch text[i] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+				if depth == 0 {
+					end = i
+				}
+			}
+		}
+		if end < 0 {
+			return "", fmt.Errorf("unclosed sum in %q", text)
+		}
+		body := strings.TrimSpace(text[loc[1]:end])
+
+		// an empty sum, hi < lo, is 0
+		sum := "0"
+		if hi >= lo {
+			terms := make([]string, 0, hi-lo+1)
+			for i := lo; i <= hi; i++ {
+				terms = append(terms, "("+strings.Replace(body, "{"+idx+"}", strconv.Itoa(i), -1)+")")
+			}
+			sum = "(" + strings.Join(terms, " + ") + ")"
+		}
+		text = text[:loc[0]] + sum + text[end+1:]
+	}
+}
+
+func sumBound(s string, names []string, x []float64) (int, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, nil
+	}
+	for i, name := range names {
+		if name != s {
+			continue
+		}
+		if x == nil {
+			return 0, fmt.Errorf("sum bound %s is a variable", s)
+		}
+		return int(math.Floor(x[i])), nil
+	}
+	return 0, fmt.Errorf("bad sum bound %q", s)
+}
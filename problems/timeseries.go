@@ -0,0 +1,364 @@
+package problems
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// options for reading time-series data files
+type TimeSeriesParams struct {
+	// columns holding the timestamp, joined with a space before parsing
+	TimeCols []int
+	// Go time layouts tried in order, or one of: numeric, unix, unixms
+	TimeFormats []string
+	// unit of the Time variable: s, min, h, d
+	TimeUnit string
+
+	// spacing of the uniform grid in TimeUnit (0 = median spacing, <0 = no resampling)
+	Resample float64
+	// gaps up to MaxGap are interpolated, longer ones split the series (0 = 3 grid steps)
+	MaxGap float64
+	// segments with fewer points are dropped
+	MinSegment int
+}
+
+func NewTimeSeriesParams() *TimeSeriesParams {
+	tp := new(TimeSeriesParams)
+	tp.TimeCols = []int{0}
+	tp.TimeFormats = []string{"numeric"}
+	tp.TimeUnit = "s"
+	tp.MinSegment = 5
+	return tp
+}
+
+func ParseTimeSeriesParams(field, value string, config interface{}) (found bool, err error) {
+
+	TP := config.(*TimeSeriesParams)
+	found = true
+
+	switch strings.ToUpper(field) {
+	case "TSTIMECOLS":
+		TP.TimeCols = TP.TimeCols[:0]
+		for _, v := range strings.Fields(value) {
+			ival, cerr := strconv.Atoi(v)
+			if cerr != nil {
+				log.Printf("Expected integer for TsTimeCols\n")
+				return found, cerr
+			}
+			TP.TimeCols = append(TP.TimeCols, ival)
+		}
+	case "TSTIMEFORMAT":
+		TP.TimeFormats = TP.TimeFormats[:0]
+		for _, f := range strings.Split(value, ";") {
+			if f = strings.TrimSpace(f); f != "" {
+				TP.TimeFormats = append(TP.TimeFormats, f)
+			}
+		}
+	case "TSTIMEUNIT":
+		if _, uerr := timeUnitSeconds(value); uerr != nil {
+			return found, uerr
+		}
+		TP.TimeUnit = strings.ToLower(value)
+	case "TSRESAMPLE":
+		TP.Resample, err = strconv.ParseFloat(value, 64)
+	case "TSMAXGAP":
+		TP.MaxGap, err = strconv.ParseFloat(value, 64)
+	case "TSMINSEGMENT":
+		TP.MinSegment, err = strconv.Atoi(value)
+	default:
+		found = false
+	}
+	if err != nil {
+		log.Printf("Error parsing %s: %v\n", field, err)
+	}
+	return
+}
+
+func timeUnitSeconds(unit string) (float64, error) {
+	switch strings.ToLower(unit) {
+	case "s", "sec", "second", "seconds":
+		return 1.0, nil
+	case "m", "min", "minute", "minutes":
+		return 60.0, nil
+	case "h", "hr", "hour", "hours":
+		return 3600.0, nil
+	case "d", "day", "days":
+		return 86400.0, nil
+	}
+	return 0.0, fmt.Errorf("unknown time unit %q", unit)
+}
+
+// parse a timestamp into seconds (or raw units for numeric)
+func (tp *TimeSeriesParams) parseTime(str string) (float64, error) {
+	var lastErr error
+	for _, f := range tp.TimeFormats {
+		switch strings.ToLower(f) {
+		case "numeric":
+			v, err := strconv.ParseFloat(str, 64)
+			if err == nil {
+				return v, nil
+			}
+			lastErr = err
+		case "unix":
+			v, err := strconv.ParseFloat(str, 64)
+			if err == nil {
+				return v, nil
+			}
+			lastErr = err
+		case "unixms":
+			v, err := strconv.ParseFloat(str, 64)
+			if err == nil {
+				return v / 1000.0, nil
+			}
+			lastErr = err
+		default:
+			t, err := time.Parse(f, str)
+			if err == nil {
+				return float64(t.UnixNano()) / 1e9, nil
+			}
+			lastErr = err
+		}
+	}
+	return 0.0, lastErr
+}
+
+func (tp *TimeSeriesParams) numericTime() bool {
+	return len(tp.TimeFormats) == 1 && strings.ToLower(tp.TimeFormats[0]) == "numeric"
+}
+
+type tsSample struct {
+	t    float64
+	vals []float64
+}
+
+// ReadTimeSeries reads a whitespace separated file with a header line of
+// column names, parses the timestamp columns into a Time variable (indep 0),
+// resamples onto a uniform grid and splits the series at long gaps
+func ReadTimeSeries(filename string, tp *TimeSeriesParams) (sets []*PointSet, err error) {
+	if tp == nil {
+		tp = NewTimeSeriesParams()
+	}
+	ftotal, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer ftotal.Close()
+
+	scanner := bufio.NewScanner(ftotal)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	isTime := make(map[int]bool)
+	for _, c := range tp.TimeCols {
+		isTime[c] = true
+	}
+
+	var names []string
+	var samples []tsSample
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+
+		if names == nil {
+			for c, n := range fields {
+				if !isTime[c] {
+					names = append(names, n)
+				}
+			}
+			continue
+		}
+
+		if len(fields) < len(names)+len(tp.TimeCols) {
+			return nil, fmt.Errorf("%s:%d: expected %d columns, found %d",
+				filename, lineNum, len(names)+len(tp.TimeCols), len(fields))
+		}
+
+		tparts := make([]string, len(tp.TimeCols))
+		for i, c := range tp.TimeCols {
+			tparts[i] = fields[c]
+		}
+		t, terr := tp.parseTime(strings.Join(tparts, " "))
+		if terr != nil {
+			return nil, fmt.Errorf("%s:%d: bad timestamp %q: %v",
+				filename, lineNum, strings.Join(tparts, " "), terr)
+		}
+
+		var s tsSample
+		s.t = t
+		s.vals = make([]float64, 0, len(names))
+		for c, f := range fields {
+			if isTime[c] {
+				continue
+			}
+			v, perr := strconv.ParseFloat(f, 64)
+			if perr != nil {
+				// anything unparsable is treated as missing
+				v = math.NaN()
+			}
+			s.vals = append(s.vals, v)
+		}
+		samples = append(samples, s)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("%s: no samples for a time series", filename)
+	}
+
+	// convert to TimeUnit relative to the first sample
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].t < samples[j].t })
+	unit := 1.0
+	if !tp.numericTime() {
+		unit, err = timeUnitSeconds(tp.TimeUnit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	t0 := samples[0].t
+	uniq := samples[:1]
+	uniq[0].t = 0.0
+	for _, s := range samples[1:] {
+		s.t = (s.t - t0) / unit
+		if s.t == uniq[len(uniq)-1].t {
+			continue // duplicate timestamp, keep the first
+		}
+		uniq = append(uniq, s)
+	}
+	samples = uniq
+	if len(samples) < 2 {
+		return nil, fmt.Errorf("%s: fewer than 2 distinct timestamps for a time series", filename)
+	}
+
+	grid, step := tp.timeGrid(samples)
+	maxGap := tp.MaxGap
+	if maxGap <= 0.0 {
+		maxGap = 3.0 * step
+	}
+
+	// value of every variable at every grid time, NaN when it can't be filled
+	NV := len(names)
+	cols := make([][]float64, NV)
+	for v := 0; v < NV; v++ {
+		cols[v] = interpColumn(samples, v, grid, maxGap)
+	}
+
+	indepNames := append([]string{"Time"}, names...)
+	var seg []int // grid indices of the current segment
+	lastT := math.Inf(-1)
+	flush := func() {
+		if len(seg) >= tp.MinSegment && len(seg) > 0 {
+			indep := make([][]float64, NV+1)
+			indep[0] = make([]float64, len(seg))
+			for k, g := range seg {
+				indep[0][k] = grid[g]
+			}
+			for v := 0; v < NV; v++ {
+				indep[v+1] = make([]float64, len(seg))
+				for k, g := range seg {
+					indep[v+1][k] = cols[v][g]
+				}
+			}
+			ps := new(PointSet)
+			ps.SetFN(fmt.Sprintf("%s#%d", filename, len(sets)))
+			ps.SetID(len(sets))
+			ps.SetIndepNames(indepNames)
+			ps.SetNumDim(len(indepNames))
+			ps.SetColumns(indep, nil)
+			sets = append(sets, ps)
+		}
+		seg = nil
+	}
+	for g, t := range grid {
+		good := true
+		for v := 0; v < NV; v++ {
+			if math.IsNaN(cols[v][g]) {
+				good = false
+				break
+			}
+		}
+		if !good {
+			flush()
+			continue
+		}
+		if t-lastT > maxGap {
+			flush()
+		}
+		seg = append(seg, g)
+		lastT = t
+	}
+	flush()
+
+	Vprintf(VInfo, "Time series %s: %d samples -> %d segments (step %g, max gap %g)\n",
+		filename, len(samples), len(sets), step, maxGap)
+	return sets, nil
+}
+
+// the times to produce output at, plus the nominal step
+func (tp *TimeSeriesParams) timeGrid(samples []tsSample) (grid []float64, step float64) {
+	diffs := make([]float64, len(samples)-1)
+	for i := range diffs {
+		diffs[i] = samples[i+1].t - samples[i].t
+	}
+	sort.Float64s(diffs)
+	step = diffs[len(diffs)/2]
+
+	if tp.Resample < 0.0 {
+		grid = make([]float64, len(samples))
+		for i, s := range samples {
+			grid[i] = s.t
+		}
+		return grid, step
+	}
+	if tp.Resample > 0.0 {
+		step = tp.Resample
+	}
+	last := samples[len(samples)-1].t
+	N := int(math.Floor(last/step+1e-9)) + 1
+	grid = make([]float64, N)
+	for i := range grid {
+		grid[i] = float64(i) * step
+	}
+	return grid, step
+}
+
+// linear interpolation of variable v onto the grid, only across gaps <= maxGap
+func interpColumn(samples []tsSample, v int, grid []float64, maxGap float64) []float64 {
+	var ts, xs []float64
+	for _, s := range samples {
+		if !math.IsNaN(s.vals[v]) && !math.IsInf(s.vals[v], 0) {
+			ts = append(ts, s.t)
+			xs = append(xs, s.vals[v])
+		}
+	}
+	out := make([]float64, len(grid))
+	k := 0
+	for g, t := range grid {
+		for k+1 < len(ts) && ts[k+1] <= t {
+			k++
+		}
+		switch {
+		case len(ts) == 0 || t < ts[0] || t > ts[len(ts)-1]:
+			out[g] = math.NaN()
+		case ts[k] == t:
+			out[g] = xs[k]
+		case ts[k+1]-ts[k] > maxGap:
+			out[g] = math.NaN()
+		default:
+			f := (t - ts[k]) / (ts[k+1] - ts[k])
+			out[g] = xs[k] + f*(xs[k+1]-xs[k])
+		}
+	}
+	return out
+}
@@ -93,16 +93,16 @@ func ParseTreeParams(field, value string, config interface{}) (found bool, err e
 	switch strings.ToUpper(field) {
 	case "ROOTS":
 		TP.RootsS = strings.Fields(value)
-		TP.RootsT, TP.Roots = fillExprStuff(TP.RootsS)
+		TP.RootsT, TP.Roots, err = fillExprStuff(TP.RootsS)
 	case "NODES":
 		TP.NodesS = strings.Fields(value)
-		TP.NodesT, TP.Nodes = fillExprStuff(TP.NodesS)
+		TP.NodesT, TP.Nodes, err = fillExprStuff(TP.NodesS)
 	case "NONTRIG":
 		TP.NonTrigS = strings.Fields(value)
-		TP.NonTrigT, TP.NonTrig = fillExprStuff(TP.NonTrigS)
+		TP.NonTrigT, TP.NonTrig, err = fillExprStuff(TP.NonTrigS)
 	case "LEAFS":
 		TP.LeafsS = strings.Fields(value)
-		TP.LeafsT, TP.Leafs = fillExprStuff(TP.LeafsS)
+		TP.LeafsT, TP.Leafs, err = fillExprStuff(TP.LeafsS)
 
 	case "USABLEVARS":
 		usable := strings.Fields(value)
@@ -152,7 +152,7 @@ func ParseTreeParams(field, value string, config interface{}) (found bool, err e
 	return
 }
 
-func fillExprStuff(names []string) (types []expr.ExprType, exprs []expr.Expr) {
+func fillExprStuff(names []string) (types []expr.ExprType, exprs []expr.Expr, err error) {
 	types = make([]expr.ExprType, len(names))
 	exprs = make([]expr.Expr, len(names))
 	for i, n := range names {
@@ -218,7 +218,7 @@ func fillExprStuff(names []string) (types []expr.ExprType, exprs []expr.Expr) {
 			types[i] = expr.DIV
 			exprs[i] = new(expr.Div)
 		default:
-			log.Fatalf("Unknown ExprType:  %s\n", n)
+			return nil, nil, fmt.Errorf("unknown ExprType: %s", n)
 		}
 	}
 	return
@@ -0,0 +1,114 @@
+package problems
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/* Units
+ *
+ * A unit is a product of SI base units with integer powers, separated
+ * by spaces, or 1 for a dimensionless quantity:
+ *
+ *   kg m^2 s^-2     energy
+ *   A s             charge
+ *   1               an angle, a ratio
+ *
+ * Benchmarks give them per variable like sampling specs, the variables
+ * before a colon and specs separated by semicolons:
+ *
+ *   q1,q2: A s; epsilon: A^2 s^4 kg^-1 m^-3; r: m
+ */
+
+// the SI base units, the order of Dims
+var siBase = []string{"m", "kg", "s", "A", "K", "mol", "cd"}
+
+// Dims are the powers of the SI base units in a unit
+type Dims [7]int
+
+// ParseUnit reads a unit into its dimensions
+func ParseUnit(unit string) (D Dims, err error) {
+	fields := strings.Fields(unit)
+	if len(fields) == 0 {
+		return D, fmt.Errorf("empty unit")
+	}
+	if len(fields) == 1 && fields[0] == "1" {
+		return D, nil
+	}
+	for _, f := range fields {
+		sym, pow := f, 1
+		if i := strings.Index(f, "^"); i >= 0 {
+			sym = f[:i]
+			if pow, err = strconv.Atoi(f[i+1:]); err != nil {
+				return D, fmt.Errorf("unit %q: bad power in %s", unit, f)
+			}
+		}
+		found := false
+		for b, base := range siBase {
+			if sym == base {
+				D[b] += pow
+				found = true
+				break
+			}
+		}
+		if !found {
+			return D, fmt.Errorf("unit %q: %s is not an SI base unit", unit, sym)
+		}
+	}
+	return D, nil
+}
+
+// ParseUnits reads a unit spec for the variables names, in order,
+// those without a unit get ""
+func ParseUnits(names []string, spec string) ([]string, error) {
+	units := make([]string, len(names))
+	index := make(map[string]int)
+	for i, name := range names {
+		index[name] = i
+	}
+	for _, part := range strings.Split(spec, ";") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		kv := strings.SplitN(part, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("bad units %q, want vars: unit", strings.TrimSpace(part))
+		}
+		unit := strings.Join(strings.Fields(kv[1]), " ")
+		if _, err := ParseUnit(unit); err != nil {
+			return nil, err
+		}
+		for _, name := range strings.Split(kv[0], ",") {
+			name = strings.TrimSpace(name)
+			i, ok := index[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown variable %q", name)
+			}
+			if units[i] != "" {
+				return nil, fmt.Errorf("variable %q given twice", name)
+			}
+			units[i] = unit
+		}
+	}
+	return units, nil
+}
+
+// withUnits gives the vars the units of spec, panicking on errors
+// like sampling
+func withUnits(vars []BenchmarkVar, spec string) []BenchmarkVar {
+	names := make([]string, len(vars))
+	for i, v := range vars {
+		names[i] = v.Name
+	}
+	units, err := ParseUnits(names, spec)
+	if err != nil {
+		panic(fmt.Sprintf("units %q: %v", spec, err))
+	}
+	out := make([]BenchmarkVar, len(vars))
+	for i, v := range vars {
+		v.Unit = units[i]
+		out[i] = v
+	}
+	return out
+}
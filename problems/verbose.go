@@ -0,0 +1,20 @@
+package problems
+
+import "fmt"
+
+// verbosity levels, pge.Verbosity picks one
+const (
+	VQuiet = iota // nothing but warnings and results
+	VInfo         // setup and summaries
+	VIter         // a few lines every iteration
+	VDebug        // every expression popped, pushed or generated
+)
+
+// Vprintf prints the package's progress and warnings, warnings at VQuiet.
+// pge points it at its own Vprintf, so they follow -verbose and go where
+// pge's output goes
+var Vprintf = func(level int, format string, a ...interface{}) {
+	if level <= VInfo {
+		fmt.Printf(format, a...)
+	}
+}
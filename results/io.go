@@ -0,0 +1,220 @@
+package results
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+// Writer writes records to a results log, it is safe for concurrent use
+type Writer struct {
+	mu  sync.Mutex
+	buf *bufio.Writer
+	c   io.Closer
+	err error // first write error, later writes are dropped
+}
+
+func NewWriter(w io.Writer) *Writer {
+	W := &Writer{buf: bufio.NewWriter(w)}
+	if c, ok := w.(io.Closer); ok {
+		W.c = c
+	}
+	return W
+}
+
+// Create creates filename and writes run as its first record
+func Create(filename string, run *Run) (*Writer, error) {
+	f, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+	W := NewWriter(f)
+	if err = W.WriteRun(run); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return W, nil
+}
+
+func (W *Writer) WriteRun(run *Run) error {
+	return W.write(&Record{Kind: KindRun, Run: run})
+}
+
+func (W *Writer) WriteReport(R *Report) error {
+	return W.write(&Record{Kind: KindReport, Report: R})
+}
+
+func (W *Writer) WriteIter(it *Iter) error {
+	return W.write(&Record{Kind: KindIter, Iter: it})
+}
+
+func (W *Writer) write(rec *Record) error {
+	rec.V = Version
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	W.mu.Lock()
+	defer W.mu.Unlock()
+	if W.err != nil {
+		return W.err
+	}
+	if _, err = W.buf.Write(line); err == nil {
+		err = W.buf.WriteByte('\n')
+	}
+	W.err = err
+	return err
+}
+
+func (W *Writer) Flush() error {
+	W.mu.Lock()
+	defer W.mu.Unlock()
+	if W.err != nil {
+		return W.err
+	}
+	W.err = W.buf.Flush()
+	return W.err
+}
+
+// Close flushes and closes the underlying file, if it is one
+func (W *Writer) Close() error {
+	err := W.Flush()
+	if W.c != nil {
+		if cerr := W.c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// Reader reads the records of a results log one at a time
+type Reader struct {
+	sc   *bufio.Scanner
+	line int
+}
+
+func NewReader(r io.Reader) *Reader {
+	sc := bufio.NewScanner(r)
+	// a report of a large expression makes for a long line
+	sc.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	return &Reader{sc: sc}
+}
+
+// Next returns the next record, or io.EOF after the last one
+func (R *Reader) Next() (*Record, error) {
+	for R.sc.Scan() {
+		R.line++
+		line := R.sc.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		rec := new(Record)
+		if err := json.Unmarshal(line, rec); err != nil {
+			return nil, fmt.Errorf("line %d: %v", R.line, err)
+		}
+		if rec.V < 1 || rec.V > Version {
+			return nil, fmt.Errorf("line %d: schema version %d, this reader knows up to %d", R.line, rec.V, Version)
+		}
+		return rec, nil
+	}
+	if err := R.sc.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// Log is a whole results log, split by kind, in file order
+type Log struct {
+	Run     *Run
+	Reports []*Report
+	Iters   []*Iter
+}
+
+// Load reads a whole results log. A log cut short by a crash loads up
+// to its last complete line.
+func Load(filename string) (*Log, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	L := new(Log)
+	R := NewReader(f)
+	for {
+		rec, err := R.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if len(L.Reports) > 0 || len(L.Iters) > 0 {
+				// a partially written last line
+				break
+			}
+			return nil, fmt.Errorf("%s: %v", filename, err)
+		}
+		switch rec.Kind {
+		case KindRun:
+			if L.Run == nil {
+				L.Run = rec.Run
+			}
+		case KindReport:
+			L.Reports = append(L.Reports, rec.Report)
+		case KindIter:
+			L.Iters = append(L.Iters, rec.Iter)
+		}
+	}
+	if L.Run == nil {
+		return nil, fmt.Errorf("%s: no run record", filename)
+	}
+	return L, nil
+}
+
+// ReportIters lists the iterations that have reports, in order
+func (L *Log) ReportIters() []int {
+	seen := make(map[int]bool)
+	var iters []int
+	for _, R := range L.Reports {
+		if !seen[R.Iter] {
+			seen[R.Iter] = true
+			iters = append(iters, R.Iter)
+		}
+	}
+	sort.Ints(iters)
+	return iters
+}
+
+// ReportsAt returns the reports logged at iter, by rank
+func (L *Log) ReportsAt(iter int) []*Report {
+	var rpts []*Report
+	for _, R := range L.Reports {
+		if R.Iter == iter {
+			rpts = append(rpts, R)
+		}
+	}
+	sort.SliceStable(rpts, func(i, j int) bool { return rpts[i].Rank < rpts[j].Rank })
+	return rpts
+}
+
+// Final returns the reports marked final, or failing that
+// the reports of the last iteration logged
+func (L *Log) Final() []*Report {
+	var rpts []*Report
+	for _, R := range L.Reports {
+		if R.Final {
+			rpts = append(rpts, R)
+		}
+	}
+	if len(rpts) > 0 {
+		return rpts
+	}
+	iters := L.ReportIters()
+	if len(iters) == 0 {
+		return nil
+	}
+	return L.ReportsAt(iters[len(iters)-1])
+}
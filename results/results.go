@@ -0,0 +1,215 @@
+// Package results defines the JSON-lines result logs written by the
+// searches and reads them back for post-processing.
+//
+// A results log starts with a run record and continues with report and
+// iter records, one JSON object per line:
+//
+//	{"v":1,"kind":"run","run":{"schema":"pge-results",...}}
+//	{"v":1,"kind":"iter","iter":{"iter":0,"neqns":412,...}}
+//	{"v":1,"kind":"report","report":{"iter":0,"rank":0,"expr":"...",...}}
+//
+// Every line carries v, the schema version it was written with. Readers
+// accept every version up to their own and reject newer ones. Fields
+// are only ever added within a version.
+package results
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	probs "github.com/verdverm/go-pge/problems"
+)
+
+const (
+	Schema  = "pge-results"
+	Version = 1
+)
+
+// record kinds
+const (
+	KindRun    = "run"
+	KindReport = "report"
+	KindIter   = "iter"
+)
+
+// Record is one line of a results log, exactly one of
+// Run, Report and Iter is set, as told by Kind
+type Record struct {
+	V    int    `json:"v"`
+	Kind string `json:"kind"`
+
+	Run    *Run    `json:"run,omitempty"`
+	Report *Report `json:"report,omitempty"`
+	Iter   *Iter   `json:"iter,omitempty"`
+}
+
+// Run describes the search that wrote the log
+type Run struct {
+	Schema  string    `json:"schema"`
+	Problem string    `json:"problem"`
+	Search  string    `json:"search"` // pge, main
+	ProcID  int       `json:"proc_id"`
+	Started time.Time `json:"started"`
+
+	// names to print the expressions with
+	IndepNames []string `json:"indep_names"`
+	DepndNames []string `json:"depnd_names"`
+	SysNames   []string `json:"sys_names"`
+	SearchVar  int      `json:"search_var"`
+}
+
+// Report is an ExprReport as logged by a search
+type Report struct {
+	Iter  int  `json:"iter"` // when it was logged
+	Rank  int  `json:"rank"` // position among the reports logged together
+	Final bool `json:"final,omitempty"`
+
+	Serial []int   `json:"serial"` // go-symexpr Expr.Serial
+	Expr   string  `json:"expr"`
+	Pretty string  `json:"pretty"` // with the coefficients and variable names
+	Coeff  []Float `json:"coeff"`
+	Size   int     `json:"size"`
+	Depth  int     `json:"depth"`
+	Code   *Code   `json:"code,omitempty"` // to rebuild the expression, see pge.DecodeResults
+
+	TrainError Float `json:"train_error"`
+	TestError  Float `json:"test_error"`
+	PredError  Float `json:"pred_error"`
+	TrainScore int   `json:"train_score"`
+	TestScore  int   `json:"test_score"`
+	PredScore  int   `json:"pred_score"`
+
+	UniqID int `json:"uniq_id"`
+	ProcID int `json:"proc_id"`
+	IterID int `json:"iter_id"` // iteration that found it
+	UnitID int `json:"unit_id"`
+}
+
+// Iter is the state of a search after an iteration
+type Iter struct {
+	Iter     int       `json:"iter"`
+	Time     time.Time `json:"time"`
+	Neqns    int       `json:"neqns"`
+	MemoCnt  int       `json:"memo"`
+	MemoVst  int       `json:"memo_visits"`
+	AveError Float     `json:"ave_error"` // over the reported best
+	MinError Float     `json:"min_error"`
+
+	Evals    int `json:"evals"`
+	Discards int `json:"discards"`
+
+	TermCache *TermCache `json:"term_cache,omitempty"`
+}
+
+// Code is the prefix wire form of an expression, as pge.ExprCode
+type Code struct {
+	Ops  []int   `json:"ops"`
+	Vals []Float `json:"vals,omitempty"`
+}
+
+type TermCache struct {
+	Hits    int64   `json:"hits"`
+	Misses  int64   `json:"misses"`
+	Evicts  int64   `json:"evicts"`
+	Columns int     `json:"columns"`
+	MB      float64 `json:"mb"`
+}
+
+// Float is a float64 that survives JSON when it is NaN or infinite,
+// those are written as the strings "NaN", "+Inf" and "-Inf"
+type Float float64
+
+func (f Float) MarshalJSON() ([]byte, error) {
+	v := float64(f)
+	switch {
+	case math.IsNaN(v):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(v, 1):
+		return []byte(`"+Inf"`), nil
+	case math.IsInf(v, -1):
+		return []byte(`"-Inf"`), nil
+	}
+	return strconv.AppendFloat(nil, v, 'g', -1, 64), nil
+}
+
+func (f *Float) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*f = Float(v)
+	return nil
+}
+
+func Floats(vs []float64) []Float {
+	if vs == nil {
+		return nil
+	}
+	fs := make([]Float, len(vs))
+	for i, v := range vs {
+		fs[i] = Float(v)
+	}
+	return fs
+}
+
+func (R *Report) Coefficients() []float64 {
+	cs := make([]float64, len(R.Coeff))
+	for i, c := range R.Coeff {
+		cs[i] = float64(c)
+	}
+	return cs
+}
+
+// NewRun fills in a Run for P
+func NewRun(P *probs.ExprProblem, search string, procID int) *Run {
+	run := &Run{
+		Schema:    Schema,
+		Problem:   P.Name,
+		Search:    search,
+		ProcID:    procID,
+		Started:   time.Now(),
+		SearchVar: P.SearchVar,
+	}
+	if len(P.Train) > 0 {
+		trn := P.Train[0]
+		run.IndepNames = trn.GetIndepNames()
+		run.DepndNames = trn.GetDepndNames()
+		run.SysNames = trn.GetSysNames()
+	}
+	return run
+}
+
+// FromExprReport converts r, printing it with the names of run
+func FromExprReport(r *probs.ExprReport, run *Run, iter, rank int) *Report {
+	e := r.Expr()
+	R := &Report{
+		Iter:   iter,
+		Rank:   rank,
+		Serial: e.Serial(make([]int, 0, 64)),
+		Expr:   e.String(),
+		Coeff:  Floats(r.Coeff()),
+		Size:   r.Size(),
+		Depth:  e.Height(),
+
+		TrainError: Float(r.TrainError()),
+		TestError:  Float(r.TestError()),
+		PredError:  Float(r.PredError()),
+		TrainScore: r.TrainScore(),
+		TestScore:  r.TestScore(),
+		PredScore:  r.PredScore(),
+
+		UniqID: r.UniqID(),
+		ProcID: r.ProcID(),
+		IterID: r.IterID(),
+		UnitID: r.UnitID(),
+	}
+	if run != nil {
+		R.Pretty = e.PrettyPrint(run.IndepNames, run.SysNames, r.Coeff())
+	}
+	return R
+}
@@ -91,9 +91,10 @@ do
 	time ./go-pge -pcfg=prob/bench/${F}.cfg -evals=${E} -peel=${P} -iter=${I} -init=${M} -grow=${M} > "runs/${F}/${F}_pge_${E}_${I}_${P}_${M}.out"
 	# gdb ./go-pge
 
-	cp runs/${F}/pge/pge/pge:fitness.log runs/${F}/${F}_pge_${E}_${I}_${P}_${M}.fit
+	RUN=$(ls -td runs/${F}/pge-*/ | head -n 1)
+	cp ${RUN}pge/pge:iters.jsonl runs/${F}/${F}_pge_${E}_${I}_${P}_${M}.fit
 	echo "${F}_pge_${E}_${I}_${P}_${M}" >> runs/${F}_pge_fit.txt 
-	tail -n 7 runs/${F}/pge/pge/pge:fitness.log >> runs/${F}_pge_fit.txt
+	tail -n 7 ${RUN}pge/pge:iters.jsonl >> runs/${F}_pge_fit.txt
 	for i in {1..4}; do
 		echo "" >> runs/${F}_pge_fit.txt 
 	done
@@ -0,0 +1,10 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd
+
+package main
+
+import "os"
+
+func ttySize(f *os.File) (w, h int, ok bool) {
+	return 0, 0, false
+}
@@ -0,0 +1,19 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+// +build linux darwin freebsd netbsd openbsd
+
+package main
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+func ttySize(f *os.File) (w, h int, ok bool) {
+	var ws struct{ Row, Col, Xpixel, Ypixel uint16 }
+	_, _, e := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if e != 0 {
+		return 0, 0, false
+	}
+	return int(ws.Col), int(ws.Row), true
+}